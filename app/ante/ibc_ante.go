@@ -62,8 +62,8 @@ func (g IBCPermissionDecorator) ValidateIBCUpdateClientMsg(ctx sdk.Context, msgs
 
 func (g IBCPermissionDecorator) validMsg(ctx sdk.Context, m sdk.Msg) error {
 	if msg, ok := m.(*clienttypes.MsgUpdateClient); ok {
-		if msg.ClientMessage.TypeUrl == "/ibc.lightclients.wasm.v1.Header" && !g.tfmwKeeper.HasAllowRlyAddress(ctx, msg.Signer) {
-			return fmt.Errorf("permission denied, address %s don't have relay permission", msg.Signer)
+		if msg.ClientMessage.TypeUrl == "/ibc.lightclients.wasm.v1.Header" && !g.tfmwKeeper.IsRelayerAllowed(ctx, msg.Signer, msg.ClientId) {
+			return fmt.Errorf("permission denied, address %s don't have relay permission for client %s", msg.Signer, msg.ClientId)
 		}
 	}
 
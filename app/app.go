@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/notional-labs/composable/v6/app/upgrades/v6_6_4"
+	"github.com/notional-labs/composable/v6/app/upgrades/v6_6_5"
 
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -148,7 +149,7 @@ var (
 	// https://github.com/CosmWasm/wasmd/blob/02a54d33ff2c064f3539ae12d75d027d9c665f05/x/wasm/internal/types/proposal.go#L28-L34
 	EnableSpecificProposals = ""
 
-	Upgrades = []upgrades.Upgrade{v6_6_4.Upgrade}
+	Upgrades = []upgrades.Upgrade{v6_6_4.Upgrade, v6_6_5.Upgrade}
 	Forks    = []upgrades.Fork{}
 )
 
@@ -346,7 +347,7 @@ func NewComposableApp(
 	txBoundaryModule := txBoundary.NewAppModule(appCodec, app.TxBoundaryKeepper)
 	ratelimitModule := ratelimitmodule.NewAppModule(&app.RatelimitKeeper)
 	icqModule := icq.NewAppModule(app.ICQKeeper)
-	ibcHooksModule := ibc_hooks.NewAppModule()
+	ibcHooksModule := ibc_hooks.NewAppModule(app.IBCHooksKeeper)
 	icaModule := ica.NewAppModule(nil, &app.ICAHostKeeper) // Only ICA Host
 	/****  Module Options ****/
 
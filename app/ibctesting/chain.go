@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	ibchookskeeper "github.com/notional-labs/composable/v6/x/ibc-hooks/keeper"
 	ratelimitmodulekeeper "github.com/notional-labs/composable/v6/x/ratelimit/keeper"
 
 	"cosmossdk.io/errors"
@@ -84,6 +85,8 @@ type TestChain struct {
 
 	PendingSendPackets []channeltypes.Packet
 	PendingAckPackets  []PacketAck
+	// LastEvents are the events of the last tx delivered by the chain
+	LastEvents []abci.Event
 
 	// Use wasm client if true
 	UseWasmClient bool
@@ -348,6 +351,7 @@ func (chain *TestChain) SendMsgsWithExpPass(expPass bool, msgs ...sdk.Msg) (*sdk
 }
 
 func (chain *TestChain) captureIBCEvents(r *sdk.Result) {
+	chain.LastEvents = r.Events
 	toSend := getSendPackets(r.Events)
 	if len(toSend) > 0 {
 		// Keep a queue on the chain that we can relay in tests
@@ -623,6 +627,10 @@ func (chain *TestChain) RateLimit() ratelimitmodulekeeper.Keeper {
 	return chain.GetTestSupport().RateLimit()
 }
 
+func (chain *TestChain) IBCHooks() *ibchookskeeper.Keeper {
+	return chain.GetTestSupport().IBCHooks()
+}
+
 func (chain *TestChain) Balance(acc sdk.AccAddress, denom string) sdk.Coin {
 	return chain.GetTestSupport().BankKeeper().GetBalance(chain.GetContext(), acc, denom)
 }
@@ -96,6 +96,7 @@ import (
 	minttypes "github.com/notional-labs/composable/v6/x/mint/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -285,6 +286,11 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	//
 	hooksKeeper := ibchookskeeper.NewKeeper(
 		appKeepers.keys[ibchookstypes.StoreKey],
+		appCodec,
+		appKeepers.BankKeeper,
+		appKeepers.IBCKeeper.ChannelKeeper,
+		appKeepers.ScopedTransferKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	appKeepers.IBCHooksKeeper = &hooksKeeper
 
@@ -295,6 +301,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.IBCKeeper.ChannelKeeper,
 		appKeepers.Ics20WasmHooks,
 	)
+	appKeepers.IBCHooksKeeper.SetICS4Wrapper(appKeepers.HooksICS4Wrapper)
 
 	appKeepers.TransferMiddlewareKeeper = transfermiddlewarekeeper.NewKeeper(
 		appKeepers.keys[transfermiddlewaretypes.StoreKey],
@@ -302,10 +309,12 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appCodec,
 		&appKeepers.RatelimitKeeper,
 		&appKeepers.TransferKeeper,
-		appKeepers.BankKeeper,
+		// the middleware moves vouchers in and out of escrow, bypassing the escrow deposit check
+		appKeepers.BankKeeper.BaseKeeper,
 		&appKeepers.IbcTransferMiddlewareKeeper,
 		authorityAddress,
 	)
+	appKeepers.IBCHooksKeeper.SetTransferMiddlewareKeeper(&appKeepers.TransferMiddlewareKeeper)
 
 	appKeepers.TxBoundaryKeepper = txBoundaryKeeper.NewKeeper(
 		appCodec,
@@ -320,7 +329,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.TransferKeeper,
 		appKeepers.IBCKeeper.ChannelKeeper,
 		&appKeepers.DistrKeeper,
-		appKeepers.BankKeeper,
+		transfermiddlewarekeeper.NewForwardBankKeeper(appKeepers.TransferMiddlewareKeeper, appKeepers.BankKeeper),
 		appKeepers.TransferMiddlewareKeeper,
 		appKeepers.IBCKeeper.ChannelKeeper,
 	)
@@ -339,6 +348,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	)
 
 	appKeepers.RouterKeeper.SetTransferKeeper(appKeepers.TransferKeeper)
+	appKeepers.IBCHooksKeeper.SetForwardKeeper(appKeepers.RouterKeeper)
+	appKeepers.IBCHooksKeeper.SetTransferKeeper(appKeepers.TransferKeeper)
 
 	appKeepers.RatelimitKeeper = *ratelimitmodulekeeper.NewKeeper(
 		appCodec,
@@ -351,6 +362,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.TransferMiddlewareKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	appKeepers.IBCHooksKeeper.SetRateLimitKeeper(&appKeepers.RatelimitKeeper)
 
 	transferIBCModule := transfer.NewIBCModule(appKeepers.TransferKeeper.Keeper)
 	scopedICQKeeper := appKeepers.CapabilityKeeper.ScopeToModule(icqtypes.ModuleName)
@@ -366,6 +378,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		transferIBCModule,
 		appKeepers.TransferMiddlewareKeeper,
 	)
+	// the forwards of the funds returned by the contracts are refunded below the packet forward middleware
+	appKeepers.IBCHooksKeeper.SetRefundApp(transfermiddlewareStack)
 
 	ibcMiddlewareStack := router.NewIBCMiddleware(
 		transfermiddlewareStack,
@@ -396,6 +410,12 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	availableCapabilities := strings.Join(AllCapabilities(), ",")
+	wasmOpts = append(wasmOpts, wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Custom: CustomQuerier(appKeepers),
+	}))
+	wasmOpts = append(wasmOpts, wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{
+		Custom: CustomEncoder,
+	}))
 	appKeepers.WasmKeeper = wasm.NewKeeper(
 		appCodec,
 		appKeepers.keys[wasmtypes.StoreKey],
@@ -403,7 +423,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.BankKeeper,
 		appKeepers.StakingKeeper,
 		distrkeeper.NewQuerier(appKeepers.DistrKeeper),
-		appKeepers.IBCKeeper.ChannelKeeper, // ISC4 Wrapper: fee IBC middleware
+		&appKeepers.HooksICS4Wrapper, // ISC4 Wrapper: ibc-hooks, contracts can register callbacks of their packets
 		appKeepers.IBCKeeper.ChannelKeeper,
 		&appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.ScopedWasmKeeper,
@@ -418,6 +438,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	)
 
 	appKeepers.Ics20WasmHooks.ContractKeeper = &appKeepers.WasmKeeper
+	appKeepers.IBCHooksKeeper.SetContractKeeper(&appKeepers.WasmKeeper)
 
 	// Register Gov (must be registered after stakeibc)
 	govRouter := govtypesv1beta1.NewRouter()
@@ -449,7 +470,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, hooksTransferMiddleware)
 	ibcRouter.AddRoute(icqtypes.ModuleName, icqIBCModule)
-	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(appKeepers.WasmKeeper, appKeepers.IBCKeeper.ChannelKeeper, appKeepers.IBCKeeper.ChannelKeeper))
+	hooksWasmMiddleware := ibc_hooks.NewIBCMiddleware(wasm.NewIBCHandler(appKeepers.WasmKeeper, appKeepers.IBCKeeper.ChannelKeeper, appKeepers.IBCKeeper.ChannelKeeper), &appKeepers.HooksICS4Wrapper)
+	ibcRouter.AddRoute(wasm.ModuleName, hooksWasmMiddleware)
 	ibcRouter.AddRoute(icahosttypes.SubModuleName, icaHostStack)
 
 	// this line is used by starport scaffolding # ibc/app/router
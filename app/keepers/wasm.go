@@ -10,5 +10,7 @@ func AllCapabilities() []string {
 		"stargate",
 		"cosmwasm_1_1",
 		"cosmwasm_1_2",
+		// custom queries of wasm_querier.go
+		"composable",
 	}
 }
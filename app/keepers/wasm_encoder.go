@@ -0,0 +1,45 @@
+package keepers
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ibchookstypes "github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+// ComposableMsg is the CosmosMsg::Custom of the contracts, only one field is set.
+type ComposableMsg struct {
+	EmitIBCAck *EmitIBCAckMsg `json:"emit_ibc_ack,omitempty"`
+}
+
+// EmitIBCAckMsg writes the acknowledgement of a packet the contract received with an async_ack wasm memo,
+// the packet fails if error is set.
+type EmitIBCAckMsg struct {
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+	Result    []byte `json:"result,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// CustomEncoder encodes the custom messages of the contracts into the messages of the modules,
+// the contract is their sender.
+func CustomEncoder(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	var custom ComposableMsg
+	if err := json.Unmarshal(msg, &custom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	switch {
+	case custom.EmitIBCAck != nil:
+		ack := custom.EmitIBCAck
+		return []sdk.Msg{
+			ibchookstypes.NewMsgEmitIBCAck(sender.String(), ack.ChannelID, ack.Sequence, ack.Result, ack.Error),
+		}, nil
+	default:
+		return nil, errorsmod.Wrap(wasmtypes.ErrUnknownMsg, "unknown composable message variant")
+	}
+}
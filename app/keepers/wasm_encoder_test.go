@@ -0,0 +1,30 @@
+package keepers_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/notional-labs/composable/v6/app/keepers"
+	ibchookstypes "github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+func TestCustomEncoderEmitIBCAck(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+
+	msgs, err := keepers.CustomEncoder(contract, []byte(`{"emit_ibc_ack":{"channel_id":"channel-0","sequence":3,"result":"eyJvayI6dHJ1ZX0="}}`))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{ibchookstypes.NewMsgEmitIBCAck(contract.String(), "channel-0", 3, []byte(`{"ok":true}`), "")}, msgs)
+
+	msgs, err = keepers.CustomEncoder(contract, []byte(`{"emit_ibc_ack":{"channel_id":"channel-0","sequence":4,"error":"swap failed"}}`))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{ibchookstypes.NewMsgEmitIBCAck(contract.String(), "channel-0", 4, nil, "swap failed")}, msgs)
+
+	_, err = keepers.CustomEncoder(contract, []byte(`{"unknown":{}}`))
+	require.ErrorIs(t, err, wasmtypes.ErrUnknownMsg)
+
+	_, err = keepers.CustomEncoder(contract, []byte(`not json`))
+	require.Error(t, err)
+}
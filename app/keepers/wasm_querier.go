@@ -0,0 +1,199 @@
+package keepers
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	transfermiddlewaretypes "github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+// ComposableQuery is the QueryRequest::Custom of the contracts, only one field is set.
+type ComposableQuery struct {
+	ParaTokenInfo *ParaTokenInfoQuery `json:"para_token_info,omitempty"`
+	EscrowAddress *EscrowAddressQuery `json:"escrow_address,omitempty"`
+	RateLimit     *RateLimitQuery     `json:"rate_limit,omitempty"`
+	TransferFee   *TransferFeeQuery   `json:"transfer_fee,omitempty"`
+}
+
+// ParaTokenInfoQuery looks up the parachain token infos by one of the native denom,
+// the IBC denom or the asset id.
+type ParaTokenInfoQuery struct {
+	NativeDenom string `json:"native_denom,omitempty"`
+	IBCDenom    string `json:"ibc_denom,omitempty"`
+	AssetID     string `json:"asset_id,omitempty"`
+}
+
+type ParaTokenInfo struct {
+	NativeDenom string `json:"native_denom"`
+	IBCDenom    string `json:"ibc_denom"`
+	ChannelID   string `json:"channel_id"`
+	AssetID     string `json:"asset_id"`
+	Primary     bool   `json:"primary"`
+}
+
+// ParaTokenInfoResponse has no infos if the denom is not mapped to a parachain token.
+type ParaTokenInfoResponse struct {
+	Infos []ParaTokenInfo `json:"infos"`
+}
+
+type EscrowAddressQuery struct {
+	ChannelID string `json:"channel_id"`
+}
+
+type EscrowAddressResponse struct {
+	EscrowAddress string `json:"escrow_address"`
+}
+
+// RateLimitQuery looks up the rate limit of a denom on a channel, the native denom of a
+// parachain token is rate limited as its IBC denom on the parachain channel.
+type RateLimitQuery struct {
+	Denom     string `json:"denom"`
+	ChannelID string `json:"channel_id"`
+}
+
+type RateLimitResponse struct {
+	Blacklisted bool             `json:"blacklisted"`
+	Paused      bool             `json:"paused"`
+	RateLimit   *RateLimitStatus `json:"rate_limit,omitempty"`
+}
+
+type RateLimitStatus struct {
+	MaxPercentSend       math.Int `json:"max_percent_send"`
+	MaxPercentRecv       math.Int `json:"max_percent_recv"`
+	DurationHours        uint64   `json:"duration_hours"`
+	EpochIdentifier      string   `json:"epoch_identifier"`
+	MaxPercentPerAddress math.Int `json:"max_percent_per_address"`
+	MaxAmountPerAddress  math.Int `json:"max_amount_per_address"`
+	Inflow               math.Int `json:"inflow"`
+	Outflow              math.Int `json:"outflow"`
+	ChannelValue         math.Int `json:"channel_value"`
+}
+
+// TransferFeeQuery estimates the fee charged for an IBC transfer, the memo sets the priority fee.
+type TransferFeeQuery struct {
+	ChannelID string           `json:"channel_id"`
+	Token     wasmvmtypes.Coin `json:"token"`
+	Memo      string           `json:"memo,omitempty"`
+}
+
+type TransferFeeResponse struct {
+	Fee wasmvmtypes.Coin `json:"fee"`
+}
+
+// CustomQuerier dispatches the custom queries of the contracts to the keepers.
+func CustomQuerier(appKeepers *AppKeepers) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query ComposableQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: err.Error(), Request: request}
+		}
+
+		var (
+			res interface{}
+			err error
+		)
+		switch {
+		case query.ParaTokenInfo != nil:
+			res, err = appKeepers.queryParaTokenInfo(ctx, query.ParaTokenInfo)
+		case query.EscrowAddress != nil:
+			res = EscrowAddressResponse{
+				EscrowAddress: transfertypes.GetEscrowAddress(transfertypes.PortID, query.EscrowAddress.ChannelID).String(),
+			}
+		case query.RateLimit != nil:
+			res = appKeepers.queryRateLimit(ctx, query.RateLimit)
+		case query.TransferFee != nil:
+			res, err = appKeepers.queryTransferFee(ctx, query.TransferFee)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown composable query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(res)
+	}
+}
+
+func (appKeepers *AppKeepers) queryParaTokenInfo(ctx sdk.Context, query *ParaTokenInfoQuery) (ParaTokenInfoResponse, error) {
+	k := appKeepers.TransferMiddlewareKeeper
+
+	var infos []transfermiddlewaretypes.ParachainIBCTokenInfo
+	switch {
+	case query.NativeDenom != "":
+		infos = k.GetParachainIBCTokenInfosByNativeDenom(ctx, query.NativeDenom)
+	case query.IBCDenom != "":
+		if info, found := k.GetParachainIBCTokenInfoByIBCDenom(ctx, query.IBCDenom); found {
+			infos = append(infos, info)
+		}
+	case query.AssetID != "":
+		if k.HasParachainIBCTokenInfoByAssetID(ctx, query.AssetID) {
+			infos = append(infos, k.GetParachainIBCTokenInfoByAssetID(ctx, query.AssetID))
+		}
+	default:
+		return ParaTokenInfoResponse{}, wasmvmtypes.InvalidRequest{Err: "one of native_denom, ibc_denom or asset_id is required"}
+	}
+
+	res := ParaTokenInfoResponse{Infos: []ParaTokenInfo{}}
+	for _, info := range infos {
+		res.Infos = append(res.Infos, ParaTokenInfo{
+			NativeDenom: info.NativeDenom,
+			IBCDenom:    info.IbcDenom,
+			ChannelID:   info.ChannelID,
+			AssetID:     info.AssetId,
+			Primary:     info.Primary,
+		})
+	}
+	return res, nil
+}
+
+func (appKeepers *AppKeepers) queryRateLimit(ctx sdk.Context, query *RateLimitQuery) RateLimitResponse {
+	denom := query.Denom
+	if tokenInfo, found := appKeepers.TransferMiddlewareKeeper.GetParachainIBCTokenInfo(ctx, denom, query.ChannelID); found {
+		denom = tokenInfo.IbcDenom
+	}
+
+	k := appKeepers.RatelimitKeeper
+	res := RateLimitResponse{
+		Blacklisted: k.IsDenomBlacklisted(ctx, denom),
+		Paused:      k.IsPathPaused(ctx, denom, query.ChannelID),
+	}
+	if rateLimit, found := k.GetRateLimit(ctx, denom, query.ChannelID); found {
+		res.RateLimit = &RateLimitStatus{
+			MaxPercentSend:       rateLimit.Quota.MaxPercentSend,
+			MaxPercentRecv:       rateLimit.Quota.MaxPercentRecv,
+			DurationHours:        rateLimit.Quota.DurationHours,
+			EpochIdentifier:      rateLimit.Quota.EpochIdentifierOrDefault(),
+			MaxPercentPerAddress: rateLimit.Quota.MaxPercentPerAddress,
+			MaxAmountPerAddress:  rateLimit.Quota.MaxAmountPerAddress,
+			Inflow:               rateLimit.Flow.Inflow,
+			Outflow:              rateLimit.Flow.Outflow,
+			ChannelValue:         rateLimit.Flow.ChannelValue,
+		}
+	}
+	return res
+}
+
+func (appKeepers *AppKeepers) queryTransferFee(ctx sdk.Context, query *TransferFeeQuery) (TransferFeeResponse, error) {
+	amount, ok := math.NewIntFromString(query.Token.Amount)
+	if !ok || amount.IsNegative() {
+		return TransferFeeResponse{}, wasmvmtypes.InvalidRequest{Err: "invalid token amount " + query.Token.Amount}
+	}
+	if err := sdk.ValidateDenom(query.Token.Denom); err != nil {
+		return TransferFeeResponse{}, wasmvmtypes.InvalidRequest{Err: err.Error()}
+	}
+
+	fee, err := appKeepers.TransferKeeper.EstimateTransferFee(ctx, query.ChannelID, sdk.NewCoin(query.Token.Denom, amount), query.Memo)
+	if err != nil {
+		return TransferFeeResponse{}, errorsmod.Wrap(err, "estimate transfer fee")
+	}
+
+	return TransferFeeResponse{
+		Fee: wasmvmtypes.Coin{Denom: fee.Denom, Amount: fee.Amount.String()},
+	}, nil
+}
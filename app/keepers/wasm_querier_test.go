@@ -0,0 +1,258 @@
+package keepers_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	composable "github.com/notional-labs/composable/v6/app"
+	"github.com/notional-labs/composable/v6/app/helpers"
+	"github.com/notional-labs/composable/v6/app/keepers"
+	ibctransfermiddlewaretypes "github.com/notional-labs/composable/v6/x/ibctransfermiddleware/types"
+	ratelimittypes "github.com/notional-labs/composable/v6/x/ratelimit/types"
+)
+
+const (
+	channelID   = "channel-0"
+	nativeDenom = "ppica"
+	assetID     = "1"
+)
+
+var ibcDenom = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, channelID, assetID)).IBCDenom()
+
+// setupQuerier returns an app with ppica mapped on channel-0, the custom query plugins
+// and the counter contract calling them.
+func setupQuerier(t *testing.T) (*composable.ComposableApp, sdk.Context, *wasmkeeper.QueryPlugins, sdk.AccAddress) {
+	t.Helper()
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app).WithBlockTime(time.Now())
+
+	err := app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, ibcDenom, channelID, nativeDenom, assetID)
+	require.NoError(t, err)
+
+	wasmCode, err := os.ReadFile("../../tests/ibc-hooks/bytecode/counter.wasm")
+	require.NoError(t, err)
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName)
+	contractKeeper := wasmkeeper.NewGovPermissionKeeper(app.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(ctx, govAddress, wasmCode, nil)
+	require.NoError(t, err)
+	contract, _, err := contractKeeper.Instantiate(ctx, codeID, govAddress, govAddress, []byte(`{"count": 0}`), "counter", nil)
+	require.NoError(t, err)
+
+	return app, ctx, &wasmkeeper.QueryPlugins{Custom: keepers.CustomQuerier(&app.AppKeepers)}, contract
+}
+
+func customQuery(t *testing.T, ctx sdk.Context, plugins *wasmkeeper.QueryPlugins, caller sdk.AccAddress, query keepers.ComposableQuery, res interface{}) error {
+	t.Helper()
+	request, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	bz, err := plugins.HandleQuery(ctx, caller, wasmvmtypes.QueryRequest{Custom: request})
+	if err != nil {
+		return err
+	}
+	require.NoError(t, json.Unmarshal(bz, res))
+	return nil
+}
+
+func TestCustomQuerierParaTokenInfo(t *testing.T) {
+	_, ctx, plugins, contract := setupQuerier(t)
+
+	expected := []keepers.ParaTokenInfo{{
+		NativeDenom: nativeDenom,
+		IBCDenom:    ibcDenom,
+		ChannelID:   channelID,
+		AssetID:     assetID,
+		Primary:     true,
+	}}
+
+	for _, query := range []keepers.ParaTokenInfoQuery{
+		{NativeDenom: nativeDenom},
+		{IBCDenom: ibcDenom},
+		{AssetID: assetID},
+	} {
+		query := query
+		var res keepers.ParaTokenInfoResponse
+		err := customQuery(t, ctx, plugins, contract, keepers.ComposableQuery{ParaTokenInfo: &query}, &res)
+		require.NoError(t, err)
+		require.Equal(t, expected, res.Infos)
+	}
+
+	var res keepers.ParaTokenInfoResponse
+	err := customQuery(t, ctx, plugins, contract, keepers.ComposableQuery{ParaTokenInfo: &keepers.ParaTokenInfoQuery{NativeDenom: "stake"}}, &res)
+	require.NoError(t, err)
+	require.Empty(t, res.Infos)
+
+	err = customQuery(t, ctx, plugins, contract, keepers.ComposableQuery{ParaTokenInfo: &keepers.ParaTokenInfoQuery{}}, &res)
+	require.ErrorAs(t, err, &wasmvmtypes.InvalidRequest{})
+}
+
+func TestCustomQuerierEscrowAddress(t *testing.T) {
+	_, ctx, plugins, contract := setupQuerier(t)
+
+	var res keepers.EscrowAddressResponse
+	err := customQuery(t, ctx, plugins, contract, keepers.ComposableQuery{EscrowAddress: &keepers.EscrowAddressQuery{ChannelID: channelID}}, &res)
+	require.NoError(t, err)
+	require.Equal(t, transfertypes.GetEscrowAddress(transfertypes.PortID, channelID).String(), res.EscrowAddress)
+}
+
+func TestCustomQuerierRateLimit(t *testing.T) {
+	app, ctx, plugins, contract := setupQuerier(t)
+
+	app.RatelimitKeeper.SetRateLimit(ctx, ratelimittypes.RateLimit{
+		Path: &ratelimittypes.Path{Denom: ibcDenom, ChannelID: channelID},
+		Quota: &ratelimittypes.Quota{
+			MaxPercentSend:       math.NewInt(10),
+			MaxPercentRecv:       math.NewInt(20),
+			DurationHours:        24,
+			MaxPercentPerAddress: math.NewInt(5),
+		},
+		Flow: &ratelimittypes.Flow{
+			Inflow:       math.NewInt(5),
+			Outflow:      math.NewInt(7),
+			ChannelValue: math.NewInt(1000),
+		},
+	})
+	app.RatelimitKeeper.AddDenomToBlacklist(ctx, ibcDenom)
+
+	// the native denom is rate limited as the IBC denom of the parachain token
+	var res keepers.RateLimitResponse
+	err := customQuery(t, ctx, plugins, contract, keepers.ComposableQuery{RateLimit: &keepers.RateLimitQuery{Denom: nativeDenom, ChannelID: channelID}}, &res)
+	require.NoError(t, err)
+	require.True(t, res.Blacklisted)
+	require.False(t, res.Paused)
+	require.NotNil(t, res.RateLimit)
+	require.Equal(t, math.NewInt(10), res.RateLimit.MaxPercentSend)
+	require.Equal(t, math.NewInt(20), res.RateLimit.MaxPercentRecv)
+	require.Equal(t, uint64(24), res.RateLimit.DurationHours)
+	require.Equal(t, ratelimittypes.HourEpoch, res.RateLimit.EpochIdentifier)
+	require.Equal(t, math.NewInt(5), res.RateLimit.MaxPercentPerAddress)
+	require.True(t, res.RateLimit.MaxAmountPerAddress.IsZero())
+	require.Equal(t, math.NewInt(5), res.RateLimit.Inflow)
+	require.Equal(t, math.NewInt(7), res.RateLimit.Outflow)
+	require.Equal(t, math.NewInt(1000), res.RateLimit.ChannelValue)
+
+	res = keepers.RateLimitResponse{}
+	err = customQuery(t, ctx, plugins, contract, keepers.ComposableQuery{RateLimit: &keepers.RateLimitQuery{Denom: "stake", ChannelID: channelID}}, &res)
+	require.NoError(t, err)
+	require.False(t, res.Blacklisted)
+	require.Nil(t, res.RateLimit)
+}
+
+func TestCustomQuerierTransferFee(t *testing.T) {
+	app, ctx, plugins, contract := setupQuerier(t)
+
+	err := app.IbcTransferMiddlewareKeeper.SetParams(ctx, ibctransfermiddlewaretypes.Params{
+		ChannelFees: []*ibctransfermiddlewaretypes.ChannelFee{{
+			Channel: channelID,
+			AllowedTokens: []*ibctransfermiddlewaretypes.CoinItem{{
+				MinFee:     sdk.NewInt64Coin(nativeDenom, 100),
+				Percentage: 10,
+				TxPriorityFee: []*ibctransfermiddlewaretypes.TxPriorityFee{{
+					Priority:    "high",
+					PriorityFee: sdk.NewInt64Coin(nativeDenom, 50),
+				}},
+			}},
+			FeeAddress: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		}},
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name      string
+		channelID string
+		token     wasmvmtypes.Coin
+		memo      string
+		expected  string
+	}{
+		{"min fee and percentage", channelID, wasmvmtypes.NewCoin(1100, nativeDenom), "", "200"},
+		{"priority fee", channelID, wasmvmtypes.NewCoin(1150, nativeDenom), `{"priority":"high"}`, "250"},
+		{"amount below min fee", channelID, wasmvmtypes.NewCoin(50, nativeDenom), "", "50"},
+		{"channel without fees", "channel-1", wasmvmtypes.NewCoin(1000, nativeDenom), "", "0"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var res keepers.TransferFeeResponse
+			err := customQuery(t, ctx, plugins, contract, keepers.ComposableQuery{TransferFee: &keepers.TransferFeeQuery{ChannelID: tc.channelID, Token: tc.token, Memo: tc.memo}}, &res)
+			require.NoError(t, err)
+			require.Equal(t, wasmvmtypes.Coin{Denom: nativeDenom, Amount: tc.expected}, res.Fee)
+		})
+	}
+
+	var res keepers.TransferFeeResponse
+	err = customQuery(t, ctx, plugins, contract, keepers.ComposableQuery{TransferFee: &keepers.TransferFeeQuery{ChannelID: channelID, Token: wasmvmtypes.NewCoin(1000, "stake")}}, &res)
+	require.Error(t, err)
+}
+
+func TestCustomQuerierUnknownQuery(t *testing.T) {
+	_, ctx, plugins, contract := setupQuerier(t)
+
+	_, err := plugins.HandleQuery(ctx, contract, wasmvmtypes.QueryRequest{Custom: []byte(`{"unknown":{}}`)})
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+
+	_, err = plugins.HandleQuery(ctx, contract, wasmvmtypes.QueryRequest{Custom: []byte(`not json`)})
+	require.ErrorAs(t, err, &wasmvmtypes.InvalidRequest{})
+}
+
+// TestCustomQuerierFromContract issues the custom queries from a contract, through the query
+// plugins of the wasm keeper.
+func TestCustomQuerierFromContract(t *testing.T) {
+	app, ctx, _, _ := setupQuerier(t)
+
+	wasmCode, err := os.ReadFile("../../tests/ibc-hooks/bytecode/querier.wasm")
+	require.NoError(t, err)
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName)
+	contractKeeper := wasmkeeper.NewGovPermissionKeeper(app.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(ctx, govAddress, wasmCode, nil)
+	require.NoError(t, err)
+	contract, _, err := contractKeeper.Instantiate(ctx, codeID, govAddress, govAddress, []byte(`{}`), "querier", nil)
+	require.NoError(t, err)
+
+	// the querier contract forwards its message to the chain as a QueryRequest
+	contractQuery := func(query keepers.ComposableQuery, res interface{}) error {
+		request, err := json.Marshal(wasmvmtypes.QueryRequest{Custom: mustMarshal(t, query)})
+		require.NoError(t, err)
+		bz, err := app.WasmKeeper.QuerySmart(ctx, contract, request)
+		if err != nil {
+			return err
+		}
+		require.NoError(t, json.Unmarshal(bz, res))
+		return nil
+	}
+
+	var infoRes keepers.ParaTokenInfoResponse
+	err = contractQuery(keepers.ComposableQuery{ParaTokenInfo: &keepers.ParaTokenInfoQuery{NativeDenom: nativeDenom}}, &infoRes)
+	require.NoError(t, err)
+	require.Equal(t, []keepers.ParaTokenInfo{{
+		NativeDenom: nativeDenom,
+		IBCDenom:    ibcDenom,
+		ChannelID:   channelID,
+		AssetID:     assetID,
+		Primary:     true,
+	}}, infoRes.Infos)
+
+	var escrowRes keepers.EscrowAddressResponse
+	err = contractQuery(keepers.ComposableQuery{EscrowAddress: &keepers.EscrowAddressQuery{ChannelID: channelID}}, &escrowRes)
+	require.NoError(t, err)
+	require.Equal(t, transfertypes.GetEscrowAddress(transfertypes.PortID, channelID).String(), escrowRes.EscrowAddress)
+
+	// an invalid custom query fails the query of the contract
+	err = contractQuery(keepers.ComposableQuery{ParaTokenInfo: &keepers.ParaTokenInfoQuery{}}, &infoRes)
+	require.Error(t, err)
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	t.Helper()
+	bz, err := json.Marshal(v)
+	require.NoError(t, err)
+	return bz
+}
@@ -16,6 +16,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 	wasm08 "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/keeper"
 
+	ibchookskeeper "github.com/notional-labs/composable/v6/x/ibc-hooks/keeper"
 	ratelimitkeeper "github.com/notional-labs/composable/v6/x/ratelimit/keeper"
 	tfmdKeeper "github.com/notional-labs/composable/v6/x/transfermiddleware/keeper"
 )
@@ -89,3 +90,7 @@ func (s TestSupport) TransferMiddleware() tfmdKeeper.Keeper {
 func (s TestSupport) RateLimit() ratelimitkeeper.Keeper {
 	return s.app.RatelimitKeeper
 }
+
+func (s TestSupport) IBCHooks() *ibchookskeeper.Keeper {
+	return s.app.IBCHooksKeeper
+}
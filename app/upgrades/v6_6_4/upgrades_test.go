@@ -6,7 +6,7 @@ import (
 	"testing"
 	"time"
 
-	ibchookskeeper "github.com/notional-labs/composable/v6/x/ibc-hooks/keeper"
+	ibchooksv2 "github.com/notional-labs/composable/v6/x/ibc-hooks/migrations/v2"
 	ibctransfermiddlewaretypes "github.com/notional-labs/composable/v6/x/ibctransfermiddleware/types"
 
 	"github.com/notional-labs/composable/v6/app/upgrades/v6_6_4"
@@ -286,8 +286,8 @@ func prepareForTestingIbcTransferMiddlewareModule(s *UpgradeTestSuite) {
 
 func prepareForTestingIbcHooksModule(s *UpgradeTestSuite) {
 	store := s.Ctx.KVStore(s.App.GetKey(ibchookstypes.StoreKey))
-	store.Set(ibchookskeeper.GetPacketKey("channel-2", 2), []byte("centauri1hj5fveer5cjtn4wd6wstzugjfdxzl0xpzxlwgs"))
-	store.Set(ibchookskeeper.GetPacketKey("channel-4", 2), []byte("centauri1wkjvpgkuchq0r8425g4z4sf6n85zj5wtmqzjv9"))
+	store.Set(ibchooksv2.LegacyPacketKey("channel-2", 2), []byte("centauri1hj5fveer5cjtn4wd6wstzugjfdxzl0xpzxlwgs"))
+	store.Set(ibchooksv2.LegacyPacketKey("channel-4", 2), []byte("centauri1wkjvpgkuchq0r8425g4z4sf6n85zj5wtmqzjv9"))
 }
 
 func checkUpgradeGovModule(s *UpgradeTestSuite, acc1 sdk.AccAddress, proposal govtypes.Proposal) {
@@ -504,13 +504,15 @@ func checkUpgradeIbcTransferMiddlewareModule(s *UpgradeTestSuite) {
 }
 
 func checkUpgradeIbcHooksMiddlewareModule(s *UpgradeTestSuite) {
-	data := s.App.IBCHooksKeeper.GetPacketCallback(s.Ctx, "channel-2", 2)
+	// the callbacks were keyed without their port until v6_6_5
+	store := s.Ctx.KVStore(s.App.GetKey(ibchookstypes.StoreKey))
+	data := string(store.Get(ibchooksv2.LegacyPacketKey("channel-2", 2)))
 	s.Suite.Equal("pica1hj5fveer5cjtn4wd6wstzugjfdxzl0xpas3hgy", data)
 
-	data = s.App.IBCHooksKeeper.GetPacketCallback(s.Ctx, "channel-4", 2)
+	data = string(store.Get(ibchooksv2.LegacyPacketKey("channel-4", 2)))
 	s.Suite.Equal("pica1wkjvpgkuchq0r8425g4z4sf6n85zj5wtykvtv3", data)
 
-	data = s.App.IBCHooksKeeper.GetPacketCallback(s.Ctx, "channel-2", 1)
+	data = string(store.Get(ibchooksv2.LegacyPacketKey("channel-2", 1)))
 	s.Suite.Equal("", data)
 }
 
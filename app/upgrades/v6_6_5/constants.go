@@ -0,0 +1,33 @@
+package v6_6_5
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/notional-labs/composable/v6/app/upgrades"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrade name for the composable upgrade.
+	UpgradeName = "v6_6_5"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added:   []string{},
+		Deleted: []string{},
+	},
+}
+
+// DenomDisplay is how a native denom minted by the transfermiddleware is shown by wallets.
+type DenomDisplay struct {
+	Display  string
+	Exponent uint32
+	Symbol   string
+}
+
+// DenomDisplays are the known native denoms of the token infos registered before their bank
+// metadata was written with the mapping, the others are shown as their base denom.
+var DenomDisplays = map[string]DenomDisplay{
+	"ppica": {Display: "pica", Exponent: 12, Symbol: "PICA"},
+}
@@ -0,0 +1,50 @@
+package v6_6_5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/notional-labs/composable/v6/app/keepers"
+	"github.com/notional-labs/composable/v6/app/upgrades"
+	transfermiddlewaretypes "github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	_ upgrades.BaseAppParamManager,
+	_ codec.Codec,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		ctx.Logger().Info("Backfill bank metadata of the transfermiddleware native denoms")
+		BackfillDenomMetadata(ctx, keepers)
+		return vm, nil
+	}
+}
+
+// BackfillDenomMetadata registers the bank metadata of the native denoms of the token infos
+// which have none.
+func BackfillDenomMetadata(ctx sdk.Context, keepers *keepers.AppKeepers) {
+	keepers.TransferMiddlewareKeeper.IterateParaTokenInfos(ctx, func(_ int64, info transfermiddlewaretypes.ParachainIBCTokenInfo) (stop bool) {
+		denomDisplay, found := DenomDisplays[info.NativeDenom]
+		if !found {
+			denomDisplay = DenomDisplay{Display: info.NativeDenom}
+		}
+
+		metadata := transfermiddlewaretypes.NewDenomMetadata(info.NativeDenom, denomDisplay.Display, denomDisplay.Exponent, denomDisplay.Symbol)
+		if err := metadata.Validate(); err != nil {
+			ctx.Logger().Error("invalid bank metadata", "native_denom", info.NativeDenom, "error", err)
+			return false
+		}
+		keepers.TransferMiddlewareKeeper.RegisterDenomMetadata(ctx, metadata)
+		return false
+	})
+}
@@ -0,0 +1,61 @@
+package v6_6_5_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/stretchr/testify/suite"
+
+	apptesting "github.com/notional-labs/composable/v6/app"
+	"github.com/notional-labs/composable/v6/app/upgrades/v6_6_5"
+	"github.com/notional-labs/composable/v6/bech32-migration/utils"
+	transfermiddlewaretypes "github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+type UpgradeTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) TestBackfillDenomMetadata() {
+	// the chain is on the pica prefix since v6_6_4
+	sdk.SetAddrCacheEnabled(false)
+
+	sdk.GetConfig().SetBech32PrefixForAccount(utils.NewBech32PrefixAccAddr, utils.NewBech32PrefixAccPub)
+	sdk.GetConfig().SetBech32PrefixForValidator(utils.NewBech32PrefixValAddr, utils.NewBech32PrefixValPub)
+	sdk.GetConfig().SetBech32PrefixForConsensusNode(utils.NewBech32PrefixConsAddr, utils.NewBech32PrefixConsPub)
+
+	s.Setup(s.T())
+
+	k := s.App.TransferMiddlewareKeeper
+	for _, info := range []struct{ nativeDenom, assetID string }{
+		{"ppica", "1"},
+		{"pdemo", "2"},
+		{"pknown", "3"},
+	} {
+		ibcDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-0", info.assetID)).IBCDenom()
+		s.Require().NoError(k.AddParachainIBCInfo(s.Ctx, ibcDenom, "channel-0", info.nativeDenom, info.assetID))
+	}
+	known := transfermiddlewaretypes.NewDenomMetadata("pknown", "known", 6, "KNW")
+	s.App.BankKeeper.SetDenomMetaData(s.Ctx, known)
+
+	s.ConfirmUpgradeSucceeded(v6_6_5.UpgradeName, int64(5))
+
+	for _, tc := range []struct {
+		nativeDenom string
+		expected    banktypes.Metadata
+	}{
+		{"ppica", transfermiddlewaretypes.NewDenomMetadata("ppica", "pica", 12, "PICA")},
+		{"pdemo", transfermiddlewaretypes.NewDenomMetadata("pdemo", "pdemo", 0, "")},
+		{"pknown", known},
+	} {
+		metadata, found := s.App.BankKeeper.GetDenomMetaData(s.Ctx, tc.nativeDenom)
+		s.Require().True(found, tc.nativeDenom)
+		s.Require().Equal(tc.expected, metadata)
+	}
+}
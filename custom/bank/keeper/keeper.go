@@ -84,3 +84,26 @@ func (k Keeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequ
 
 	return &types.QueryTotalSupplyResponse{Supply: totalSupply, Pagination: pageRes}, nil
 }
+
+// SendCoins rejects the IBC vouchers of a transfermiddleware token info sent to the escrow
+// of its channel, they would back no minted native tokens.
+func (k Keeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.tfmk.ValidateEscrowDeposit(ctx, toAddr, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins applies the SendCoins escrow check to every output.
+func (k Keeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
+	for _, out := range outputs {
+		toAddr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.tfmk.ValidateEscrowDeposit(ctx, toAddr, out.Coins); err != nil {
+			return err
+		}
+	}
+	return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+}
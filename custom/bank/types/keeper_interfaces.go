@@ -8,4 +8,5 @@ type StakingKeeper interface {
 
 type TransferMiddlewareKeeper interface {
 	GetTotalEscrowedToken(ctx sdk.Context) (coins sdk.Coins)
+	ValidateEscrowDeposit(ctx sdk.Context, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
@@ -134,6 +134,23 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	return ret, err
 }
 
+// EstimateTransferFee returns the fee Transfer charges for sending a token on a channel,
+// the fee is zero if the channel has no fees.
+func (k Keeper) EstimateTransferFee(ctx sdk.Context, sourceChannel string, token sdk.Coin, memo string) (sdk.Coin, error) {
+	params := k.IbcTransfermiddleware.GetParams(ctx)
+	channelFee := findChannelParams(params.ChannelFees, sourceChannel)
+	if channelFee == nil {
+		return sdk.NewCoin(token.Denom, sdk.ZeroInt()), nil
+	}
+
+	coin := findCoinByDenom(channelFee.AllowedTokens, token.Denom)
+	if coin == nil {
+		return sdk.Coin{}, fmt.Errorf("token not allowed to be transferred in this channel")
+	}
+
+	return sdk.NewCoin(token.Denom, transferCharge(coin, token.Amount, memo)), nil
+}
+
 func GetPriority(jsonString string) *string {
 	var data map[string]interface{}
 	if err := json.Unmarshal([]byte(jsonString), &data); err != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	custombankkeeper "github.com/notional-labs/composable/v6/custom/bank/keeper"
@@ -57,28 +58,9 @@ func (k msgServer) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*typ
 				return nil, fmt.Errorf("token not allowed to be transferred in this channel")
 			}
 
-			minFee := coin.MinFee.Amount
-			priority := GetPriority(msg.Memo)
-			if priority != nil {
-				p := findPriority(coin.TxPriorityFee, *priority)
-				if p != nil && coin.MinFee.Denom == p.PriorityFee.Denom {
-					minFee = minFee.Add(p.PriorityFee.Amount)
-				}
-			}
-
-			charge := minFee
-			if charge.GT(msg.Token.Amount) {
-				charge = msg.Token.Amount
-			}
-
+			charge := transferCharge(coin, msg.Token.Amount, msg.Memo)
 			newAmount := msg.Token.Amount.Sub(charge)
 
-			if newAmount.IsPositive() && coin.Percentage != 0 {
-				percentageCharge := newAmount.QuoRaw(coin.Percentage)
-				newAmount = newAmount.Sub(percentageCharge)
-				charge = charge.Add(percentageCharge)
-			}
-
 			msgSender, err := sdk.AccAddressFromBech32(msg.Sender)
 			if err != nil {
 				return nil, err
@@ -108,6 +90,33 @@ func (k msgServer) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*typ
 	return ret, err
 }
 
+// transferCharge returns the fee charged for transferring an amount of an allowed token,
+// the minimum fee (plus the priority fee requested in the memo) and a percentage of the rest.
+func transferCharge(coin *ibctransfermiddlewaretypes.CoinItem, amount math.Int, memo string) math.Int {
+	minFee := coin.MinFee.Amount
+	priority := GetPriority(memo)
+	if priority != nil {
+		p := findPriority(coin.TxPriorityFee, *priority)
+		if p != nil && coin.MinFee.Denom == p.PriorityFee.Denom {
+			minFee = minFee.Add(p.PriorityFee.Amount)
+		}
+	}
+
+	charge := minFee
+	if charge.GT(amount) {
+		charge = amount
+	}
+
+	newAmount := amount.Sub(charge)
+
+	if newAmount.IsPositive() && coin.Percentage != 0 {
+		percentageCharge := newAmount.QuoRaw(coin.Percentage)
+		charge = charge.Add(percentageCharge)
+	}
+
+	return charge
+}
+
 func findChannelParams(channelFees []*ibctransfermiddlewaretypes.ChannelFee, targetChannelID string) *ibctransfermiddlewaretypes.ChannelFee {
 	for _, fee := range channelFees {
 		if fee.Channel == targetChannelID {
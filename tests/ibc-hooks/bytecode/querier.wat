@@ -0,0 +1,80 @@
+;; querier forwards its smart query message to the chain as a QueryRequest and returns the
+;; response, e.g. {"custom":{...}} runs a custom query of the chain.
+;; querier.wasm is this module assembled, e.g. wat2wasm querier.wat -o querier.wasm
+(module
+  (import "env" "query_chain" (func $query_chain (param i32) (result i32)))
+  (memory (export "memory") 2)
+
+  ;; the heap starts at 2048, its end is stored at 8
+  (data (i32.const 8) "\00\08\00\00")
+  ;; region of the empty response returned by instantiate
+  (data (i32.const 16) "\00\04\00\00\3e\00\00\00\3e\00\00\00")
+  (data (i32.const 1024) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[],\"data\":null}}")
+
+  (func $interface_version_8 (export "interface_version_8"))
+
+  ;; allocate returns a region of size bytes, the memory is never freed
+  (func $allocate (export "allocate") (param $size i32) (result i32)
+    (local $region i32)
+    i32.const 8
+    i32.load
+    local.set $region
+    local.get $region
+    local.get $region
+    i32.const 12
+    i32.add
+    i32.store
+    local.get $region
+    local.get $size
+    i32.store offset=4
+    local.get $region
+    i32.const 0
+    i32.store offset=8
+    ;; regions are 8 bytes aligned
+    i32.const 8
+    local.get $region
+    i32.const 19
+    i32.add
+    local.get $size
+    i32.add
+    i32.const -8
+    i32.and
+    i32.store
+    local.get $region)
+
+  (func $deallocate (export "deallocate") (param $region i32))
+
+  (func $instantiate (export "instantiate") (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    i32.const 16)
+
+  ;; query_chain returns {"ok":{"ok":"<base64>"}} or {"ok":{"error":"..."}} for a query handled by the
+  ;; chain, the inner object is the result of the query: the returned region points into the response,
+  ;; skipping {"ok": and the last }. A system error is not a valid result and fails the query.
+  (func $query (export "query") (param $env i32) (param $msg i32) (result i32)
+    (local $res i32)
+    (local $region i32)
+    local.get $msg
+    call $query_chain
+    local.set $res
+    i32.const 12
+    call $allocate
+    local.set $region
+    local.get $region
+    local.get $res
+    i32.load
+    i32.const 6
+    i32.add
+    i32.store
+    local.get $region
+    local.get $res
+    i32.load offset=8
+    i32.const 7
+    i32.sub
+    i32.store offset=4
+    local.get $region
+    local.get $res
+    i32.load offset=8
+    i32.const 7
+    i32.sub
+    i32.store offset=8
+    local.get $region))
@@ -0,0 +1,57 @@
+;; recorder stores the message of its sudo entry point under the key "sudo" of its storage and returns an
+;; empty response, e.g. the ibc_packet_receive message of a packet executing it with the packet context.
+;; recorder.wasm is this module assembled, e.g. wat2wasm recorder.wat -o recorder.wasm
+(module
+  (import "env" "db_write" (func $db_write (param i32) (param i32)))
+  (memory (export "memory") 2)
+
+  ;; the heap starts at 2048, its end is stored at 8
+  (data (i32.const 8) "\00\08\00\00")
+  ;; region of the empty response returned by instantiate and sudo
+  (data (i32.const 16) "\00\04\00\00\3e\00\00\00\3e\00\00\00")
+  ;; region of the storage key
+  (data (i32.const 32) "\00\05\00\00\04\00\00\00\04\00\00\00")
+  (data (i32.const 1024) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[],\"data\":null}}")
+  (data (i32.const 1280) "sudo")
+
+  (func $interface_version_8 (export "interface_version_8"))
+
+  ;; allocate returns a region of size bytes, the memory is never freed
+  (func $allocate (export "allocate") (param $size i32) (result i32)
+    (local $region i32)
+    i32.const 8
+    i32.load
+    local.set $region
+    local.get $region
+    local.get $region
+    i32.const 12
+    i32.add
+    i32.store
+    local.get $region
+    local.get $size
+    i32.store offset=4
+    local.get $region
+    i32.const 0
+    i32.store offset=8
+    ;; regions are 8 bytes aligned
+    i32.const 8
+    local.get $region
+    i32.const 19
+    i32.add
+    local.get $size
+    i32.add
+    i32.const -8
+    i32.and
+    i32.store
+    local.get $region)
+
+  (func $deallocate (export "deallocate") (param $region i32))
+
+  (func $instantiate (export "instantiate") (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    i32.const 16)
+
+  (func $sudo (export "sudo") (param $env i32) (param $msg i32) (result i32)
+    i32.const 32
+    local.get $msg
+    call $db_write
+    i32.const 16))
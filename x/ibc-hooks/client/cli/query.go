@@ -2,15 +2,14 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
-	"github.com/notional-labs/composable/v6/x/ibc-hooks/keeper"
-
 	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
 )
 
@@ -38,36 +37,406 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		GetCmdParams(),
 		GetCmdWasmSender(),
+		GetCmdValidateMemo(),
+		GetCmdPacketCallbacks(),
+		GetCmdPacketCallback(),
+		GetCmdFailedCallbacks(),
+		GetCmdFailedCallback(),
+		GetCmdAsyncAckPackets(),
+		GetCmdAsyncAckPacket(),
 	)
 	return cmd
 }
 
-// GetCmdPoolParams return pool params.
+// GetCmdParams returns the gas limits of the contracts.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the gas limits of the contracts executed by packets and their callbacks",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdWasmSender returns the intermediate sender executing the contracts for a sender on another chain.
 func GetCmdWasmSender() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "wasm-sender <channelID> <originalSender>",
-		Short: "Generate the local address for a wasm hooks sender",
+		Use:     "wasm-sender <channelID> <originalSender>",
+		Aliases: []string{"intermediate-sender"},
+		Short:   "Generate the local address for a wasm hooks sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Generate the local address executing the contracts for the sender of packets received on a channel.
+Example:
+$ %s query %s wasm-sender channel-42 juno12smx2wdlyttvyzvzg54y2vnqwq2qjatezqwqxu
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IntermediateSender(cmd.Context(), &types.QueryIntermediateSenderRequest{
+				ChannelID:      args[0],
+				OriginalSender: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdValidateMemo parses the wasm memo of a packet as a dry run.
+func GetCmdValidateMemo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-memo [memo] [receiver]",
+		Short: "Validate the wasm memo of a packet sent to a receiver",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Parse the wasm memo of a packet sent to a receiver without executing it, returning the contract
+and the message it executes or the error the packet would be acknowledged with.
+Example:
+$ %s query %s validate-memo '{"wasm":{"contract":"pica14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr","msg":{"increment":{}}}}' pica14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidateMemo(cmd.Context(), &types.QueryValidateMemoRequest{
+				Memo:     args[0],
+				Receiver: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const (
+	FlagContract = "contract"
+	FlagPort     = "port"
+	FlagChannel  = "channel"
+)
+
+// GetCmdPacketCallbacks returns the pending packet callbacks.
+func GetCmdPacketCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-callbacks",
+		Short: "Query the packet callbacks waiting for an ack or a timeout",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the packet callbacks waiting for an ack or a timeout, optionally of a contract, a port and/or a channel only.
+Example:
+$ %s query %s packet-callbacks --port transfer --channel channel-0 --contract pica14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			contract, err := cmd.Flags().GetString(FlagContract)
+			if err != nil {
+				return err
+			}
+			portID, err := cmd.Flags().GetString(FlagPort)
+			if err != nil {
+				return err
+			}
+			channelID, err := cmd.Flags().GetString(FlagChannel)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PacketCallbacks(cmd.Context(), &types.QueryPacketCallbacksRequest{
+				Contract:   contract,
+				PortID:     portID,
+				ChannelID:  channelID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagContract, "", "Only the callbacks of this contract")
+	cmd.Flags().String(FlagPort, "", "Only the callbacks of packets sent from this port")
+	cmd.Flags().String(FlagChannel, "", "Only the callbacks of packets sent on this channel")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packet-callbacks")
+
+	return cmd
+}
+
+// GetCmdPacketCallback returns the contract waiting for the callback of a packet.
+func GetCmdPacketCallback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-callback [port] [channel] [sequence]",
+		Short: "Query the contract waiting for the callback of a packet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the contract waiting for the ack or timeout callback of the packet sent from a port and a channel with a sequence.
+Example:
+$ %s query %s packet-callback transfer channel-0 42
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PacketCallback(cmd.Context(), &types.QueryPacketCallbackRequest{
+				PortID:    args[0],
+				ChannelID: args[1],
+				Sequence:  sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFailedCallbacks returns the failed packet callbacks.
+func GetCmdFailedCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failed-callbacks",
+		Short: "Query the packet callbacks which failed and can be retried",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Generate the local address for a wasm hooks sender.
+			fmt.Sprintf(`Query the packet callbacks which failed and can be retried, optionally of a contract only.
 Example:
-$ %s query ibc-hooks wasm-hooks-sender channel-42 juno12smx2wdlyttvyzvzg54y2vnqwq2qjatezqwqxu
+$ %s query %s failed-callbacks --contract pica14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr
 `,
-				version.AppName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			contract, err := cmd.Flags().GetString(FlagContract)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FailedCallbacks(cmd.Context(), &types.QueryFailedCallbacksRequest{
+				Contract:   contract,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagContract, "", "Only the failed callbacks of this contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "failed-callbacks")
+
+	return cmd
+}
+
+// GetCmdFailedCallback returns the failed callback of a packet.
+func GetCmdFailedCallback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failed-callback [port] [channel] [sequence]",
+		Short: "Query the failed callback of a packet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the failed callback of the packet sent from a port and a channel with a sequence.
+Example:
+$ %s query %s failed-callback transfer channel-0 42
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FailedCallback(cmd.Context(), &types.QueryFailedCallbackRequest{
+				PortID:    args[0],
+				ChannelID: args[1],
+				Sequence:  sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAsyncAckPackets returns the packets waiting for the acknowledgement of their contract.
+func GetCmdAsyncAckPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "async-ack-packets",
+		Short: "Query the received packets waiting for the acknowledgement of their contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the received packets waiting for the acknowledgement of their contract, optionally of a contract only.
+Example:
+$ %s query %s async-ack-packets --contract pica14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			contract, err := cmd.Flags().GetString(FlagContract)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AsyncAckPackets(cmd.Context(), &types.QueryAsyncAckPacketsRequest{
+				Contract:   contract,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagContract, "", "Only the packets of this contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "async-ack-packets")
+
+	return cmd
+}
+
+// GetCmdAsyncAckPacket returns a packet waiting for the acknowledgement of its contract.
+func GetCmdAsyncAckPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "async-ack-packet [channel] [sequence]",
+		Short: "Query a received packet waiting for the acknowledgement of its contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the packet received on a transfer channel with a sequence and waiting for the acknowledgement of its contract.
+Example:
+$ %s query %s async-ack-packet channel-0 42
+`,
+				version.AppName, types.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			channelID := args[0]
-			originalSender := args[1]
-			// ToDo: Make this flexible as an arg
-			prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
-			senderBech32, err := keeper.DeriveIntermediateSender(channelID, originalSender, prefix)
+			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
-			fmt.Println(senderBech32)
-			return nil
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AsyncAckPacket(cmd.Context(), &types.QueryAsyncAckPacketRequest{
+				ChannelID: args[0],
+				Sequence:  sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
 	}
 
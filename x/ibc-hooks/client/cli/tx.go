@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+// GetTxCmd returns the tx commands for ibc-hooks
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		Short:                      fmt.Sprintf("Tx commands for the %s module", types.ModuleName),
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		GetCmdRetryCallback(),
+		GetCmdEmitIBCAck(),
+	)
+
+	return txCmd
+}
+
+// GetCmdRetryCallback retries the failed callback of a packet.
+func GetCmdRetryCallback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-callback [port] [channel] [sequence]",
+		Short: "Retry the failed callback of a packet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Call again the contract whose ack or timeout callback failed for the packet sent from a port
+and a channel with a sequence. Anyone can retry a callback, it is removed once it succeeds.
+Example:
+$ %s tx %s retry-callback transfer channel-0 42 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRetryCallback(clientCtx.GetFromAddress().String(), args[0], args[1], sequence)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const (
+	FlagResult = "result"
+	FlagError  = "error"
+)
+
+// GetCmdEmitIBCAck writes the acknowledgement of a packet waiting for the contract.
+func GetCmdEmitIBCAck() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emit-ibc-ack [channel] [sequence]",
+		Short: "Acknowledge a received packet waiting for the acknowledgement of its contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Write the acknowledgement of the packet received on a transfer channel with a sequence whose memo
+asked for an async ack. Only the contract executed by the packet can acknowledge it, an error acknowledgement
+takes back the funds from the contract and refunds the sender.
+Example:
+$ %s tx %s emit-ibc-ack channel-0 42 --result '{"swapped":true}' --from mycontract
+$ %s tx %s emit-ibc-ack channel-0 42 --error "swap failed" --from mycontract
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			result, err := cmd.Flags().GetString(FlagResult)
+			if err != nil {
+				return err
+			}
+			ackError, err := cmd.Flags().GetString(FlagError)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEmitIBCAck(clientCtx.GetFromAddress().String(), args[0], sequence, []byte(result), ackError)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagResult, "", "The result of the contract returned in the acknowledgement")
+	cmd.Flags().String(FlagError, "", "Write an error acknowledgement refunding the sender")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
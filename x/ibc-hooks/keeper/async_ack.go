@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+// SetAsyncAckPacket records a received packet whose contract writes the acknowledgement later
+func (k Keeper) SetAsyncAckPacket(ctx sdk.Context, packet types.AsyncAckPacket) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetAsyncAckPacketKey(packet.Packet.DestinationPort, packet.Packet.DestinationChannel, packet.Packet.Sequence)
+	store.Set(key, k.cdc.MustMarshal(&packet))
+}
+
+func (k Keeper) GetAsyncAckPacket(ctx sdk.Context, port, channel string, packetSequence uint64) (types.AsyncAckPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetAsyncAckPacketKey(port, channel, packetSequence))
+	if bz == nil {
+		return types.AsyncAckPacket{}, false
+	}
+
+	var packet types.AsyncAckPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+func (k Keeper) DeleteAsyncAckPacket(ctx sdk.Context, port, channel string, packetSequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetAsyncAckPacketKey(port, channel, packetSequence))
+}
+
+// IterateAsyncAckPackets iterates over the packets waiting for their acknowledgement in the order of their keys
+func (k Keeper) IterateAsyncAckPackets(ctx sdk.Context, cb func(packet types.AsyncAckPacket) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyAsyncAckPacketPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.AsyncAckPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		if cb(packet) {
+			break
+		}
+	}
+}
+
+// WriteAsyncAck writes the acknowledgement of a packet received on a transfer channel with an async_ack memo,
+// only the contract executed by the packet can acknowledge it. An error acknowledgement refunds the sender
+// of the packet, so its funds are taken back from the contract first.
+func (k Keeper) WriteAsyncAck(ctx sdk.Context, sender, channel string, packetSequence uint64, result []byte, ackError string) (types.AsyncAckPacket, error) {
+	pending, found := k.GetAsyncAckPacket(ctx, transfertypes.PortID, channel, packetSequence)
+	if !found {
+		return pending, errorsmod.Wrapf(types.ErrAsyncAckNotFound, "sequence %d on %s/%s", packetSequence, transfertypes.PortID, channel)
+	}
+	if pending.Contract != sender {
+		return pending, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only %s can acknowledge the packet", pending.Contract)
+	}
+
+	var ack channeltypes.Acknowledgement
+	if ackError == "" {
+		bz, err := json.Marshal(types.ContractAck{ContractResult: result, IbcAck: pending.IbcAck})
+		if err != nil {
+			return pending, errorsmod.Wrap(types.ErrBadResponse, err.Error())
+		}
+		ack = channeltypes.NewResultAcknowledgement(bz)
+	} else {
+		if err := k.revertReceive(ctx, pending); err != nil {
+			return pending, err
+		}
+		ack = channeltypes.NewErrorAcknowledgement(types.ErrAsyncAck)
+	}
+
+	packet := pending.Packet
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(packet.DestinationPort, packet.DestinationChannel))
+	if !ok {
+		return pending, errorsmod.Wrapf(sdkerrors.ErrNotFound, "capability of channel %s", packet.DestinationChannel)
+	}
+	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack); err != nil {
+		return pending, err
+	}
+
+	k.DeleteAsyncAckPacket(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	return pending, nil
+}
+
+// GetReceiveWindowID returns the quota window of the rate limit of a received packet, it is stored with the
+// packets acknowledged later so an error acknowledgement only undoes the inflow of its window
+func (k Keeper) GetReceiveWindowID(ctx sdk.Context, packet channeltypes.Packet) uint64 {
+	if k.rateLimitKeeper == nil {
+		return 0
+	}
+	return k.rateLimitKeeper.GetReceiveWindowID(ctx, packet)
+}
+
+// revertReceive takes back the funds of a packet from its contract the way the transfer would not have
+// received them: the native tokens are escrowed again and the vouchers are burned. The native tokens minted
+// by the transfer middleware for the vouchers are burned and the vouchers are released from its escrow first.
+// The inflow of the packet is undone as the packet is refunded.
+func (k Keeper) revertReceive(ctx sdk.Context, pending types.AsyncAckPacket) error {
+	packet := pending.Packet
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.Data, &data); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPacket, err.Error())
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidPacket, "invalid amount %s", data.Amount)
+	}
+	contract, err := sdk.AccAddressFromBech32(pending.Contract)
+	if err != nil {
+		return err
+	}
+	if err := k.undoReceiveInflow(ctx, pending, data); err != nil {
+		return err
+	}
+
+	if transfertypes.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, data.Denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel)
+		denom := transfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):]).IBCDenom()
+		token := sdk.NewCoin(denom, amount)
+
+		escrowAddress := transfertypes.GetEscrowAddress(packet.DestinationPort, packet.DestinationChannel)
+		if err := k.bankKeeper.SendCoins(ctx, contract, escrowAddress, sdk.NewCoins(token)); err != nil {
+			return errorsmod.Wrap(err, "escrow the funds of the packet again")
+		}
+		totalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, denom)
+		k.transferKeeper.SetTotalEscrowForDenom(ctx, totalEscrow.Add(token))
+		return nil
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, data.Denom)
+	voucherDenom := transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+	if denom := k.ReceivedDenom(ctx, packet.DestinationChannel, voucherDenom); denom != voucherDenom {
+		info, _ := k.transferMiddlewareKeeper.GetParachainIBCTokenInfoByIBCDenom(ctx, voucherDenom)
+		if err := k.transferMiddlewareKeeper.ReleaseEscrowedVouchers(ctx, contract, info, amount); err != nil {
+			return errorsmod.Wrap(err, "take back the native tokens of the packet")
+		}
+	}
+
+	vouchers := sdk.NewCoins(sdk.NewCoin(voucherDenom, amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, contract, transfertypes.ModuleName, vouchers); err != nil {
+		return errorsmod.Wrap(err, "take back the vouchers of the packet")
+	}
+	return k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, vouchers)
+}
+
+// undoReceiveInflow undoes the inflow of a packet refunded by an error acknowledgement. The rate limit counted
+// the packet received by the intermediate sender of the contract, not the packet sent to the contract.
+func (k Keeper) undoReceiveInflow(ctx sdk.Context, pending types.AsyncAckPacket, data transfertypes.FungibleTokenPacketData) error {
+	if k.rateLimitKeeper == nil {
+		return nil
+	}
+
+	packet := pending.Packet
+	intermediateSender, err := DeriveIntermediateSender(packet.DestinationChannel, data.Sender, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	if err != nil {
+		return err
+	}
+	data.Receiver = intermediateSender
+	bz, err := json.Marshal(data)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrMarshaling, err.Error())
+	}
+	packet.Data = bz
+	return k.rateLimitKeeper.UndoReceivePacket(ctx, packet, pending.RateLimitWindowID)
+}
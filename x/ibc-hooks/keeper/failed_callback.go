@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+// SetFailedCallback records a callback which failed in its contract, it can be retried later
+func (k Keeper) SetFailedCallback(ctx sdk.Context, callback types.FailedCallback) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetFailedCallbackKey(callback.PortID, callback.ChannelID, callback.Sequence), k.cdc.MustMarshal(&callback))
+}
+
+// StoreFailedCallback records the failure of the callback of a packet, only the deterministic part of the
+// error is stored.
+func (k Keeper) StoreFailedCallback(ctx sdk.Context, port, channel string, packetSequence uint64, contract string, sudoMsg []byte, err error) {
+	k.SetFailedCallback(ctx, types.FailedCallback{
+		PortID:    port,
+		ChannelID: channel,
+		Sequence:  packetSequence,
+		Contract:  contract,
+		SudoMsg:   string(sudoMsg),
+		Error:     deterministicError(err),
+		Height:    ctx.BlockHeight(),
+	})
+}
+
+func (k Keeper) GetFailedCallback(ctx sdk.Context, port, channel string, packetSequence uint64) (types.FailedCallback, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetFailedCallbackKey(port, channel, packetSequence))
+	if bz == nil {
+		return types.FailedCallback{}, false
+	}
+
+	var callback types.FailedCallback
+	k.cdc.MustUnmarshal(bz, &callback)
+	return callback, true
+}
+
+func (k Keeper) DeleteFailedCallback(ctx sdk.Context, port, channel string, packetSequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetFailedCallbackKey(port, channel, packetSequence))
+}
+
+// IterateFailedCallbacks iterates over the failed callbacks in the order of their keys
+func (k Keeper) IterateFailedCallbacks(ctx sdk.Context, cb func(callback types.FailedCallback) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyFailedCallbackPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var callback types.FailedCallback
+		k.cdc.MustUnmarshal(iterator.Value(), &callback)
+		if cb(callback) {
+			break
+		}
+	}
+}
+
+// SudoCallback calls the callback of a contract with at most the callback gas limit, its state changes are
+// discarded if it fails. A contract rejected by the contract policy is not called and ErrContractNotAllowed
+// is returned.
+func (k Keeper) SudoCallback(ctx sdk.Context, contract sdk.AccAddress, sudoMsg []byte) error {
+	if k.contractKeeper == nil {
+		return errorsmod.Wrap(types.ErrCallbackFailed, "contract keeper not set")
+	}
+	if err := k.CheckContractPolicy(ctx, contract); err != nil {
+		return err
+	}
+
+	return k.RunWithGasLimit(ctx, k.GetParams(ctx).CallbackGasLimit, func(ctx sdk.Context) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, err := k.contractKeeper.Sudo(cacheCtx, contract, sudoMsg); err != nil {
+			return err
+		}
+		writeCache()
+		return nil
+	})
+}
+
+// RetryFailedCallback calls again the contract of a failed callback, the failed callback is removed if it succeeds.
+func (k Keeper) RetryFailedCallback(ctx sdk.Context, port, channel string, packetSequence uint64) (types.FailedCallback, error) {
+	callback, found := k.GetFailedCallback(ctx, port, channel, packetSequence)
+	if !found {
+		return callback, errorsmod.Wrapf(types.ErrCallbackNotFound, "sequence %d on %s/%s", packetSequence, port, channel)
+	}
+	contract, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return callback, err
+	}
+
+	if err := k.SudoCallback(ctx, contract, []byte(callback.SudoMsg)); err != nil {
+		return callback, errorsmod.Wrap(types.ErrCallbackFailed, err.Error())
+	}
+
+	k.DeleteFailedCallback(ctx, port, channel, packetSequence)
+	return callback, nil
+}
+
+// deterministicError returns the codespace and code of an error, its message may not be the same on all the nodes
+func deterministicError(err error) string {
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	return fmt.Sprintf("codespace: %s, code: %d", codespace, code)
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/keeper"
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+func TestRetryCallback(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app).WithBlockTime(time.Now())
+	k := app.IBCHooksKeeper
+
+	wasmCode, err := os.ReadFile("../../../tests/ibc-hooks/bytecode/counter.wasm")
+	require.NoError(t, err)
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName)
+	contractKeeper := wasmkeeper.NewGovPermissionKeeper(app.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(ctx, govAddress, wasmCode, nil)
+	require.NoError(t, err)
+	counter, _, err := contractKeeper.Instantiate(ctx, codeID, govAddress, govAddress, []byte(`{"count": 0}`), "counter", nil)
+	require.NoError(t, err)
+
+	timeoutMsg := []byte(`{"ibc_lifecycle_complete": {"ibc_timeout": {"channel": "channel-0", "sequence": 1}}}`)
+	k.StoreFailedCallback(ctx, "transfer", "channel-0", 1, counter.String(), timeoutMsg, types.ErrCallbackFailed)
+	k.StoreFailedCallback(ctx, "transfer", "channel-0", 2, counter.String(), []byte(`{"unknown": {}}`), types.ErrCallbackFailed)
+
+	callback, found := k.GetFailedCallback(ctx, "transfer", "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight(), callback.Height)
+	require.Equal(t, "codespace: wasm-hooks, code: 9", callback.Error)
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+	sender := sdk.AccAddress([]byte("sender______________")).String()
+
+	// a callback the contract cannot process stays failed
+	_, err = msgServer.RetryCallback(sdk.WrapSDKContext(ctx), types.NewMsgRetryCallback(sender, "transfer", "channel-0", 2))
+	require.ErrorIs(t, err, types.ErrCallbackFailed)
+	_, found = k.GetFailedCallback(ctx, "transfer", "channel-0", 2)
+	require.True(t, found)
+
+	_, err = msgServer.RetryCallback(sdk.WrapSDKContext(ctx), types.NewMsgRetryCallback(sender, "transfer", "channel-0", 3))
+	require.ErrorIs(t, err, types.ErrCallbackNotFound)
+
+	// a callback running out of gas stays failed
+	k.SetParams(ctx, types.NewParams(types.DefaultExecuteGasLimit, 10_000))
+	_, err = msgServer.RetryCallback(sdk.WrapSDKContext(ctx), types.NewMsgRetryCallback(sender, "transfer", "channel-0", 1))
+	require.ErrorIs(t, err, types.ErrCallbackFailed)
+	require.ErrorContains(t, err, types.ErrGasLimitExceeded.Error())
+	_, found = k.GetFailedCallback(ctx, "transfer", "channel-0", 1)
+	require.True(t, found)
+	k.SetParams(ctx, types.DefaultParams())
+
+	// the timeout callback increments the counter of the contract by 10
+	_, err = msgServer.RetryCallback(sdk.WrapSDKContext(ctx), types.NewMsgRetryCallback(sender, "transfer", "channel-0", 1))
+	require.NoError(t, err)
+	events := ctx.EventManager().ABCIEvents()
+	event, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.NoError(t, err)
+	require.Equal(t, &types.EventRetryCallback{Signer: sender, Contract: counter.String(), PortID: "transfer", ChannelID: "channel-0", Sequence: 1}, event)
+	_, found = k.GetFailedCallback(ctx, "transfer", "channel-0", 1)
+	require.False(t, found)
+
+	res, err := app.WasmKeeper.QuerySmart(ctx, counter, []byte(fmt.Sprintf(`{"get_count": {"addr": "%s"}}`, counter)))
+	require.NoError(t, err)
+	require.Equal(t, `{"count":10}`, string(res))
+}
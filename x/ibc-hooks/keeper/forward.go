@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	routerkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
+	routertypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+// ReceivedDenom returns the denom of the funds received by the intermediate sender for the voucher denom of a packet
+// received on a channel, the transfer middleware mints the native denom of the vouchers of its parachain channels
+func (k Keeper) ReceivedDenom(ctx sdk.Context, channel, voucherDenom string) string {
+	if k.transferMiddlewareKeeper == nil {
+		return voucherDenom
+	}
+
+	info, found := k.transferMiddlewareKeeper.GetParachainIBCTokenInfoByIBCDenom(ctx, voucherDenom)
+	if !found || info.ChannelID != channel {
+		return voucherDenom
+	}
+	return info.NativeDenom
+}
+
+// GetIntermediateBalances returns the balances of the intermediate sender executing a contract
+func (k Keeper) GetIntermediateBalances(ctx sdk.Context, sender sdk.AccAddress) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, sender)
+}
+
+// ForwardReturnedFunds forwards the funds returned by a contract to the intermediate sender of a received packet,
+// its balances before the packet was received are not forwarded. The acknowledgement of the packet is written by
+// the packet forward middleware once the forward is acknowledged, a failed forward can't be refunded to the
+// original sender since the contract was executed: the packet is acknowledged successfully and the funds of the
+// forward are refunded to the contract, see RefundForward. It returns the forwarded token and the sequence of the
+// forward.
+func (k Keeper) ForwardReturnedFunds(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	contract sdk.AccAddress,
+	originalSender string,
+	sender sdk.AccAddress,
+	balancesBefore sdk.Coins,
+	metadata *routertypes.ForwardMetadata,
+) (sdk.Coin, uint64, error) {
+	if k.forwardKeeper == nil || k.refundApp == nil {
+		return sdk.Coin{}, 0, errorsmod.Wrap(types.ErrForward, "packet forward middleware not configured")
+	}
+
+	returned, hasNeg := k.bankKeeper.GetAllBalances(ctx, sender).SafeSub(balancesBefore...)
+	if hasNeg {
+		return sdk.Coin{}, 0, errorsmod.Wrapf(types.ErrForward, "contract spent the funds of %s", sender)
+	}
+	if len(returned) != 1 {
+		return sdk.Coin{}, 0, errorsmod.Wrapf(types.ErrForward, "contract must return a single denom, got %q", returned)
+	}
+
+	timeout := time.Duration(metadata.Timeout)
+	if timeout <= 0 {
+		timeout = routerkeeper.DefaultForwardTransferPacketTimeoutTimestamp
+	}
+	var retries uint8
+	if metadata.Retries != nil {
+		retries = *metadata.Retries
+	}
+	// the forward is the next packet sent on the channel
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, metadata.Port, metadata.Channel)
+	if !found {
+		return sdk.Coin{}, 0, errorsmod.Wrapf(types.ErrForward, "channel %s/%s not found", metadata.Port, metadata.Channel)
+	}
+
+	token := returned[0]
+	err := k.forwardKeeper.ForwardTransferPacket(ctx, nil, packet, originalSender, sender.String(), metadata, token, retries, timeout, []metrics.Label{}, true)
+	if err != nil {
+		return sdk.Coin{}, 0, errorsmod.Wrap(types.ErrForward, err.Error())
+	}
+	k.SetForwardRefund(ctx, types.ForwardRefund{
+		PortID:    metadata.Port,
+		ChannelID: metadata.Channel,
+		Sequence:  sequence,
+		Contract:  contract.String(),
+		Sender:    sender.String(),
+	})
+	return token, sequence, nil
+}
+
+// GetNextSequenceSend returns the sequence of the next packet sent on a channel, 0 if the channel is not found
+func (k Keeper) GetNextSequenceSend(ctx sdk.Context, port, channel string) uint64 {
+	sequence, _ := k.channelKeeper.GetNextSequenceSend(ctx, port, channel)
+	return sequence
+}
+
+// SetForwardRefund records the forward of the funds returned by a contract until it is acknowledged
+func (k Keeper) SetForwardRefund(ctx sdk.Context, forward types.ForwardRefund) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetForwardRefundKey(forward.PortID, forward.ChannelID, forward.Sequence), k.cdc.MustMarshal(&forward))
+}
+
+func (k Keeper) GetForwardRefund(ctx sdk.Context, port, channel string, packetSequence uint64) (types.ForwardRefund, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetForwardRefundKey(port, channel, packetSequence))
+	if bz == nil {
+		return types.ForwardRefund{}, false
+	}
+
+	var forward types.ForwardRefund
+	k.cdc.MustUnmarshal(bz, &forward)
+	return forward, true
+}
+
+func (k Keeper) DeleteForwardRefund(ctx sdk.Context, port, channel string, packetSequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetForwardRefundKey(port, channel, packetSequence))
+}
+
+// IterateForwardRefunds iterates over the forwards waiting for their acknowledgement in the order of their keys
+func (k Keeper) IterateForwardRefunds(ctx sdk.Context, cb func(forward types.ForwardRefund) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyForwardRefundPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var forward types.ForwardRefund
+		k.cdc.MustUnmarshal(iterator.Value(), &forward)
+		if cb(forward) {
+			break
+		}
+	}
+}
+
+// RefundForward refunds a forward which failed or timed out to its contract. refund calls the acknowledgement or
+// the timeout of the forward on the transfer application below the packet forward middleware, which refunds the
+// intermediate sender, and the refunded funds are sent to the contract.
+func (k Keeper) RefundForward(ctx sdk.Context, forward types.ForwardRefund, refund func(app porttypes.IBCModule) error) (sdk.Coins, error) {
+	sender, err := sdk.AccAddressFromBech32(forward.Sender)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := sdk.AccAddressFromBech32(forward.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	balancesBefore := k.bankKeeper.GetAllBalances(ctx, sender)
+	if err := refund(k.refundApp); err != nil {
+		return nil, err
+	}
+	refunded, hasNeg := k.bankKeeper.GetAllBalances(ctx, sender).SafeSub(balancesBefore...)
+	if hasNeg || refunded.IsZero() {
+		return sdk.Coins{}, nil
+	}
+	if err := k.bankKeeper.SendCoins(ctx, sender, contract, refunded); err != nil {
+		return nil, errorsmod.Wrap(err, "refund the forward to the contract")
+	}
+	return refunded, nil
+}
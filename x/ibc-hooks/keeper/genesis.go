@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+// InitGenesis restores the params, the packet callbacks pending and failed, the async ack packets and the
+// forwards waiting for their acknowledgement of the exported chain
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, callback := range genState.PacketCallbacks {
+		k.StorePacketCallback(ctx, callback.PortID, callback.ChannelID, callback.Sequence, callback.Contract)
+	}
+	for _, callback := range genState.FailedCallbacks {
+		k.SetFailedCallback(ctx, callback)
+	}
+	for _, packet := range genState.AsyncAckPackets {
+		k.SetAsyncAckPacket(ctx, packet)
+	}
+	for _, forward := range genState.ForwardRefunds {
+		k.SetForwardRefund(ctx, forward)
+	}
+}
+
+// ExportGenesis returns the params, the pending and failed packet callbacks, the async ack packets and the
+// forwards waiting for their acknowledgement
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genesis := types.DefaultGenesisState()
+	genesis.Params = k.GetParams(ctx)
+	k.IteratePacketCallbacks(ctx, func(callback types.PacketCallback) (stop bool) {
+		genesis.PacketCallbacks = append(genesis.PacketCallbacks, callback)
+		return false
+	})
+	k.IterateFailedCallbacks(ctx, func(callback types.FailedCallback) (stop bool) {
+		genesis.FailedCallbacks = append(genesis.FailedCallbacks, callback)
+		return false
+	})
+	k.IterateAsyncAckPackets(ctx, func(packet types.AsyncAckPacket) (stop bool) {
+		genesis.AsyncAckPackets = append(genesis.AsyncAckPackets, packet)
+		return false
+	})
+	k.IterateForwardRefunds(ctx, func(forward types.ForwardRefund) (stop bool) {
+		genesis.ForwardRefunds = append(genesis.ForwardRefunds, forward)
+		return false
+	})
+	return genesis
+}
@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+var (
+	contractA = sdk.AccAddress([]byte("contract_a__________")).String()
+	contractB = sdk.AccAddress([]byte("contract_b__________")).String()
+)
+
+// asyncAckPacket returns a transfer packet received on a channel and waiting for the ack of a contract.
+func asyncAckPacket(channel string, sequence uint64, contract string) types.AsyncAckPacket {
+	packet := channeltypes.NewPacket([]byte(`{}`), sequence, "transfer", "channel-7", "transfer", channel, clienttypes.NewHeight(1, 100), 0)
+	return types.AsyncAckPacket{Packet: packet, Contract: contract, IbcAck: []byte(`{"result":"AQ=="}`), Height: 3}
+}
+
+func TestGenesis(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+
+	genesis := types.GenesisState{
+		Params: types.NewParams(500_000, 200_000),
+		PacketCallbacks: []types.PacketCallback{
+			{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA},
+			{PortID: "transfer", ChannelID: "channel-0", Sequence: 12, Contract: contractB},
+			{PortID: "transfer", ChannelID: "channel-1", Sequence: 3, Contract: contractA},
+			{PortID: "wasm." + contractA, ChannelID: "channel-0", Sequence: 1, Contract: contractB},
+		},
+		FailedCallbacks: []types.FailedCallback{
+			{PortID: "transfer", ChannelID: "channel-0", Sequence: 2, Contract: contractA, SudoMsg: `{"ibc_lifecycle_complete":{}}`, Error: "codespace: wasm, code: 5", Height: 4},
+			// the same packet can have a failed and a pending callback
+			{PortID: "transfer", ChannelID: "channel-1", Sequence: 3, Contract: contractB, SudoMsg: `{}`, Height: 5},
+		},
+		AsyncAckPackets: []types.AsyncAckPacket{
+			asyncAckPacket("channel-0", 1, contractA),
+			asyncAckPacket("channel-1", 1, contractB),
+		},
+		ForwardRefunds: []types.ForwardRefund{
+			{PortID: "transfer", ChannelID: "channel-0", Sequence: 7, Contract: contractA, Sender: contractB},
+			{PortID: "transfer", ChannelID: "channel-1", Sequence: 7, Contract: contractB, Sender: contractA},
+		},
+	}
+	require.NoError(t, genesis.Validate())
+
+	app.IBCHooksKeeper.InitGenesis(ctx, genesis)
+	require.Equal(t, contractB, app.IBCHooksKeeper.GetPacketCallback(ctx, "transfer", "channel-0", 12))
+	require.Equal(t, contractB, app.IBCHooksKeeper.GetPacketCallback(ctx, "wasm."+contractA, "channel-0", 1))
+	require.ElementsMatch(t, genesis.PacketCallbacks, app.IBCHooksKeeper.ExportGenesis(ctx).PacketCallbacks)
+	require.ElementsMatch(t, genesis.FailedCallbacks, app.IBCHooksKeeper.ExportGenesis(ctx).FailedCallbacks)
+	require.ElementsMatch(t, genesis.AsyncAckPackets, app.IBCHooksKeeper.ExportGenesis(ctx).AsyncAckPackets)
+	require.ElementsMatch(t, genesis.ForwardRefunds, app.IBCHooksKeeper.ExportGenesis(ctx).ForwardRefunds)
+	require.Equal(t, genesis.Params, app.IBCHooksKeeper.ExportGenesis(ctx).Params)
+	packet, found := app.IBCHooksKeeper.GetAsyncAckPacket(ctx, "transfer", "channel-1", 1)
+	require.True(t, found)
+	require.Equal(t, genesis.AsyncAckPackets[1], packet)
+
+	// a processed callback is not exported
+	app.IBCHooksKeeper.DeletePacketCallback(ctx, "transfer", "channel-0", 1)
+	require.ElementsMatch(t, genesis.PacketCallbacks[1:], app.IBCHooksKeeper.ExportGenesis(ctx).PacketCallbacks)
+}
+
+func TestGenesisValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		callbacks []types.PacketCallback
+		valid     bool
+	}{
+		{"default", nil, true},
+		{"valid", []types.PacketCallback{{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA}, {PortID: "transfer", ChannelID: "channel-1", Sequence: 1, Contract: contractA}}, true},
+		{"same packet on other ports", []types.PacketCallback{{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA}, {PortID: "wasm." + contractA, ChannelID: "channel-0", Sequence: 1, Contract: contractA}}, true},
+		{"invalid port", []types.PacketCallback{{PortID: "", ChannelID: "channel-0", Sequence: 1, Contract: contractA}}, false},
+		{"invalid channel", []types.PacketCallback{{PortID: "transfer", ChannelID: "", Sequence: 1, Contract: contractA}}, false},
+		{"zero sequence", []types.PacketCallback{{PortID: "transfer", ChannelID: "channel-0", Sequence: 0, Contract: contractA}}, false},
+		{"invalid contract", []types.PacketCallback{{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: "contract"}}, false},
+		{"duplicated packet", []types.PacketCallback{{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA}, {PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractB}}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.GenesisState{Params: types.DefaultParams(), PacketCallbacks: tc.callbacks}.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidateFailedCallbacks(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		callbacks []types.FailedCallback
+		valid     bool
+	}{
+		{"valid", []types.FailedCallback{{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA, SudoMsg: `{}`}, {PortID: "transfer", ChannelID: "channel-0", Sequence: 2, Contract: contractA, SudoMsg: `{}`}}, true},
+		{"invalid port", []types.FailedCallback{{PortID: "", ChannelID: "channel-0", Sequence: 1, Contract: contractA, SudoMsg: `{}`}}, false},
+		{"zero sequence", []types.FailedCallback{{PortID: "transfer", ChannelID: "channel-0", Sequence: 0, Contract: contractA, SudoMsg: `{}`}}, false},
+		{"invalid contract", []types.FailedCallback{{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: "contract", SudoMsg: `{}`}}, false},
+		{"invalid sudo message", []types.FailedCallback{{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA, SudoMsg: `{`}}, false},
+		{"duplicated packet", []types.FailedCallback{{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA, SudoMsg: `{}`}, {PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractB, SudoMsg: `{}`}}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.GenesisState{Params: types.DefaultParams(), FailedCallbacks: tc.callbacks}.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidateAsyncAckPackets(t *testing.T) {
+	invalidPacket := asyncAckPacket("channel-0", 1, contractA)
+	invalidPacket.Packet.Sequence = 0
+
+	for _, tc := range []struct {
+		name    string
+		packets []types.AsyncAckPacket
+		valid   bool
+	}{
+		{"valid", []types.AsyncAckPacket{asyncAckPacket("channel-0", 1, contractA), asyncAckPacket("channel-0", 2, contractA)}, true},
+		{"invalid packet", []types.AsyncAckPacket{invalidPacket}, false},
+		{"invalid contract", []types.AsyncAckPacket{asyncAckPacket("channel-0", 1, "contract")}, false},
+		{"duplicated packet", []types.AsyncAckPacket{asyncAckPacket("channel-0", 1, contractA), asyncAckPacket("channel-0", 1, contractB)}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.GenesisState{Params: types.DefaultParams(), AsyncAckPackets: tc.packets}.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidateForwardRefunds(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		forwards []types.ForwardRefund
+		valid    bool
+	}{
+		{"valid", []types.ForwardRefund{{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA, Sender: contractB}, {PortID: "transfer", ChannelID: "channel-0", Sequence: 2, Contract: contractA, Sender: contractB}}, true},
+		{"zero sequence", []types.ForwardRefund{{PortID: "transfer", ChannelID: "channel-0", Sequence: 0, Contract: contractA, Sender: contractB}}, false},
+		{"invalid contract", []types.ForwardRefund{{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: "contract", Sender: contractB}}, false},
+		{"invalid sender", []types.ForwardRefund{{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA, Sender: "sender"}}, false},
+		{"duplicated packet", []types.ForwardRefund{{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA, Sender: contractB}, {PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractB, Sender: contractA}}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.GenesisState{Params: types.DefaultParams(), ForwardRefunds: tc.forwards}.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidateParams(t *testing.T) {
+	require.NoError(t, types.DefaultGenesisState().Validate())
+	require.Error(t, types.GenesisState{Params: types.NewParams(0, types.DefaultCallbackGasLimit)}.Validate())
+	require.Error(t, types.GenesisState{Params: types.NewParams(types.DefaultExecuteGasLimit, 0)}.Validate())
+
+	for _, tc := range []struct {
+		name   string
+		modify func(p *types.Params)
+		valid  bool
+	}{
+		{"allowlist", func(p *types.Params) {
+			p.ContractPolicy = types.CONTRACT_POLICY_ALLOWLIST
+			p.AllowedCodeIDs = []uint64{1, 2}
+			p.AllowedContracts = []string{contractA}
+			p.DisabledContracts = []string{contractB}
+		}, true},
+		{"invalid policy", func(p *types.Params) { p.ContractPolicy = 2 }, false},
+		{"zero code id", func(p *types.Params) { p.AllowedCodeIDs = []uint64{0} }, false},
+		{"duplicated code id", func(p *types.Params) { p.AllowedCodeIDs = []uint64{1, 1} }, false},
+		{"invalid allowed contract", func(p *types.Params) { p.AllowedContracts = []string{"contract"} }, false},
+		{"duplicated allowed contract", func(p *types.Params) { p.AllowedContracts = []string{contractA, contractA} }, false},
+		{"invalid disabled contract", func(p *types.Params) { p.DisabledContracts = []string{"contract"} }, false},
+		{"duplicated disabled contract", func(p *types.Params) { p.DisabledContracts = []string{contractB, contractB} }, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+			err := types.GenesisState{Params: params}.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the gas limits of the contracts
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// PacketCallbacks lists the pending packet callbacks of a contract, a port and/or a channel
+func (k Keeper) PacketCallbacks(c context.Context, req *types.QueryPacketCallbacksRequest) (*types.QueryPacketCallbacksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the callbacks of a channel of a port share the prefix of their keys
+	keyPrefix := types.KeyPacketCallbackPrefix
+	if req.PortID != "" && req.ChannelID != "" {
+		keyPrefix = GetPacketChannelKey(req.PortID, req.ChannelID)
+	}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	callbacks := []types.PacketCallback{}
+	pageRes, err := sdkquery.FilteredPaginate(prefixStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		if req.Contract != "" && string(value) != req.Contract {
+			return false, nil
+		}
+		callback, err := ParsePacketCallback(append(append([]byte{}, keyPrefix...), key...), value)
+		if err != nil {
+			return false, err
+		}
+		if (req.PortID != "" && callback.PortID != req.PortID) || (req.ChannelID != "" && callback.ChannelID != req.ChannelID) {
+			return false, nil
+		}
+		if accumulate {
+			callbacks = append(callbacks, callback)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPacketCallbacksResponse{
+		Callbacks:  callbacks,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) PacketCallback(c context.Context, req *types.QueryPacketCallbackRequest) (*types.QueryPacketCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	contract := k.GetPacketCallback(ctx, req.PortID, req.ChannelID, req.Sequence)
+	if contract == "" {
+		return nil, errorsmod.Wrapf(types.ErrPacketCallbackNotFound, "sequence %d on %s/%s", req.Sequence, req.PortID, req.ChannelID)
+	}
+
+	return &types.QueryPacketCallbackResponse{Callback: types.PacketCallback{
+		PortID:    req.PortID,
+		ChannelID: req.ChannelID,
+		Sequence:  req.Sequence,
+		Contract:  contract,
+	}}, nil
+}
+
+// IntermediateSender derives the address executing the contracts for the original sender of packets
+// received on a channel, with the account prefix of the chain
+func (k Keeper) IntermediateSender(_ context.Context, req *types.QueryIntermediateSenderRequest) (*types.QueryIntermediateSenderResponse, error) {
+	if err := host.ChannelIdentifierValidator(req.ChannelID); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if req.OriginalSender == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty original sender")
+	}
+
+	address, err := DeriveIntermediateSender(req.ChannelID, req.OriginalSender, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIntermediateSenderResponse{Address: address}, nil
+}
+
+// ValidateMemo parses the wasm memo of a packet without executing it, a memo which would be rejected
+// on receive returns its validation error in the response
+func (k Keeper) ValidateMemo(_ context.Context, req *types.QueryValidateMemoRequest) (*types.QueryValidateMemoResponse, error) {
+	isWasmRouted, contractAddr, msgBytes, err := types.ValidateAndParseMemo(req.Memo, req.Receiver)
+	if err != nil {
+		return &types.QueryValidateMemoResponse{IsWasmRouted: isWasmRouted, Error: err.Error()}, nil
+	}
+	if !isWasmRouted {
+		return &types.QueryValidateMemoResponse{}, nil
+	}
+
+	return &types.QueryValidateMemoResponse{
+		IsWasmRouted: true,
+		Contract:     contractAddr.String(),
+		Msg:          string(msgBytes),
+	}, nil
+}
+
+// FailedCallbacks lists the failed callbacks, optionally of a contract only
+func (k Keeper) FailedCallbacks(c context.Context, req *types.QueryFailedCallbacksRequest) (*types.QueryFailedCallbacksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyFailedCallbackPrefix)
+
+	callbacks := []types.FailedCallback{}
+	pageRes, err := sdkquery.FilteredPaginate(prefixStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var callback types.FailedCallback
+		if err := k.cdc.Unmarshal(value, &callback); err != nil {
+			return false, err
+		}
+		if req.Contract != "" && callback.Contract != req.Contract {
+			return false, nil
+		}
+		if accumulate {
+			callbacks = append(callbacks, callback)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFailedCallbacksResponse{
+		Callbacks:  callbacks,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) FailedCallback(c context.Context, req *types.QueryFailedCallbackRequest) (*types.QueryFailedCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	callback, found := k.GetFailedCallback(ctx, req.PortID, req.ChannelID, req.Sequence)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCallbackNotFound, "sequence %d on %s/%s", req.Sequence, req.PortID, req.ChannelID)
+	}
+
+	return &types.QueryFailedCallbackResponse{Callback: callback}, nil
+}
+
+// AsyncAckPackets lists the packets waiting for the acknowledgement of their contract, optionally of a contract only
+func (k Keeper) AsyncAckPackets(c context.Context, req *types.QueryAsyncAckPacketsRequest) (*types.QueryAsyncAckPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyAsyncAckPacketPrefix)
+
+	packets := []types.AsyncAckPacket{}
+	pageRes, err := sdkquery.FilteredPaginate(prefixStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var packet types.AsyncAckPacket
+		if err := k.cdc.Unmarshal(value, &packet); err != nil {
+			return false, err
+		}
+		if req.Contract != "" && packet.Contract != req.Contract {
+			return false, nil
+		}
+		if accumulate {
+			packets = append(packets, packet)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAsyncAckPacketsResponse{
+		Packets:    packets,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) AsyncAckPacket(c context.Context, req *types.QueryAsyncAckPacketRequest) (*types.QueryAsyncAckPacketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	packet, found := k.GetAsyncAckPacket(ctx, transfertypes.PortID, req.ChannelID, req.Sequence)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrAsyncAckNotFound, "sequence %d on %s/%s", req.Sequence, transfertypes.PortID, req.ChannelID)
+	}
+
+	return &types.QueryAsyncAckPacketResponse{Packet: packet}, nil
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/keeper"
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+func TestQueryPacketCallbacks(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	goCtx := sdk.WrapSDKContext(ctx)
+	k := app.IBCHooksKeeper
+	wasmPort := "wasm." + contractA
+
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 1, contractA)
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 256, contractB)
+	k.StorePacketCallback(ctx, "transfer", "channel-1", 1, contractA)
+	// channel-10 shares its first characters with channel-1
+	k.StorePacketCallback(ctx, "transfer", "channel-10", 1, contractB)
+	k.StorePacketCallback(ctx, wasmPort, "channel-1", 2, contractB)
+
+	for _, tc := range []struct {
+		name     string
+		req      types.QueryPacketCallbacksRequest
+		expected []types.PacketCallback
+	}{
+		{"all", types.QueryPacketCallbacksRequest{}, []types.PacketCallback{
+			{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA},
+			{PortID: "transfer", ChannelID: "channel-0", Sequence: 256, Contract: contractB},
+			{PortID: "transfer", ChannelID: "channel-1", Sequence: 1, Contract: contractA},
+			{PortID: "transfer", ChannelID: "channel-10", Sequence: 1, Contract: contractB},
+			{PortID: wasmPort, ChannelID: "channel-1", Sequence: 2, Contract: contractB},
+		}},
+		{"by contract", types.QueryPacketCallbacksRequest{Contract: contractA}, []types.PacketCallback{
+			{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA},
+			{PortID: "transfer", ChannelID: "channel-1", Sequence: 1, Contract: contractA},
+		}},
+		{"by channel", types.QueryPacketCallbacksRequest{ChannelID: "channel-1"}, []types.PacketCallback{
+			{PortID: "transfer", ChannelID: "channel-1", Sequence: 1, Contract: contractA},
+			{PortID: wasmPort, ChannelID: "channel-1", Sequence: 2, Contract: contractB},
+		}},
+		{"by port", types.QueryPacketCallbacksRequest{PortID: wasmPort}, []types.PacketCallback{
+			{PortID: wasmPort, ChannelID: "channel-1", Sequence: 2, Contract: contractB},
+		}},
+		{"by port and channel", types.QueryPacketCallbacksRequest{PortID: "transfer", ChannelID: "channel-1"}, []types.PacketCallback{
+			{PortID: "transfer", ChannelID: "channel-1", Sequence: 1, Contract: contractA},
+		}},
+		{"by contract and channel", types.QueryPacketCallbacksRequest{Contract: contractB, ChannelID: "channel-0"}, []types.PacketCallback{
+			{PortID: "transfer", ChannelID: "channel-0", Sequence: 256, Contract: contractB},
+		}},
+		{"none", types.QueryPacketCallbacksRequest{Contract: contractA, PortID: wasmPort}, []types.PacketCallback{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := k.PacketCallbacks(goCtx, &tc.req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res.Callbacks)
+		})
+	}
+
+	res, err := k.PacketCallbacks(goCtx, &types.QueryPacketCallbacksRequest{Contract: contractA, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketCallback{{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA}}, res.Callbacks)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+}
+
+func TestQueryPacketCallback(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	goCtx := sdk.WrapSDKContext(ctx)
+	k := app.IBCHooksKeeper
+
+	k.StorePacketCallback(ctx, "transfer", "channel-0", 1, contractA)
+
+	res, err := k.PacketCallback(goCtx, &types.QueryPacketCallbackRequest{PortID: "transfer", ChannelID: "channel-0", Sequence: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.PacketCallback{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA}, res.Callback)
+
+	_, err = k.PacketCallback(goCtx, &types.QueryPacketCallbackRequest{PortID: "transfer", ChannelID: "channel-0", Sequence: 2})
+	require.ErrorIs(t, err, types.ErrPacketCallbackNotFound)
+}
+
+func TestQueryIntermediateSender(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	goCtx := sdk.WrapSDKContext(helpers.NewContextForApp(*app))
+	k := app.IBCHooksKeeper
+	originalSender := "juno12smx2wdlyttvyzvzg54y2vnqwq2qjatezqwqxu"
+
+	expected, err := keeper.DeriveIntermediateSender("channel-42", originalSender, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	require.NoError(t, err)
+	res, err := k.IntermediateSender(goCtx, &types.QueryIntermediateSenderRequest{ChannelID: "channel-42", OriginalSender: originalSender})
+	require.NoError(t, err)
+	require.Equal(t, expected, res.Address)
+
+	// the sender of another channel executes the contracts from another address
+	res, err = k.IntermediateSender(goCtx, &types.QueryIntermediateSenderRequest{ChannelID: "channel-43", OriginalSender: originalSender})
+	require.NoError(t, err)
+	require.NotEqual(t, expected, res.Address)
+
+	_, err = k.IntermediateSender(goCtx, &types.QueryIntermediateSenderRequest{ChannelID: "channel/42", OriginalSender: originalSender})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = k.IntermediateSender(goCtx, &types.QueryIntermediateSenderRequest{ChannelID: "channel-42"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestQueryValidateMemo(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	goCtx := sdk.WrapSDKContext(helpers.NewContextForApp(*app))
+	k := app.IBCHooksKeeper
+
+	for _, tc := range []struct {
+		name     string
+		memo     string
+		receiver string
+		expected types.QueryValidateMemoResponse
+		err      string
+	}{
+		{"valid", fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{"increment":{}}}}`, contractA), contractA, types.QueryValidateMemoResponse{IsWasmRouted: true, Contract: contractA, Msg: `{"increment":{}}`}, ""},
+		{"async ack", fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{"increment":{}},"async_ack":true}}`, contractA), contractA, types.QueryValidateMemoResponse{IsWasmRouted: true, Contract: contractA, Msg: `{"increment":{}}`}, ""},
+		{"empty memo", "", contractA, types.QueryValidateMemoResponse{}, ""},
+		{"not wasm routed", `{"forward":{}}`, contractA, types.QueryValidateMemoResponse{}, ""},
+		{"other receiver", fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{}}}`, contractA), contractB, types.QueryValidateMemoResponse{IsWasmRouted: true}, "should be the same as the receiver"},
+		{"no message", fmt.Sprintf(`{"wasm":{"contract":"%s"}}`, contractA), contractA, types.QueryValidateMemoResponse{IsWasmRouted: true}, `Could not find key wasm["msg"]`},
+		{"invalid contract", `{"wasm":{"contract":"contract","msg":{}}}`, "contract", types.QueryValidateMemoResponse{IsWasmRouted: true}, "not a valid bech32 address"},
+		{"invalid async ack", fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{},"async_ack":"yes"}}`, contractA), contractA, types.QueryValidateMemoResponse{IsWasmRouted: true}, "is not a boolean"},
+		{"packet context", fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{"increment":{}},"packet_context":true}}`, contractA), contractA, types.QueryValidateMemoResponse{IsWasmRouted: true, Contract: contractA, Msg: `{"increment":{}}`}, ""},
+		{"invalid packet context", fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{},"packet_context":1}}`, contractA), contractA, types.QueryValidateMemoResponse{IsWasmRouted: true}, `wasm["packet_context"] is not a boolean`},
+		{"forward", fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{"increment":{}}},"forward":{"receiver":"%s","port":"transfer","channel":"channel-1","timeout":"10m"}}`, contractA, contractB), contractA, types.QueryValidateMemoResponse{IsWasmRouted: true, Contract: contractA, Msg: `{"increment":{}}`}, ""},
+		{"invalid forward", fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{}},"forward":{"receiver":"%s","port":"transfer"}}`, contractA, contractB), contractA, types.QueryValidateMemoResponse{IsWasmRouted: true}, "failed to validate metadata"},
+		{"async ack forward", fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{},"async_ack":true},"forward":{"receiver":"%s","port":"transfer","channel":"channel-1"}}`, contractA, contractB), contractA, types.QueryValidateMemoResponse{IsWasmRouted: true}, "cannot be set with a forward"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := k.ValidateMemo(goCtx, &types.QueryValidateMemoRequest{Memo: tc.memo, Receiver: tc.receiver})
+			require.NoError(t, err)
+			require.Contains(t, res.Error, tc.err)
+			res.Error = ""
+			require.Equal(t, tc.expected, *res)
+		})
+	}
+}
+
+func TestQueryFailedCallbacks(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	goCtx := sdk.WrapSDKContext(ctx)
+	k := app.IBCHooksKeeper
+
+	callbacks := []types.FailedCallback{
+		{PortID: "transfer", ChannelID: "channel-0", Sequence: 1, Contract: contractA, SudoMsg: `{}`, Error: "codespace: wasm, code: 5"},
+		{PortID: "transfer", ChannelID: "channel-0", Sequence: 2, Contract: contractB, SudoMsg: `{}`, Error: "codespace: wasm, code: 5"},
+		{PortID: "transfer", ChannelID: "channel-1", Sequence: 1, Contract: contractA, SudoMsg: `{}`, Error: "codespace: sdk, code: 11"},
+	}
+	for _, callback := range callbacks {
+		k.SetFailedCallback(ctx, callback)
+	}
+
+	res, err := k.FailedCallbacks(goCtx, &types.QueryFailedCallbacksRequest{})
+	require.NoError(t, err)
+	require.Equal(t, callbacks, res.Callbacks)
+
+	res, err = k.FailedCallbacks(goCtx, &types.QueryFailedCallbacksRequest{Contract: contractA, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, callbacks[:1], res.Callbacks)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+
+	callbackRes, err := k.FailedCallback(goCtx, &types.QueryFailedCallbackRequest{PortID: "transfer", ChannelID: "channel-1", Sequence: 1})
+	require.NoError(t, err)
+	require.Equal(t, callbacks[2], callbackRes.Callback)
+
+	_, err = k.FailedCallback(goCtx, &types.QueryFailedCallbackRequest{PortID: "transfer", ChannelID: "channel-1", Sequence: 2})
+	require.ErrorIs(t, err, types.ErrCallbackNotFound)
+}
+
+func TestQueryAsyncAckPackets(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	goCtx := sdk.WrapSDKContext(ctx)
+	k := app.IBCHooksKeeper
+
+	packets := []types.AsyncAckPacket{
+		asyncAckPacket("channel-0", 1, contractA),
+		asyncAckPacket("channel-0", 2, contractB),
+		asyncAckPacket("channel-1", 1, contractA),
+	}
+	for _, packet := range packets {
+		k.SetAsyncAckPacket(ctx, packet)
+	}
+
+	res, err := k.AsyncAckPackets(goCtx, &types.QueryAsyncAckPacketsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, packets, res.Packets)
+
+	res, err = k.AsyncAckPackets(goCtx, &types.QueryAsyncAckPacketsRequest{Contract: contractA, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, packets[:1], res.Packets)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+
+	packetRes, err := k.AsyncAckPacket(goCtx, &types.QueryAsyncAckPacketRequest{ChannelID: "channel-0", Sequence: 2})
+	require.NoError(t, err)
+	require.Equal(t, packets[1], packetRes.Packet)
+
+	_, err = k.AsyncAckPacket(goCtx, &types.QueryAsyncAckPacketRequest{ChannelID: "channel-1", Sequence: 2})
+	require.ErrorIs(t, err, types.ErrAsyncAckNotFound)
+}
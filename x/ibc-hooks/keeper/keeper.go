@@ -2,55 +2,247 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
 
 	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
 )
 
 type (
 	Keeper struct {
-		storeKey storetypes.StoreKey
+		storeKey       storetypes.StoreKey
+		cdc            codec.BinaryCodec
+		contractKeeper types.ContractKeeper
+		bankKeeper     types.BankKeeper
+		channelKeeper  types.ChannelKeeper
+		scopedKeeper   types.ScopedKeeper
+		transferKeeper types.TransferKeeper
+		ics4Wrapper    porttypes.ICS4Wrapper
+		// the transfer middleware, the packet forward and the rate limit keepers are optional
+		transferMiddlewareKeeper types.TransferMiddlewareKeeper
+		forwardKeeper            types.ForwardKeeper
+		rateLimitKeeper          types.RateLimitKeeper
+		// refundApp refunds the forwards which failed, it is the transfer application below the packet forward
+		// middleware
+		refundApp porttypes.IBCModule
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
 	}
 )
 
 // NewKeeper returns a new instance of the x/ibchooks keeper
 func NewKeeper(
 	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	bankKeeper types.BankKeeper,
+	channelKeeper types.ChannelKeeper,
+	scopedKeeper types.ScopedKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
-		storeKey: storeKey,
+		storeKey:      storeKey,
+		cdc:           cdc,
+		bankKeeper:    bankKeeper,
+		channelKeeper: channelKeeper,
+		scopedKeeper:  scopedKeeper,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the x/ibchooks module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetParams sets the x/ibchooks module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&p))
+}
+
+// GetParams returns the current x/ibchooks module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return p
+	}
+
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
+
+// SetContractKeeper sets the keeper calling the callbacks, the wasm keeper is created after the ibc-hooks keeper
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
+// SetTransferKeeper sets the keeper tracking the escrowed tokens, the transfer keeper is created after the
+// ibc-hooks keeper
+func (k *Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
+	k.transferKeeper = transferKeeper
+}
+
+// SetICS4Wrapper sets the wrapper writing the async acks, it calls the hooks so it is created after the
+// ibc-hooks keeper
+func (k *Keeper) SetICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = ics4Wrapper
+}
+
+// SetTransferMiddlewareKeeper sets the keeper mapping the parachain vouchers to their native denoms, the transfer
+// middleware keeper is created after the ibc-hooks keeper
+func (k *Keeper) SetTransferMiddlewareKeeper(transferMiddlewareKeeper types.TransferMiddlewareKeeper) {
+	k.transferMiddlewareKeeper = transferMiddlewareKeeper
+}
+
+// SetForwardKeeper sets the packet forward keeper forwarding the funds returned by the contracts, it is created
+// after the ibc-hooks keeper
+func (k *Keeper) SetForwardKeeper(forwardKeeper types.ForwardKeeper) {
+	k.forwardKeeper = forwardKeeper
+}
+
+// SetRefundApp sets the transfer application refunding the failed forwards of the funds returned by the contracts,
+// the packet forward middleware does not refund them
+func (k *Keeper) SetRefundApp(refundApp porttypes.IBCModule) {
+	k.refundApp = refundApp
+}
+
+// SetRateLimitKeeper sets the keeper undoing the inflow of the packets refunded by an async ack, the rate limit
+// keeper is created after the ibc-hooks keeper
+func (k *Keeper) SetRateLimitKeeper(rateLimitKeeper types.RateLimitKeeper) {
+	k.rateLimitKeeper = rateLimitKeeper
+}
+
+// CheckContractPolicy returns ErrContractNotAllowed if the contract policy of the params rejects the contract
+// executed by a received packet or registered as the callback of a sent packet
+func (k Keeper) CheckContractPolicy(ctx sdk.Context, contract sdk.AccAddress) error {
+	var codeID uint64
+	if k.contractKeeper != nil {
+		if info := k.contractKeeper.GetContractInfo(ctx, contract); info != nil {
+			codeID = info.CodeID
+		}
+	}
+
+	if err := k.GetParams(ctx).IsContractAllowed(contract.String(), codeID); err != nil {
+		return errorsmod.Wrap(types.ErrContractNotAllowed, err.Error())
+	}
+	return nil
+}
+
+// RunWithGasLimit runs fn with a gas meter of gasLimit, running out of it returns ErrGasLimitExceeded.
+// The gas used is charged to the context afterwards, so the outcome only depends on gasLimit and not on
+// the gas left in the transaction, which may still run out of gas.
+func (k Keeper) RunWithGasLimit(ctx sdk.Context, gasLimit uint64, fn func(ctx sdk.Context) error) (err error) {
+	limitedCtx := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+	defer func() {
+		r := recover()
+		ctx.GasMeter().ConsumeGas(limitedCtx.GasMeter().GasConsumedToLimit(), "ibc-hooks contract")
+		if r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(types.ErrGasLimitExceeded, "gas limit %d", gasLimit)
+		} else if err != nil && limitedCtx.GasMeter().IsOutOfGas() {
+			// the wasm vm returns an error when it runs out of the gas left
+			err = errorsmod.Wrapf(types.ErrGasLimitExceeded, "gas limit %d: %s", gasLimit, err)
+		}
+	}()
+
+	return fn(limitedCtx)
+}
+
 // Logger returns a logger for the x/tokenfactory module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-func GetPacketKey(channel string, packetSequence uint64) []byte {
-	return []byte(fmt.Sprintf("%s::%d", channel, packetSequence))
+// GetPacketChannelKey returns the prefix of the keys of the packets sent on a channel of a port
+func GetPacketChannelKey(port, channel string) []byte {
+	return getChannelKey(types.KeyPacketCallbackPrefix, port, channel)
+}
+
+func GetPacketKey(port, channel string, packetSequence uint64) []byte {
+	return append(GetPacketChannelKey(port, channel), sdk.Uint64ToBigEndian(packetSequence)...)
+}
+
+func GetFailedCallbackKey(port, channel string, packetSequence uint64) []byte {
+	return append(getChannelKey(types.KeyFailedCallbackPrefix, port, channel), sdk.Uint64ToBigEndian(packetSequence)...)
+}
+
+func GetAsyncAckPacketKey(port, channel string, packetSequence uint64) []byte {
+	return append(getChannelKey(types.KeyAsyncAckPacketPrefix, port, channel), sdk.Uint64ToBigEndian(packetSequence)...)
+}
+
+func GetForwardRefundKey(port, channel string, packetSequence uint64) []byte {
+	return append(getChannelKey(types.KeyForwardRefundPrefix, port, channel), sdk.Uint64ToBigEndian(packetSequence)...)
+}
+
+func getChannelKey(keyPrefix []byte, port, channel string) []byte {
+	return append(append([]byte{}, keyPrefix...), []byte(fmt.Sprintf("%s/%s/", port, channel))...)
 }
 
 // StorePacketCallback stores which contract will be listening for the ack or timeout of a packet
-func (k Keeper) StorePacketCallback(ctx sdk.Context, channel string, packetSequence uint64, contract string) {
+func (k Keeper) StorePacketCallback(ctx sdk.Context, port, channel string, packetSequence uint64, contract string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(GetPacketKey(channel, packetSequence), []byte(contract))
+	store.Set(GetPacketKey(port, channel, packetSequence), []byte(contract))
 }
 
 // GetPacketCallback returns the bech32 addr of the contract that is expecting a callback from a packet
-func (k Keeper) GetPacketCallback(ctx sdk.Context, channel string, packetSequence uint64) string {
+func (k Keeper) GetPacketCallback(ctx sdk.Context, port, channel string, packetSequence uint64) string {
 	store := ctx.KVStore(k.storeKey)
-	return string(store.Get(GetPacketKey(channel, packetSequence)))
+	return string(store.Get(GetPacketKey(port, channel, packetSequence)))
 }
 
 // DeletePacketCallback deletes the callback from storage once it has been processed
-func (k Keeper) DeletePacketCallback(ctx sdk.Context, channel string, packetSequence uint64) {
+func (k Keeper) DeletePacketCallback(ctx sdk.Context, port, channel string, packetSequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetPacketKey(port, channel, packetSequence))
+}
+
+// IteratePacketCallbacks iterates over the pending packet callbacks in the order of their keys
+func (k Keeper) IteratePacketCallbacks(ctx sdk.Context, cb func(callback types.PacketCallback) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(GetPacketKey(channel, packetSequence))
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPacketCallbackPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		callback, err := ParsePacketCallback(iterator.Key(), iterator.Value())
+		if err != nil {
+			k.Logger(ctx).Error("invalid packet callback", "key", iterator.Key(), "error", err)
+			continue
+		}
+		if cb(callback) {
+			break
+		}
+	}
+}
+
+// ParsePacketCallback returns the packet callback stored under a key built by GetPacketKey,
+// port and channel identifiers can't contain a slash.
+func ParsePacketCallback(key, value []byte) (types.PacketCallback, error) {
+	prefixLen := len(types.KeyPacketCallbackPrefix)
+	if len(key) < prefixLen+8 {
+		return types.PacketCallback{}, fmt.Errorf("invalid packet key %x", key)
+	}
+	path := strings.TrimSuffix(string(key[prefixLen:len(key)-8]), "/")
+	port, channel, found := strings.Cut(path, "/")
+	if !found {
+		return types.PacketCallback{}, fmt.Errorf("invalid packet key %x", key)
+	}
+	return types.PacketCallback{
+		PortID:    port,
+		ChannelID: channel,
+		Sequence:  sdk.BigEndianToUint64(key[len(key)-8:]),
+		Contract:  string(value),
+	}, nil
 }
 
 func DeriveIntermediateSender(channel, originalSender, bech32Prefix string) (string, error) {
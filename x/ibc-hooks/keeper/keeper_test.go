@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/keeper"
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+func TestRunWithGasLimit(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app).WithGasMeter(sdk.NewGasMeter(100_000))
+	k := app.IBCHooksKeeper
+
+	// the gas used within the limit is charged to the context
+	err := k.RunWithGasLimit(ctx, 10_000, func(ctx sdk.Context) error {
+		ctx.GasMeter().ConsumeGas(4_000, "test")
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4_000), ctx.GasMeter().GasConsumed())
+
+	// running out of the limit returns an error and charges the limit
+	err = k.RunWithGasLimit(ctx, 10_000, func(ctx sdk.Context) error {
+		ctx.GasMeter().ConsumeGas(20_000, "test")
+		return nil
+	})
+	require.ErrorIs(t, err, types.ErrGasLimitExceeded)
+	require.Equal(t, uint64(14_000), ctx.GasMeter().GasConsumed())
+
+	require.PanicsWithValue(t, "other", func() {
+		_ = k.RunWithGasLimit(ctx, 10_000, func(ctx sdk.Context) error {
+			panic("other")
+		})
+	})
+
+	// running out of the gas of the context is not recovered
+	require.Panics(t, func() {
+		_ = k.RunWithGasLimit(ctx, 200_000, func(ctx sdk.Context) error {
+			ctx.GasMeter().ConsumeGas(150_000, "test")
+			return nil
+		})
+	})
+}
+
+func TestUpdateParams(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	msgServer := keeper.NewMsgServerImpl(*app.IBCHooksKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	require.Equal(t, types.DefaultParams(), app.IBCHooksKeeper.GetParams(ctx))
+
+	params := types.NewParams(500_000, 200_000)
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: contractA, Params: params})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: authority, Params: types.NewParams(0, 200_000)})
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	require.Equal(t, params, app.IBCHooksKeeper.GetParams(ctx))
+
+	res, err := app.IBCHooksKeeper.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
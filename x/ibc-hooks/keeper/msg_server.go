@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: keeper,
+	}
+}
+
+type msgServer struct {
+	Keeper
+}
+
+func (ms msgServer) RetryCallback(goCtx context.Context, req *types.MsgRetryCallback) (*types.MsgRetryCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	callback, err := ms.RetryFailedCallback(ctx, req.PortID, req.ChannelID, req.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRetryCallback{
+		Signer:    req.Sender,
+		Contract:  callback.Contract,
+		PortID:    callback.PortID,
+		ChannelID: callback.ChannelID,
+		Sequence:  callback.Sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetryCallbackResponse{}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ms.SetParams(ctx, req.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (ms msgServer) EmitIBCAck(goCtx context.Context, req *types.MsgEmitIBCAck) (*types.MsgEmitIBCAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	packet, err := ms.WriteAsyncAck(ctx, req.Sender, req.ChannelID, req.Sequence, req.Result, req.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventEmitIBCAck{
+		Contract:  req.Sender,
+		PortID:    packet.Packet.GetDestPort(),
+		ChannelID: req.ChannelID,
+		Sequence:  req.Sequence,
+		Success:   req.Error == "",
+		Error:     req.Error,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEmitIBCAckResponse{}, nil
+}
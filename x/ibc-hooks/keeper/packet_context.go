@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+// SudoWithPacketContext sends the funds of a received packet from the intermediate sender to the contract and calls
+// its ibc_packet_receive sudo entry point with the message of the memo and the packet context. Only the chain calls
+// the sudo entry point, so the contract can trust the packet context. It returns the data of the contract response.
+func (k Keeper) SudoWithPacketContext(ctx sdk.Context, contract, sender sdk.AccAddress, funds sdk.Coins, msg []byte, packetContext types.IBCPacketContext) ([]byte, error) {
+	if k.contractKeeper == nil {
+		return nil, errorsmod.Wrap(types.ErrWasmError, "contract keeper not set")
+	}
+
+	sudoMsg, err := types.NewIBCPacketReceiveSudoMsg(sender.String(), funds, msg, packetContext)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrMarshaling, err.Error())
+	}
+	if err := k.bankKeeper.SendCoins(ctx, sender, contract, funds); err != nil {
+		return nil, err
+	}
+	return k.contractKeeper.Sudo(ctx, contract, sudoMsg)
+}
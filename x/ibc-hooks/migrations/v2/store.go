@@ -0,0 +1,57 @@
+package v2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+// LegacyPacketCallbackPrefix prefixes the packet callbacks of version 1, they are keyed by
+// "<channel>::<sequence>" without the port.
+var LegacyPacketCallbackPrefix = []byte("channel")
+
+// LegacyPacketKey returns the version 1 key of a packet callback
+func LegacyPacketKey(channel string, packetSequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s::%d", channel, packetSequence))
+}
+
+// packetKey returns the version 2 key of a packet callback
+func packetKey(port, channel string, packetSequence uint64) []byte {
+	key := append(types.KeyPacketCallbackPrefix, []byte(fmt.Sprintf("%s/%s/", port, channel))...)
+	return append(key, sdk.Uint64ToBigEndian(packetSequence)...)
+}
+
+// MigrateStore moves the packet callbacks under keys including the source port. The callbacks
+// were only registered for the packets of the transfer port.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, LegacyPacketCallbackPrefix)
+	var legacyKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		legacyKeys = append(legacyKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, legacyKey := range legacyKeys {
+		channel, sequence, found := strings.Cut(string(legacyKey), "::")
+		if !found {
+			return fmt.Errorf("invalid packet callback key %s", legacyKey)
+		}
+		packetSequence, err := strconv.ParseUint(sequence, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid packet sequence in key %s: %w", legacyKey, err)
+		}
+
+		store.Set(packetKey(transfertypes.PortID, channel, packetSequence), store.Get(legacyKey))
+		store.Delete(legacyKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package v2_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	v2 "github.com/notional-labs/composable/v6/x/ibc-hooks/migrations/v2"
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	storeKey := app.GetKey(types.StoreKey)
+	store := ctx.KVStore(storeKey)
+
+	contractA := sdk.AccAddress([]byte("contract_a__________")).String()
+	contractB := sdk.AccAddress([]byte("contract_b__________")).String()
+	store.Set(v2.LegacyPacketKey("channel-0", 2), []byte(contractA))
+	store.Set(v2.LegacyPacketKey("channel-12", 300), []byte(contractB))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey))
+
+	keeper := app.IBCHooksKeeper
+	require.Equal(t, contractA, keeper.GetPacketCallback(ctx, "transfer", "channel-0", 2))
+	require.Equal(t, contractB, keeper.GetPacketCallback(ctx, "transfer", "channel-12", 300))
+	require.False(t, store.Has(v2.LegacyPacketKey("channel-0", 2)))
+	require.False(t, store.Has(v2.LegacyPacketKey("channel-12", 300)))
+	require.Len(t, keeper.ExportGenesis(ctx).PacketCallbacks, 2)
+}
@@ -0,0 +1,24 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	v3 "github.com/notional-labs/composable/v6/x/ibc-hooks/migrations/v3"
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	storeKey := app.GetKey(types.StoreKey)
+
+	// version 2 had no params
+	ctx.KVStore(storeKey).Delete(types.ParamsKey)
+	require.Equal(t, types.Params{}, app.IBCHooksKeeper.GetParams(ctx))
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, app.AppCodec()))
+	require.Equal(t, types.DefaultParams(), app.IBCHooksKeeper.GetParams(ctx))
+}
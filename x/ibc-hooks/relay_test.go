@@ -1,17 +1,26 @@
 package ibchooks_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/stretchr/testify/suite"
 
 	customibctesting "github.com/notional-labs/composable/v6/app/ibctesting"
+	ibchooks "github.com/notional-labs/composable/v6/x/ibc-hooks"
 	ibchookskeeper "github.com/notional-labs/composable/v6/x/ibc-hooks/keeper"
+	ibchookstypes "github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+	ratelimitkeeper "github.com/notional-labs/composable/v6/x/ratelimit/keeper"
+	ratelimittypes "github.com/notional-labs/composable/v6/x/ratelimit/types"
 )
 
 // TODO: use testsuite here.
@@ -47,6 +56,20 @@ func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IBCHooksTestSuite))
 }
 
+// requireLastTxEvent requires the last tx delivered by a chain to emit the expected typed event
+func (suite *IBCHooksTestSuite) requireLastTxEvent(chain *customibctesting.TestChain, expected proto.Message) {
+	for _, event := range chain.LastEvents {
+		if event.Type != proto.MessageName(expected) {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(event)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected, parsed)
+		return
+	}
+	suite.Require().Failf("missing event", "no %s event", proto.MessageName(expected))
+}
+
 func (suite *IBCHooksTestSuite) TestRecvHooks() {
 	var (
 		transferAmount = sdk.NewInt(1000000000)
@@ -235,3 +258,523 @@ func (suite *IBCHooksTestSuite) TestTimeoutHooks() {
 	state := suite.chainA.QueryContract(&suite.Suite, addr, []byte(fmt.Sprintf(`{"get_count": {"addr": "%s"}}`, addr)))
 	suite.Require().Equal(`{"count":10}`, state)
 }
+
+func (suite *IBCHooksTestSuite) TestFailedAckCallback() {
+	var (
+		transferAmount = sdk.NewInt(1000000000)
+		timeoutHeight  = clienttypes.NewHeight(0, 110)
+	)
+
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// the echo contract has no sudo entry point so its callbacks fail
+	suite.chainA.StoreContractCode(&suite.Suite, "../../tests/ibc-hooks/bytecode/echo.wasm")
+	addr := suite.chainA.InstantiateContract(&suite.Suite, `{}`, 1)
+	suite.Require().NotEmpty(addr)
+
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, transferAmount),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		addr.String(),
+		timeoutHeight,
+		0,
+		fmt.Sprintf(`{"ibc_callback":"%s"}`, addr),
+	)
+	sdkResult, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := customibctesting.ParsePacketFromEvents(sdkResult.GetEvents())
+	suite.Require().NoError(err)
+
+	// the ack completes even though the callback fails
+	err = suite.coordinator.RelayAndAckPendingPackets(path)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, len(suite.chainA.PendingSendPackets))
+
+	ctx := suite.chainA.GetContext()
+	hooksKeeper := suite.chainA.IBCHooks()
+	suite.Require().Equal("", hooksKeeper.GetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence))
+	callback, found := hooksKeeper.GetFailedCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(addr.String(), callback.Contract)
+	suite.Require().Contains(callback.SudoMsg, `"ibc_ack"`)
+
+	// retrying it fails again and keeps it
+	retry := ibchookstypes.NewMsgRetryCallback(suite.chainA.SenderAccount.GetAddress().String(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	_, err = suite.chainA.SendMsgsWithExpPass(false, retry)
+	suite.Require().Error(err)
+
+	// SignAndDeliver calls app.Commit()
+	suite.chainA.NextBlock()
+
+	// increment sequence for successful transaction execution
+	err = suite.chainA.SenderAccount.SetSequence(suite.chainA.SenderAccount.GetSequence() + 1)
+	suite.Require().NoError(err)
+
+	_, found = suite.chainA.IBCHooks().GetFailedCallback(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+}
+
+func (suite *IBCHooksTestSuite) TestRecvHooksGasLimit() {
+	var (
+		transferAmount = sdk.NewInt(1000000000)
+		timeoutHeight  = clienttypes.NewHeight(1, 110)
+	)
+
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.chainB.StoreContractCode(&suite.Suite, "../../tests/ibc-hooks/bytecode/counter.wasm")
+	addr := suite.chainB.InstantiateContract(&suite.Suite, `{"count": 0}`, 1)
+	suite.Require().NotEmpty(addr)
+
+	// the contract cannot be executed with so little gas
+	suite.chainB.IBCHooks().SetParams(suite.chainB.GetContext(), ibchookstypes.NewParams(10_000, ibchookstypes.DefaultCallbackGasLimit))
+	suite.chainB.NextBlock()
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	balance := suite.chainA.Balance(sender, sdk.DefaultBondDenom)
+
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, transferAmount),
+		sender.String(),
+		addr.String(),
+		timeoutHeight,
+		0,
+		fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": {"increment": {} } } }`, addr),
+	)
+	_, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(balance.Sub(sdk.NewCoin(sdk.DefaultBondDenom, transferAmount)), suite.chainA.Balance(sender, sdk.DefaultBondDenom))
+
+	// the packet is acknowledged with an error instead of failing the relayer's tx
+	err = suite.coordinator.RelayAndAckPendingPackets(path)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, len(suite.chainA.PendingSendPackets))
+
+	// so the transfer is refunded
+	suite.Require().Equal(balance, suite.chainA.Balance(sender, sdk.DefaultBondDenom))
+}
+
+func (suite *IBCHooksTestSuite) TestRecvHooksContractPolicy() {
+	var (
+		transferAmount = sdk.NewInt(1000000000)
+		timeoutHeight  = clienttypes.NewHeight(1, 110)
+	)
+
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.chainB.StoreContractCode(&suite.Suite, "../../tests/ibc-hooks/bytecode/counter.wasm")
+	addr := suite.chainB.InstantiateContract(&suite.Suite, `{"count": 0}`, 1)
+	suite.Require().NotEmpty(addr)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	notAllowedAck := channeltypes.NewErrorAcknowledgement(ibchookstypes.ErrContractNotAllowed)
+
+	for _, tc := range []struct {
+		name     string
+		policy   ibchookstypes.ContractPolicy
+		codeIDs  []uint64
+		allowed  []string
+		disabled []string
+		executed bool
+	}{
+		{"code id not allowed", ibchookstypes.CONTRACT_POLICY_ALLOWLIST, []uint64{2}, nil, nil, false},
+		{"disabled in open mode", ibchookstypes.CONTRACT_POLICY_OPEN, nil, nil, []string{addr.String()}, false},
+		{"code id allowed", ibchookstypes.CONTRACT_POLICY_ALLOWLIST, []uint64{1}, nil, nil, true},
+		{"contract allowed", ibchookstypes.CONTRACT_POLICY_ALLOWLIST, nil, []string{addr.String()}, nil, true},
+		{"allowed but disabled", ibchookstypes.CONTRACT_POLICY_ALLOWLIST, []uint64{1}, nil, []string{addr.String()}, false},
+	} {
+		params := ibchookstypes.DefaultParams()
+		params.ContractPolicy = tc.policy
+		params.AllowedCodeIDs = tc.codeIDs
+		params.AllowedContracts = tc.allowed
+		params.DisabledContracts = tc.disabled
+		suite.Require().NoError(params.Validate(), tc.name)
+		suite.chainB.IBCHooks().SetParams(suite.chainB.GetContext(), params)
+		suite.chainB.NextBlock()
+
+		balance := suite.chainA.Balance(sender, sdk.DefaultBondDenom)
+		contractBalance := suite.chainB.Balance(addr, voucherDenom)
+
+		msg := transfertypes.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			sdk.NewCoin(sdk.DefaultBondDenom, transferAmount),
+			sender.String(),
+			addr.String(),
+			timeoutHeight,
+			0,
+			fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": {"increment": {} } } }`, addr),
+		)
+		sdkResult, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err, tc.name)
+		packet, err := customibctesting.ParsePacketFromEvents(sdkResult.GetEvents())
+		suite.Require().NoError(err, tc.name)
+
+		err = suite.coordinator.RelayAndAckPendingPackets(path)
+		suite.Require().NoError(err, tc.name)
+
+		ackCommitment, found := suite.chainB.GetTestSupport().IBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), transfertypes.PortID, path.EndpointB.ChannelID, packet.Sequence)
+		suite.Require().True(found, tc.name)
+		// core ibc discards the events of the hooks on an error acknowledgement, the rejection is only
+		// observable with the acknowledgement
+		var eventTypes []string
+		for _, event := range suite.chainB.LastEvents {
+			eventTypes = append(eventTypes, event.Type)
+		}
+		suite.Require().Contains(eventTypes, channeltypes.EventTypeWriteAck, tc.name)
+		suite.Require().NotContains(eventTypes, ibchooks.IbcAcknowledgementErrorType, tc.name)
+		suite.Require().NotContains(eventTypes, proto.MessageName(&ibchookstypes.EventContractNotAllowed{}), tc.name)
+		if tc.executed {
+			suite.Require().Contains(eventTypes, proto.MessageName(&ibchookstypes.EventRecvHookExecuted{}), tc.name)
+			suite.Require().NotEqual(channeltypes.CommitAcknowledgement(notAllowedAck.Acknowledgement()), ackCommitment, tc.name)
+			suite.Require().Equal(contractBalance.AddAmount(transferAmount), suite.chainB.Balance(addr, voucherDenom), tc.name)
+			suite.Require().Equal(balance.Sub(sdk.NewCoin(sdk.DefaultBondDenom, transferAmount)), suite.chainA.Balance(sender, sdk.DefaultBondDenom), tc.name)
+		} else {
+			// the contract is not executed and the transfer is refunded
+			suite.Require().NotContains(eventTypes, proto.MessageName(&ibchookstypes.EventRecvHookExecuted{}), tc.name)
+			suite.Require().Equal(channeltypes.CommitAcknowledgement(notAllowedAck.Acknowledgement()), ackCommitment, tc.name)
+			suite.Require().Equal(contractBalance, suite.chainB.Balance(addr, voucherDenom), tc.name)
+			suite.Require().Equal(balance, suite.chainA.Balance(sender, sdk.DefaultBondDenom), tc.name)
+		}
+	}
+}
+
+func (suite *IBCHooksTestSuite) TestAsyncAckHooks() {
+	var (
+		transferAmount = sdk.NewInt(1000000000)
+		timeoutHeight  = clienttypes.NewHeight(1, 110)
+	)
+
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.chainB.StoreContractCode(&suite.Suite, "../../tests/ibc-hooks/bytecode/counter.wasm")
+	addr := suite.chainB.InstantiateContract(&suite.Suite, `{"count": 0}`, 1)
+	suite.Require().NotEmpty(addr)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	balance := suite.chainA.Balance(sender, sdk.DefaultBondDenom)
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	msgServer := ibchookskeeper.NewMsgServerImpl(*suite.chainB.IBCHooks())
+	intermediateSender, err := ibchookskeeper.DeriveIntermediateSender(path.EndpointB.ChannelID, sender.String(), "cosmos")
+	suite.Require().NoError(err)
+
+	// transfer executes the contract which acknowledges the packet later
+	transfer := func() channeltypes.Packet {
+		msg := transfertypes.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			sdk.NewCoin(sdk.DefaultBondDenom, transferAmount),
+			sender.String(),
+			addr.String(),
+			timeoutHeight,
+			0,
+			fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": {"increment": {} }, "async_ack": true } }`, addr),
+		)
+		sdkResult, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+		packet, err := customibctesting.ParsePacketFromEvents(sdkResult.GetEvents())
+		suite.Require().NoError(err)
+
+		err = suite.coordinator.RelayAndAckPendingPackets(path)
+		suite.Require().NoError(err)
+
+		pending, found := suite.chainB.IBCHooks().GetAsyncAckPacket(suite.chainB.GetContext(), transfertypes.PortID, path.EndpointB.ChannelID, packet.Sequence)
+		suite.Require().True(found)
+		suite.Require().Equal(addr.String(), pending.Contract)
+		suite.Require().Equal(packet, pending.Packet)
+		suite.requireLastTxEvent(suite.chainB, &ibchookstypes.EventAsyncAckPacket{
+			Contract:       addr.String(),
+			Sender:         intermediateSender,
+			OriginalSender: sender.String(),
+			PortID:         transfertypes.PortID,
+			ChannelID:      path.EndpointB.ChannelID,
+			Sequence:       packet.Sequence,
+		})
+		return packet
+	}
+	// acknowledge writes the ack of the contract on chain B and relays it to chain A
+	acknowledge := func(packet channeltypes.Packet, msg *ibchookstypes.MsgEmitIBCAck, ack channeltypes.Acknowledgement) {
+		ctx := suite.chainB.GetContext()
+		_, err := msgServer.EmitIBCAck(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+		requireTypedEvent(suite.T(), ctx, &ibchookstypes.EventEmitIBCAck{
+			Contract:  msg.Sender,
+			PortID:    transfertypes.PortID,
+			ChannelID: msg.ChannelID,
+			Sequence:  msg.Sequence,
+			Success:   msg.Error == "",
+			Error:     msg.Error,
+		})
+		_, found := suite.chainB.IBCHooks().GetAsyncAckPacket(suite.chainB.GetContext(), transfertypes.PortID, path.EndpointB.ChannelID, packet.Sequence)
+		suite.Require().False(found)
+
+		suite.coordinator.IncrementTime()
+		suite.coordinator.CommitBlock(suite.chainB)
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement()))
+	}
+
+	packet := transfer()
+	suite.Require().Equal(transferAmount, suite.chainB.Balance(addr, voucherDenom).Amount)
+
+	// only the contract can acknowledge the packet
+	_, err = msgServer.EmitIBCAck(sdk.WrapSDKContext(suite.chainB.GetContext()), ibchookstypes.NewMsgEmitIBCAck(suite.chainB.SenderAccount.GetAddress().String(), path.EndpointB.ChannelID, packet.Sequence, nil, ""))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.EmitIBCAck(sdk.WrapSDKContext(suite.chainB.GetContext()), ibchookstypes.NewMsgEmitIBCAck(addr.String(), path.EndpointB.ChannelID, packet.Sequence+1, nil, ""))
+	suite.Require().ErrorIs(err, ibchookstypes.ErrAsyncAckNotFound)
+
+	result := []byte(`{"swapped":true}`)
+	contractAck, err := json.Marshal(ibchooks.ContractAck{ContractResult: result, IbcAck: channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()})
+	suite.Require().NoError(err)
+	acknowledge(packet, ibchookstypes.NewMsgEmitIBCAck(addr.String(), path.EndpointB.ChannelID, packet.Sequence, result, ""), channeltypes.NewResultAcknowledgement(contractAck))
+	suite.Require().Equal(balance.Sub(sdk.NewCoin(sdk.DefaultBondDenom, transferAmount)), suite.chainA.Balance(sender, sdk.DefaultBondDenom))
+
+	// an error ack takes back the vouchers from the contract and refunds the sender
+	packet = transfer()
+	suite.Require().Equal(transferAmount.MulRaw(2), suite.chainB.Balance(addr, voucherDenom).Amount)
+	acknowledge(packet, ibchookstypes.NewMsgEmitIBCAck(addr.String(), path.EndpointB.ChannelID, packet.Sequence, nil, "swap failed"), channeltypes.NewErrorAcknowledgement(ibchookstypes.ErrAsyncAck))
+	suite.Require().Equal(transferAmount, suite.chainB.Balance(addr, voucherDenom).Amount)
+	suite.Require().Equal(transferAmount, suite.chainB.GetTestSupport().BankKeeper().GetSupply(suite.chainB.GetContext(), voucherDenom).Amount)
+	suite.Require().Equal(balance.Sub(sdk.NewCoin(sdk.DefaultBondDenom, transferAmount)), suite.chainA.Balance(sender, sdk.DefaultBondDenom))
+
+	// the contract receives the native tokens minted for the vouchers of a parachain token, an error ack burns
+	// them and releases the vouchers locked in escrow, and undoes the inflow of the packet
+	nativeDenom := "ppica"
+	tfm := suite.chainB.TransferMiddleware()
+	suite.Require().NoError(tfm.AddParachainIBCInfo(suite.chainB.GetContext(), voucherDenom, path.EndpointB.ChannelID, nativeDenom, sdk.DefaultBondDenom))
+	rateLimit := suite.chainB.RateLimit()
+	suite.Require().NoError(rateLimit.AddRateLimit(suite.chainB.GetContext(), &ratelimittypes.MsgAddRateLimit{
+		Denom:              nativeDenom,
+		ChannelID:          path.EndpointB.ChannelID,
+		MaxPercentSend:     sdk.NewInt(100),
+		MaxPercentRecv:     sdk.NewInt(100),
+		MinRateLimitAmount: transferAmount.MulRaw(10),
+		DurationHours:      1,
+	}))
+	inflow := func() sdk.Int {
+		limit, found := rateLimit.GetRateLimit(suite.chainB.GetContext(), voucherDenom, path.EndpointB.ChannelID)
+		suite.Require().True(found)
+		return limit.Flow.Inflow
+	}
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, path.EndpointB.ChannelID)
+
+	packet = transfer()
+	suite.Require().Equal(transferAmount, suite.chainB.Balance(addr, nativeDenom).Amount)
+	suite.Require().Equal(transferAmount, suite.chainB.Balance(escrowAddress, voucherDenom).Amount)
+	suite.Require().Equal(transferAmount, tfm.GetMintedSupply(suite.chainB.GetContext(), nativeDenom, path.EndpointB.ChannelID))
+	suite.Require().Equal(transferAmount, inflow())
+
+	acknowledge(packet, ibchookstypes.NewMsgEmitIBCAck(addr.String(), path.EndpointB.ChannelID, packet.Sequence, nil, "swap failed"), channeltypes.NewErrorAcknowledgement(ibchookstypes.ErrAsyncAck))
+	suite.Require().True(suite.chainB.Balance(addr, nativeDenom).IsZero())
+	suite.Require().True(suite.chainB.GetTestSupport().BankKeeper().GetSupply(suite.chainB.GetContext(), nativeDenom).IsZero())
+	suite.Require().True(suite.chainB.Balance(escrowAddress, voucherDenom).IsZero())
+	suite.Require().True(tfm.GetMintedSupply(suite.chainB.GetContext(), nativeDenom, path.EndpointB.ChannelID).IsZero())
+	suite.Require().Equal(transferAmount, suite.chainB.Balance(addr, voucherDenom).Amount)
+	suite.Require().Equal(transferAmount, suite.chainB.GetTestSupport().BankKeeper().GetSupply(suite.chainB.GetContext(), voucherDenom).Amount)
+	suite.Require().True(inflow().IsZero())
+	suite.Require().Equal(balance.Sub(sdk.NewCoin(sdk.DefaultBondDenom, transferAmount)), suite.chainA.Balance(sender, sdk.DefaultBondDenom))
+}
+
+// sudoRecorder records the sudo messages of the contracts instead of calling them
+type sudoRecorder struct {
+	contracts []sdk.AccAddress
+	msgs      [][]byte
+	data      []byte
+}
+
+func (r *sudoRecorder) Sudo(_ sdk.Context, contract sdk.AccAddress, msg []byte) ([]byte, error) {
+	r.contracts = append(r.contracts, contract)
+	r.msgs = append(r.msgs, msg)
+	return r.data, nil
+}
+
+func (r *sudoRecorder) GetContractInfo(_ sdk.Context, _ sdk.AccAddress) *wasmtypes.ContractInfo {
+	return nil
+}
+
+func (suite *IBCHooksTestSuite) TestAsyncAckErrorWithinSendQuota() {
+	var (
+		transferAmount = sdk.NewInt(1000000000)
+		timeoutHeight  = clienttypes.NewHeight(1, 110)
+	)
+
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.chainB.StoreContractCode(&suite.Suite, "../../tests/ibc-hooks/bytecode/counter.wasm")
+	addr := suite.chainB.InstantiateContract(&suite.Suite, `{"count": 0}`, 1)
+	suite.Require().NotEmpty(addr)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	transfer := func(amount sdk.Int, receiver, memo string) channeltypes.Packet {
+		msg := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount), sender.String(), receiver, timeoutHeight, 0, memo)
+		sdkResult, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+		packet, err := customibctesting.ParsePacketFromEvents(sdkResult.GetEvents())
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.coordinator.RelayAndAckPendingPackets(path))
+		return packet
+	}
+
+	// the send quota of the vouchers is the inflow of the window and half of their supply
+	transfer(transferAmount.MulRaw(2), receiver.String(), "")
+	rateLimit := suite.chainB.RateLimit()
+	suite.Require().NoError(rateLimit.AddRateLimit(suite.chainB.GetContext(), &ratelimittypes.MsgAddRateLimit{
+		Denom:              voucherDenom,
+		ChannelID:          path.EndpointB.ChannelID,
+		MaxPercentSend:     sdk.NewInt(50),
+		MaxPercentRecv:     sdk.NewInt(100),
+		MinRateLimitAmount: sdk.NewInt(1),
+		DurationHours:      1,
+	}))
+	flow := func() ratelimittypes.Flow {
+		limit, found := rateLimit.GetRateLimit(suite.chainB.GetContext(), voucherDenom, path.EndpointB.ChannelID)
+		suite.Require().True(found)
+		return *limit.Flow
+	}
+
+	// the contract receives the vouchers and acknowledges the packet later, the inflow lets the chain send
+	// all its vouchers
+	packet := transfer(transferAmount, addr.String(), fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": {"increment": {} }, "async_ack": true } }`, addr))
+	_, err := suite.chainB.SendMsgs(transfertypes.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoin(voucherDenom, transferAmount.MulRaw(2)), receiver.String(), sender.String(), timeoutHeight, 0, ""))
+	suite.Require().NoError(err)
+	suite.Require().Equal(transferAmount, flow().Inflow)
+	suite.Require().Equal(transferAmount.MulRaw(2), flow().Outflow)
+
+	// the error ack doesn't undo the inflow the send used, the net outflow stays within the send quota
+	ctx := suite.chainB.GetContext()
+	_, err = suite.chainB.IBCHooks().WriteAsyncAck(ctx, addr.String(), path.EndpointB.ChannelID, packet.Sequence, nil, "swap failed")
+	suite.Require().NoError(err)
+	suite.Require().True(suite.chainB.Balance(addr, voucherDenom).IsZero())
+	suite.Require().Equal(transferAmount, flow().Inflow)
+	limit, found := rateLimit.GetRateLimit(ctx, voucherDenom, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	suite.Require().False(limit.Quota.CheckExceedsQuota(ratelimittypes.PACKET_SEND, limit.Flow.Outflow.Sub(limit.Flow.Inflow), limit.Flow.ChannelValue, limit.MinRateLimitAmount))
+	msg, broken := ratelimitkeeper.AllInvariants(rateLimit)(ctx)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *IBCHooksTestSuite) TestRecvHooksPacketContext() {
+	var (
+		transferAmount = sdk.NewInt(1000000000)
+		timeoutHeight  = clienttypes.NewHeight(1, 110)
+	)
+
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.chainB.StoreContractCode(&suite.Suite, "../../tests/ibc-hooks/bytecode/counter.wasm")
+	counter := suite.chainB.InstantiateContract(&suite.Suite, `{"count": 0}`, 1)
+	suite.Require().NotEmpty(counter)
+	// the recorder contract stores the message of its sudo entry point under the key "sudo"
+	suite.chainB.StoreContractCode(&suite.Suite, "../../tests/ibc-hooks/bytecode/recorder.wasm")
+	recorder := suite.chainB.InstantiateContract(&suite.Suite, `{}`, 2)
+	suite.Require().NotEmpty(recorder)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	transfer := func(contract sdk.AccAddress) channeltypes.Packet {
+		msg := transfertypes.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			sdk.NewCoin(sdk.DefaultBondDenom, transferAmount),
+			sender.String(),
+			contract.String(),
+			timeoutHeight,
+			0,
+			fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": {"increment": {} }, "packet_context": true } }`, contract),
+		)
+		sdkResult, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+		packet, err := customibctesting.ParsePacketFromEvents(sdkResult.GetEvents())
+		suite.Require().NoError(err)
+
+		err = suite.coordinator.RelayAndAckPendingPackets(path)
+		suite.Require().NoError(err)
+		return packet
+	}
+	ackCommitment := func(packet channeltypes.Packet) []byte {
+		commitment, found := suite.chainB.GetTestSupport().IBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), transfertypes.PortID, path.EndpointB.ChannelID, packet.Sequence)
+		suite.Require().True(found)
+		return commitment
+	}
+	sudoMsg := func() ibchookstypes.IBCPacketReceive {
+		var msg struct {
+			IBCPacketReceive ibchookstypes.IBCPacketReceive `json:"ibc_packet_receive"`
+		}
+		bz := suite.chainB.GetTestSupport().WasmdKeeper().QueryRaw(suite.chainB.GetContext(), recorder, []byte("sudo"))
+		suite.Require().NoError(json.Unmarshal(bz, &msg))
+		return msg.IBCPacketReceive
+	}
+
+	// the counter contract has no ibc_packet_receive sudo entry point, the transfer is refunded
+	balance := suite.chainA.Balance(sender, sdk.DefaultBondDenom)
+	packet := transfer(counter)
+	errorAck := channeltypes.NewErrorAcknowledgement(ibchookstypes.ErrWasmError)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(errorAck.Acknowledgement()), ackCommitment(packet))
+	suite.Require().Equal(balance, suite.chainA.Balance(sender, sdk.DefaultBondDenom))
+	suite.Require().True(suite.chainB.Balance(counter, voucherDenom).IsZero())
+
+	// a contract implementing it receives the funds and the context of the packet
+	packet = transfer(recorder)
+
+	intermediateSender, err := ibchookskeeper.DeriveIntermediateSender(path.EndpointB.ChannelID, sender.String(), "cosmos")
+	suite.Require().NoError(err)
+	packetContext := ibchookstypes.IBCPacketContext{
+		OriginalSender:     sender.String(),
+		SourcePort:         path.EndpointA.ChannelConfig.PortID,
+		SourceChannel:      path.EndpointA.ChannelID,
+		DestinationPort:    path.EndpointB.ChannelConfig.PortID,
+		DestinationChannel: path.EndpointB.ChannelID,
+		Sequence:           packet.Sequence,
+		DenomTrace:         ibchookstypes.PacketDenomTrace{Path: "transfer/" + path.EndpointB.ChannelID, BaseDenom: sdk.DefaultBondDenom},
+		ReceivedDenom:      voucherDenom,
+	}
+	received := sudoMsg()
+	suite.Require().Equal(intermediateSender, received.Sender)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(voucherDenom, transferAmount)), received.Funds)
+	suite.Require().JSONEq(`{"increment":{}}`, string(received.Msg))
+	suite.Require().Equal(packetContext, received.IBCPacketContext)
+
+	contractAck, err := json.Marshal(ibchookstypes.ContractAck{IbcAck: channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(channeltypes.NewResultAcknowledgement(contractAck).Acknowledgement()), ackCommitment(packet))
+	suite.Require().Equal(sdk.NewCoin(voucherDenom, transferAmount), suite.chainB.Balance(recorder, voucherDenom))
+	suite.Require().True(suite.chainB.AllBalances(sdk.MustAccAddressFromBech32(intermediateSender)).IsZero())
+
+	// the contract receives the native tokens minted for the vouchers of a parachain token, the denom trace is
+	// still the trace of the vouchers
+	nativeDenom := "ppica"
+	suite.Require().NoError(suite.chainB.TransferMiddleware().AddParachainIBCInfo(suite.chainB.GetContext(), voucherDenom, path.EndpointB.ChannelID, nativeDenom, sdk.DefaultBondDenom))
+	packet = transfer(recorder)
+
+	packetContext.Sequence = packet.Sequence
+	packetContext.ReceivedDenom = nativeDenom
+	received = sudoMsg()
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(nativeDenom, transferAmount)), received.Funds)
+	suite.Require().Equal(packetContext, received.IBCPacketContext)
+	suite.Require().Equal(sdk.NewCoin(nativeDenom, transferAmount), suite.chainB.Balance(recorder, nativeDenom))
+}
@@ -0,0 +1,7 @@
+package types
+
+// ContractAck is the result of a successful acknowledgement of a packet executing a contract
+type ContractAck struct {
+	ContractResult []byte `json:"contract_result"`
+	IbcAck         []byte `json:"ibc_ack"`
+}
@@ -14,4 +14,13 @@ var (
 	ErrBadResponse   = errorsmod.Register("wasm-hooks", 5, "cannot create response")
 	ErrWasmError     = errorsmod.Register("wasm-hooks", 6, "wasm error")
 	ErrBadSender     = errorsmod.Register("wasm-hooks", 7, "bad sender")
+
+	ErrCallbackNotFound       = errorsmod.Register("wasm-hooks", 8, "failed callback not found")
+	ErrCallbackFailed         = errorsmod.Register("wasm-hooks", 9, "callback failed")
+	ErrGasLimitExceeded       = errorsmod.Register("wasm-hooks", 10, "contract gas limit exceeded")
+	ErrAsyncAckNotFound       = errorsmod.Register("wasm-hooks", 11, "async ack packet not found")
+	ErrAsyncAck               = errorsmod.Register("wasm-hooks", 12, "contract acknowledged the packet with an error")
+	ErrPacketCallbackNotFound = errorsmod.Register("wasm-hooks", 13, "packet callback not found")
+	ErrContractNotAllowed     = errorsmod.Register("wasm-hooks", 14, "contract not allowed")
+	ErrForward                = errorsmod.Register("wasm-hooks", 15, "cannot forward the funds returned by the contract")
 )
@@ -0,0 +1,13 @@
+package types
+
+const (
+	EventTypeAckCallbackError     = "ibc-ack-callback-error"
+	EventTypeTimeoutCallbackError = "ibc-timeout-callback-error"
+
+	AttributeKeyContract = "contract"
+	AttributeKeyMessage  = "message"
+	AttributeKeyError    = "error"
+	AttributeKeyPort     = "port"
+	AttributeKeyChannel  = "channel"
+	AttributeKeySequence = "sequence"
+)
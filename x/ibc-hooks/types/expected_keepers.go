@@ -0,0 +1,76 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	routertypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	transfermiddlewaretypes "github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+// ContractKeeper calls the callbacks of the contracts and returns their code id
+type ContractKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
+
+// BankKeeper takes back the funds of a packet from its contract when it fails the async ack and returns the funds
+// of the intermediate senders forwarded after the contract execution
+type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// ChannelKeeper returns the sequence of the packets forwarded after the contract execution
+type ChannelKeeper interface {
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+}
+
+// TransferKeeper tracks the tokens escrowed by the transfers
+type TransferKeeper interface {
+	GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin
+	SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin)
+}
+
+// ScopedKeeper returns the capabilities of the transfer channels
+type ScopedKeeper interface {
+	GetCapability(ctx sdk.Context, name string) (*capabilitytypes.Capability, bool)
+}
+
+// TransferMiddlewareKeeper returns the native denoms minted for the vouchers received from the parachains and
+// burns them when the receive of the vouchers is reverted
+type TransferMiddlewareKeeper interface {
+	GetParachainIBCTokenInfoByIBCDenom(ctx sdk.Context, ibcDenom string) (transfermiddlewaretypes.ParachainIBCTokenInfo, bool)
+	ReleaseEscrowedVouchers(ctx sdk.Context, holder sdk.AccAddress, info transfermiddlewaretypes.ParachainIBCTokenInfo, amount math.Int) error
+}
+
+// RateLimitKeeper undoes the inflow of the received packets whose receive is reverted
+type RateLimitKeeper interface {
+	GetReceiveWindowID(ctx sdk.Context, packet channeltypes.Packet) uint64
+	UndoReceivePacket(ctx sdk.Context, packet channeltypes.Packet, windowID uint64) error
+}
+
+// ForwardKeeper forwards the funds returned by the contracts with the packet forward middleware
+type ForwardKeeper interface {
+	ForwardTransferPacket(
+		ctx sdk.Context,
+		inFlightPacket *routertypes.InFlightPacket,
+		srcPacket channeltypes.Packet,
+		srcPacketSender string,
+		receiver string,
+		metadata *routertypes.ForwardMetadata,
+		token sdk.Coin,
+		maxRetries uint8,
+		timeout time.Duration,
+		labels []metrics.Label,
+		nonrefundable bool,
+	) error
+}
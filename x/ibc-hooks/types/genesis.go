@@ -0,0 +1,125 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// DefaultGenesisState returns the default ibc-hooks genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		PacketCallbacks: []PacketCallback{},
+		FailedCallbacks: []FailedCallback{},
+		Params:          DefaultParams(),
+		AsyncAckPackets: []AsyncAckPacket{},
+		ForwardRefunds:  []ForwardRefund{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	callbacks := make(map[string]bool)
+	for _, callback := range gs.PacketCallbacks {
+		if err := callback.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%s/%d", callback.PortID, callback.ChannelID, callback.Sequence)
+		if callbacks[key] {
+			return fmt.Errorf("duplicated packet callback for sequence %d on %s/%s", callback.Sequence, callback.PortID, callback.ChannelID)
+		}
+		callbacks[key] = true
+	}
+
+	failedCallbacks := make(map[string]bool)
+	for _, callback := range gs.FailedCallbacks {
+		if err := callback.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%s/%d", callback.PortID, callback.ChannelID, callback.Sequence)
+		if failedCallbacks[key] {
+			return fmt.Errorf("duplicated failed callback for sequence %d on %s/%s", callback.Sequence, callback.PortID, callback.ChannelID)
+		}
+		failedCallbacks[key] = true
+	}
+
+	asyncAckPackets := make(map[string]bool)
+	for _, packet := range gs.AsyncAckPackets {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%s/%d", packet.Packet.DestinationPort, packet.Packet.DestinationChannel, packet.Packet.Sequence)
+		if asyncAckPackets[key] {
+			return fmt.Errorf("duplicated async ack packet for sequence %d on %s/%s", packet.Packet.Sequence, packet.Packet.DestinationPort, packet.Packet.DestinationChannel)
+		}
+		asyncAckPackets[key] = true
+	}
+
+	forwardRefunds := make(map[string]bool)
+	for _, forward := range gs.ForwardRefunds {
+		if err := forward.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%s/%d", forward.PortID, forward.ChannelID, forward.Sequence)
+		if forwardRefunds[key] {
+			return fmt.Errorf("duplicated forward for sequence %d on %s/%s", forward.Sequence, forward.PortID, forward.ChannelID)
+		}
+		forwardRefunds[key] = true
+	}
+	return nil
+}
+
+func (c PacketCallback) Validate() error {
+	if err := host.PortIdentifierValidator(c.PortID); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(c.ChannelID); err != nil {
+		return err
+	}
+	if c.Sequence == 0 {
+		return fmt.Errorf("packet sequence cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return fmt.Errorf("invalid callback contract %s: %w", c.Contract, err)
+	}
+	return nil
+}
+
+func (c FailedCallback) Validate() error {
+	packetCallback := PacketCallback{PortID: c.PortID, ChannelID: c.ChannelID, Sequence: c.Sequence, Contract: c.Contract}
+	if err := packetCallback.Validate(); err != nil {
+		return err
+	}
+	if !json.Valid([]byte(c.SudoMsg)) {
+		return fmt.Errorf("invalid sudo message of the failed callback for sequence %d on %s/%s", c.Sequence, c.PortID, c.ChannelID)
+	}
+	return nil
+}
+
+func (p AsyncAckPacket) Validate() error {
+	if err := p.Packet.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return fmt.Errorf("invalid async ack contract %s: %w", p.Contract, err)
+	}
+	return nil
+}
+
+func (f ForwardRefund) Validate() error {
+	packetCallback := PacketCallback{PortID: f.PortID, ChannelID: f.ChannelID, Sequence: f.Sequence, Contract: f.Contract}
+	if err := packetCallback.Validate(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(f.Sender); err != nil {
+		return fmt.Errorf("invalid forward sender %s: %w", f.Sender, err)
+	}
+	return nil
+}
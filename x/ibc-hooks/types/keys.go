@@ -2,7 +2,23 @@ package types
 
 const (
 	ModuleName     = "ibchooks"
+	RouterKey      = ModuleName
 	StoreKey       = "hooks-for-ibc" // not using the module name because of collisions with key "ibc"
 	IBCCallbackKey = "ibc_callback"
 	SenderPrefix   = "ibc-wasm-hook-intermediary"
 )
+
+var (
+	// KeyPacketCallbackPrefix prefixes the packet callbacks, they are keyed by their source port, channel and sequence
+	KeyPacketCallbackPrefix = []byte{0x01}
+	// KeyFailedCallbackPrefix prefixes the failed callbacks, keyed as the packet callbacks
+	KeyFailedCallbackPrefix = []byte{0x02}
+	// ParamsKey is the key of the module parameters
+	ParamsKey = []byte{0x03}
+	// KeyAsyncAckPacketPrefix prefixes the packets waiting for the acknowledgement of their contract, they are
+	// keyed by their destination port, channel and sequence
+	KeyAsyncAckPacketPrefix = []byte{0x04}
+	// KeyForwardRefundPrefix prefixes the forwards of the funds returned by the contracts, they are keyed by the
+	// source port, channel and sequence of the forwarded packet
+	KeyForwardRefundPrefix = []byte{0x05}
+)
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	routertypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+)
+
+// ValidateAndParseMemo returns the contract and the message executed by the wasm memo of a packet sent to the receiver,
+// isWasmRouted is false if the memo has no wasm key.
+func ValidateAndParseMemo(memo, receiver string) (isWasmRouted bool, contractAddr sdk.AccAddress, msgBytes []byte, err error) {
+	isWasmRouted, metadata := JSONStringHasKey(memo, "wasm")
+	if !isWasmRouted {
+		return isWasmRouted, sdk.AccAddress{}, nil, nil
+	}
+
+	wasmRaw := metadata["wasm"]
+
+	// Make sure the wasm key is a map. If it isn't, ignore this packet
+	wasm, ok := wasmRaw.(map[string]interface{})
+	if !ok {
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(ErrBadMetadataFormatMsg, memo, "wasm metadata is not a valid JSON map object")
+	}
+
+	// Get the contract
+	contract, ok := wasm["contract"].(string)
+	if !ok {
+		// The tokens will be returned
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(ErrBadMetadataFormatMsg, memo, `Could not find key wasm["contract"]`)
+	}
+
+	contractAddr, err = sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(ErrBadMetadataFormatMsg, memo, `wasm["contract"] is not a valid bech32 address`)
+	}
+
+	// The contract and the receiver should be the same for the packet to be valid
+	if contract != receiver {
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(ErrBadMetadataFormatMsg, memo, `wasm["contract"] should be the same as the receiver of the packet`)
+	}
+
+	// Ensure the message key is provided
+	if wasm["msg"] == nil {
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(ErrBadMetadataFormatMsg, memo, `Could not find key wasm["msg"]`)
+	}
+
+	// Make sure the msg key is a map. If it isn't, return an error
+	_, ok = wasm["msg"].(map[string]interface{})
+	if !ok {
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(ErrBadMetadataFormatMsg, memo, `wasm["msg"] is not a map object`)
+	}
+
+	// The acknowledgement is only written by the contract if async_ack is true
+	asyncAck := false
+	if asyncAckRaw, found := wasm["async_ack"]; found {
+		if asyncAck, ok = asyncAckRaw.(bool); !ok {
+			return isWasmRouted, sdk.AccAddress{}, nil,
+				fmt.Errorf(ErrBadMetadataFormatMsg, memo, `wasm["async_ack"] is not a boolean`)
+		}
+	}
+
+	// The message is passed to the ibc_packet_receive sudo entry point with the packet context if packet_context is true
+	if packetContext, found := wasm["packet_context"]; found {
+		if _, ok := packetContext.(bool); !ok {
+			return isWasmRouted, sdk.AccAddress{}, nil,
+				fmt.Errorf(ErrBadMetadataFormatMsg, memo, `wasm["packet_context"] is not a boolean`)
+		}
+	}
+
+	// The funds returned by the contract are forwarded if the memo has a forward key next to the wasm key
+	_, isForwarded, err := ParseForwardMetadata(memo)
+	if err != nil {
+		return isWasmRouted, sdk.AccAddress{}, nil, fmt.Errorf(ErrBadMetadataFormatMsg, memo, err.Error())
+	}
+	if isForwarded && asyncAck {
+		// the acknowledgement of a forwarded packet is written once the forward is acknowledged
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(ErrBadMetadataFormatMsg, memo, `wasm["async_ack"] cannot be set with a forward`)
+	}
+
+	// Get the message string by serializing the map
+	msgBytes, err = json.Marshal(wasm["msg"])
+	if err != nil {
+		// The tokens will be returned
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(ErrBadMetadataFormatMsg, memo, err.Error())
+	}
+
+	return isWasmRouted, contractAddr, msgBytes, nil
+}
+
+// ParseForwardMetadata returns the packet forward middleware metadata of the forward key of a memo,
+// isForwarded is false if the memo has no forward key.
+func ParseForwardMetadata(memo string) (metadata *routertypes.ForwardMetadata, isForwarded bool, err error) {
+	isForwarded, _ = JSONStringHasKey(memo, "forward")
+	if !isForwarded {
+		return nil, false, nil
+	}
+
+	var packetMetadata routertypes.PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &packetMetadata); err != nil {
+		return nil, true, fmt.Errorf("forward metadata is not valid: %w", err)
+	}
+	if packetMetadata.Forward == nil {
+		return nil, true, fmt.Errorf("forward metadata is not a valid JSON map object")
+	}
+	if err := packetMetadata.Forward.Validate(); err != nil {
+		return nil, true, err
+	}
+
+	return packetMetadata.Forward, true, nil
+}
+
+// JSONStringHasKey parses the memo as a json object and checks if it contains the key.
+func JSONStringHasKey(memo, key string) (found bool, jsonObject map[string]interface{}) {
+	jsonObject = make(map[string]interface{})
+
+	// If there is no memo, the packet was either sent with an earlier version of IBC, or the memo was
+	// intentionally left blank. Nothing to do here. Ignore the packet and pass it down the stack.
+	if len(memo) == 0 {
+		return false, jsonObject
+	}
+
+	// the jsonObject must be a valid JSON object
+	err := json.Unmarshal([]byte(memo), &jsonObject)
+	if err != nil {
+		return false, jsonObject
+	}
+
+	// If the key doesn't exist, there's nothing to do on this hook. Continue by passing the packet
+	// down the stack
+	_, ok := jsonObject[key]
+	if !ok {
+		return false, jsonObject
+	}
+
+	return true, jsonObject
+}
@@ -0,0 +1,143 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+const (
+	TypeMsgRetryCallback = "retry_callback"
+	TypeMsgUpdateParams  = "update_params"
+	TypeMsgEmitIBCAck    = "emit_ibc_ack"
+)
+
+var (
+	_ sdk.Msg = &MsgRetryCallback{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgEmitIBCAck{}
+)
+
+func NewMsgRetryCallback(
+	sender string,
+	portID string,
+	channelID string,
+	sequence uint64,
+) *MsgRetryCallback {
+	return &MsgRetryCallback{
+		Sender:    sender,
+		PortID:    portID,
+		ChannelID: channelID,
+		Sequence:  sequence,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRetryCallback) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRetryCallback) Type() string { return TypeMsgRetryCallback }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRetryCallback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRetryCallback message.
+func (msg *MsgRetryCallback) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRetryCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if err := host.PortIdentifierValidator(msg.PortID); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
+		return err
+	}
+	if msg.Sequence == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "packet sequence cannot be 0")
+	}
+	return nil
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return msg.Params.Validate()
+}
+
+func NewMsgEmitIBCAck(
+	sender string,
+	channelID string,
+	sequence uint64,
+	result []byte,
+	ackError string,
+) *MsgEmitIBCAck {
+	return &MsgEmitIBCAck{
+		Sender:    sender,
+		ChannelID: channelID,
+		Sequence:  sequence,
+		Result:    result,
+		Error:     ackError,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgEmitIBCAck) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgEmitIBCAck) Type() string { return TypeMsgEmitIBCAck }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgEmitIBCAck) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgEmitIBCAck message.
+func (msg *MsgEmitIBCAck) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgEmitIBCAck) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
+		return err
+	}
+	if msg.Sequence == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "packet sequence cannot be 0")
+	}
+	if msg.Error != "" && len(msg.Result) > 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "an acknowledgement cannot have both a result and an error")
+	}
+	return nil
+}
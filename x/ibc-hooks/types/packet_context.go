@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IBCPacketContext is the provenance of a received packet, it is built from the packet and not from its memo
+type IBCPacketContext struct {
+	OriginalSender     string           `json:"original_sender"`
+	SourcePort         string           `json:"source_port"`
+	SourceChannel      string           `json:"source_channel"`
+	DestinationPort    string           `json:"destination_port"`
+	DestinationChannel string           `json:"destination_channel"`
+	Sequence           uint64           `json:"sequence"`
+	DenomTrace         PacketDenomTrace `json:"denom_trace"`
+	// ReceivedDenom is the denom of the funds received by the contract. It is the native denom minted by the
+	// transfer middleware for the vouchers of a parachain token, otherwise the ibc denom of the denom trace or
+	// the base denom of a denom of this chain.
+	ReceivedDenom string `json:"received_denom"`
+}
+
+// PacketDenomTrace is the trace of the denom of the packet on this chain, the path is empty for the denoms of
+// this chain. The trace of the vouchers of a parachain token is kept, see ReceivedDenom.
+type PacketDenomTrace struct {
+	Path      string `json:"path"`
+	BaseDenom string `json:"base_denom"`
+}
+
+// IBCPacketReceive is the message of the ibc_packet_receive sudo entry point of the contracts executed with
+// the packet context
+type IBCPacketReceive struct {
+	Sender           string           `json:"sender"`
+	Funds            sdk.Coins        `json:"funds"`
+	Msg              json.RawMessage  `json:"msg"`
+	IBCPacketContext IBCPacketContext `json:"ibc_packet_context"`
+}
+
+// NewIBCPacketReceiveSudoMsg returns the sudo message executing the message of a memo with the packet context,
+// the funds were sent to the contract by the intermediate sender
+func NewIBCPacketReceiveSudoMsg(sender string, funds sdk.Coins, msg []byte, packetContext IBCPacketContext) ([]byte, error) {
+	return json.Marshal(struct {
+		IBCPacketReceive IBCPacketReceive `json:"ibc_packet_receive"`
+	}{IBCPacketReceive{
+		Sender:           sender,
+		Funds:            funds,
+		Msg:              msg,
+		IBCPacketContext: packetContext,
+	}})
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultExecuteGasLimit is the default gas a contract can use when executed by a received packet
+	DefaultExecuteGasLimit uint64 = 2_000_000
+	// DefaultCallbackGasLimit is the default gas a contract can use in the callback of a packet
+	DefaultCallbackGasLimit uint64 = 1_000_000
+)
+
+// NewParams creates a new Params instance.
+func NewParams(executeGasLimit, callbackGasLimit uint64) Params {
+	return Params{
+		ExecuteGasLimit:  executeGasLimit,
+		CallbackGasLimit: callbackGasLimit,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultExecuteGasLimit, DefaultCallbackGasLimit)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if p.ExecuteGasLimit == 0 {
+		return fmt.Errorf("execute gas limit cannot be 0")
+	}
+	if p.CallbackGasLimit == 0 {
+		return fmt.Errorf("callback gas limit cannot be 0")
+	}
+	if _, ok := ContractPolicy_name[int32(p.ContractPolicy)]; !ok {
+		return fmt.Errorf("invalid contract policy %d", p.ContractPolicy)
+	}
+
+	codeIDs := make(map[uint64]bool)
+	for _, codeID := range p.AllowedCodeIDs {
+		if codeID == 0 {
+			return fmt.Errorf("allowed code id cannot be 0")
+		}
+		if codeIDs[codeID] {
+			return fmt.Errorf("duplicated allowed code id %d", codeID)
+		}
+		codeIDs[codeID] = true
+	}
+	if err := validateContracts("allowed", p.AllowedContracts); err != nil {
+		return err
+	}
+	return validateContracts("disabled", p.DisabledContracts)
+}
+
+// IsContractAllowed returns nil if packets can execute the contract, or register it as the callback
+// of a packet, with the code id codeID. The code id is 0 if the contract does not exist.
+func (p Params) IsContractAllowed(contract string, codeID uint64) error {
+	for _, disabled := range p.DisabledContracts {
+		if disabled == contract {
+			return fmt.Errorf("contract %s is disabled", contract)
+		}
+	}
+	if p.ContractPolicy == CONTRACT_POLICY_OPEN {
+		return nil
+	}
+
+	for _, allowed := range p.AllowedContracts {
+		if allowed == contract {
+			return nil
+		}
+	}
+	for _, allowed := range p.AllowedCodeIDs {
+		if codeID != 0 && allowed == codeID {
+			return nil
+		}
+	}
+	return fmt.Errorf("contract %s with code id %d is not allowed", contract, codeID)
+}
+
+func validateContracts(list string, contracts []string) error {
+	seen := make(map[string]bool)
+	for _, contract := range contracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid %s contract %s: %w", list, contract, err)
+		}
+		if seen[contract] {
+			return fmt.Errorf("duplicated %s contract %s", list, contract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
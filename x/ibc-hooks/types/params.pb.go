@@ -0,0 +1,650 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: composable/ibchooks/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ContractPolicy defines which contracts packets can execute or register as
+// callbacks.
+type ContractPolicy int32
+
+const (
+	// any contract which is not disabled
+	CONTRACT_POLICY_OPEN ContractPolicy = 0
+	// only the allowed contracts and the contracts of the allowed code ids which
+	// are not disabled
+	CONTRACT_POLICY_ALLOWLIST ContractPolicy = 1
+)
+
+var ContractPolicy_name = map[int32]string{
+	0: "CONTRACT_POLICY_OPEN",
+	1: "CONTRACT_POLICY_ALLOWLIST",
+}
+
+var ContractPolicy_value = map[string]int32{
+	"CONTRACT_POLICY_OPEN":      0,
+	"CONTRACT_POLICY_ALLOWLIST": 1,
+}
+
+func (x ContractPolicy) String() string {
+	return proto.EnumName(ContractPolicy_name, int32(x))
+}
+
+func (ContractPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e2ea801baaa91c0c, []int{0}
+}
+
+// Params holds parameters for the ibc-hooks module.
+type Params struct {
+	// execute_gas_limit is the gas a contract can use when it is executed by the
+	// wasm memo of a received packet, running out of it returns an error ack.
+	ExecuteGasLimit uint64 `protobuf:"varint,1,opt,name=execute_gas_limit,json=executeGasLimit,proto3" json:"execute_gas_limit,omitempty" yaml:"execute_gas_limit"`
+	// callback_gas_limit is the gas a contract can use in the callback of the
+	// ack or the timeout of a packet, running out of it fails the callback.
+	CallbackGasLimit uint64 `protobuf:"varint,2,opt,name=callback_gas_limit,json=callbackGasLimit,proto3" json:"callback_gas_limit,omitempty" yaml:"callback_gas_limit"`
+	// contract_policy restricts the contracts executed by received packets and
+	// registered as callbacks of sent packets.
+	ContractPolicy ContractPolicy `protobuf:"varint,3,opt,name=contract_policy,json=contractPolicy,proto3,enum=composable.ibchooks.v1beta1.ContractPolicy" json:"contract_policy,omitempty" yaml:"contract_policy"`
+	// allowed_code_ids are the code ids whose contracts are allowed by the
+	// allowlist policy.
+	AllowedCodeIDs []uint64 `protobuf:"varint,4,rep,packed,name=allowed_code_ids,json=allowedCodeIds,proto3" json:"allowed_code_ids,omitempty" yaml:"allowed_code_ids"`
+	// allowed_contracts are the contracts allowed by the allowlist policy.
+	AllowedContracts []string `protobuf:"bytes,5,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty" yaml:"allowed_contracts"`
+	// disabled_contracts are rejected whatever the policy.
+	DisabledContracts []string `protobuf:"bytes,6,rep,name=disabled_contracts,json=disabledContracts,proto3" json:"disabled_contracts,omitempty" yaml:"disabled_contracts"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ea801baaa91c0c, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetExecuteGasLimit() uint64 {
+	if m != nil {
+		return m.ExecuteGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.CallbackGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetContractPolicy() ContractPolicy {
+	if m != nil {
+		return m.ContractPolicy
+	}
+	return CONTRACT_POLICY_OPEN
+}
+
+func (m *Params) GetAllowedCodeIDs() []uint64 {
+	if m != nil {
+		return m.AllowedCodeIDs
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedContracts() []string {
+	if m != nil {
+		return m.AllowedContracts
+	}
+	return nil
+}
+
+func (m *Params) GetDisabledContracts() []string {
+	if m != nil {
+		return m.DisabledContracts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("composable.ibchooks.v1beta1.ContractPolicy", ContractPolicy_name, ContractPolicy_value)
+	proto.RegisterType((*Params)(nil), "composable.ibchooks.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("composable/ibchooks/v1beta1/params.proto", fileDescriptor_e2ea801baaa91c0c)
+}
+
+var fileDescriptor_e2ea801baaa91c0c = []byte{
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x8e, 0x93, 0x40,
+	0x18, 0xc7, 0xc1, 0x62, 0x13, 0xe7, 0xc0, 0xd2, 0xc9, 0x46, 0xd9, 0xea, 0x42, 0x33, 0x27, 0xe2,
+	0x66, 0x69, 0x56, 0x6f, 0xde, 0x5a, 0x34, 0xda, 0x48, 0x96, 0x06, 0x9b, 0x6c, 0xf4, 0x42, 0x86,
+	0x61, 0x52, 0xc9, 0x4e, 0x1d, 0xd2, 0x41, 0xdd, 0x9e, 0xbd, 0x78, 0xf4, 0x1d, 0x7c, 0x19, 0x8f,
+	0x7b, 0xf4, 0x44, 0x0c, 0x7d, 0x03, 0x9e, 0xc0, 0x14, 0x68, 0x97, 0x96, 0x64, 0x6f, 0x93, 0xff,
+	0xfc, 0xfe, 0xbf, 0xc9, 0x7c, 0xf9, 0x80, 0x45, 0xf8, 0x22, 0xe1, 0x02, 0x87, 0x8c, 0x0e, 0xe3,
+	0x90, 0x7c, 0xe6, 0xfc, 0x5a, 0x0c, 0xbf, 0x5d, 0x84, 0x34, 0xc5, 0x17, 0xc3, 0x04, 0x2f, 0xf1,
+	0x42, 0xd8, 0xc9, 0x92, 0xa7, 0x1c, 0x3e, 0xbd, 0x23, 0xed, 0x2d, 0x69, 0xd7, 0x64, 0xff, 0x78,
+	0xce, 0xe7, 0xbc, 0xe4, 0x86, 0x9b, 0x53, 0x55, 0x41, 0x3f, 0x14, 0xd0, 0x9d, 0x96, 0x0e, 0xf8,
+	0x0e, 0xf4, 0xe8, 0x0d, 0x25, 0x5f, 0x53, 0x1a, 0xcc, 0xb1, 0x08, 0x58, 0xbc, 0x88, 0x53, 0x5d,
+	0x1e, 0xc8, 0x96, 0x32, 0x7e, 0x56, 0x64, 0xa6, 0xbe, 0xc2, 0x0b, 0xf6, 0x0a, 0xb5, 0x10, 0xe4,
+	0x1f, 0xd5, 0xd9, 0x5b, 0x2c, 0xdc, 0x4d, 0x02, 0xdf, 0x03, 0x48, 0x30, 0x63, 0x21, 0x26, 0xd7,
+	0x0d, 0xd5, 0x83, 0x52, 0x75, 0x5a, 0x64, 0xe6, 0x49, 0xa5, 0x6a, 0x33, 0xc8, 0xd7, 0xb6, 0xe1,
+	0x4e, 0x96, 0x80, 0x23, 0xc2, 0xbf, 0xa4, 0x4b, 0x4c, 0xd2, 0x20, 0xe1, 0x2c, 0x26, 0x2b, 0xbd,
+	0x33, 0x90, 0x2d, 0xf5, 0xc5, 0x99, 0x7d, 0xcf, 0x77, 0x6d, 0xa7, 0xee, 0x4c, 0xcb, 0xca, 0xb8,
+	0x5f, 0x64, 0xe6, 0xe3, 0xfa, 0xd9, 0x7d, 0x1b, 0xf2, 0x55, 0xb2, 0xc7, 0xc2, 0x2b, 0xa0, 0x61,
+	0xc6, 0xf8, 0x77, 0x1a, 0x05, 0x84, 0x47, 0x34, 0x88, 0x23, 0xa1, 0x2b, 0x83, 0x8e, 0xa5, 0x8c,
+	0xcf, 0xf3, 0xcc, 0x54, 0x47, 0xd5, 0x9d, 0xc3, 0x23, 0x3a, 0x79, 0x2d, 0x8a, 0xcc, 0x7c, 0x52,
+	0x79, 0x0f, 0x3b, 0xc8, 0x57, 0x71, 0x03, 0x8d, 0x04, 0x9c, 0x80, 0xde, 0x1d, 0x54, 0x3d, 0x29,
+	0xf4, 0x87, 0x83, 0x8e, 0xf5, 0xa8, 0x39, 0xe1, 0x16, 0x82, 0x7c, 0x6d, 0x27, 0xaa, 0x23, 0xe8,
+	0x02, 0x18, 0xc5, 0xe5, 0xd7, 0x9b, 0xae, 0x6e, 0xe9, 0x6a, 0x8c, 0xb8, 0xcd, 0x20, 0xbf, 0xb7,
+	0x0d, 0x77, 0xb6, 0xe7, 0x1e, 0x50, 0xf7, 0xe7, 0x05, 0x75, 0x70, 0xec, 0x78, 0x97, 0x33, 0x7f,
+	0xe4, 0xcc, 0x82, 0xa9, 0xe7, 0x4e, 0x9c, 0x8f, 0x81, 0x37, 0x7d, 0x73, 0xa9, 0x49, 0xf0, 0x14,
+	0x9c, 0x1c, 0xde, 0x8c, 0x5c, 0xd7, 0xbb, 0x72, 0x27, 0x1f, 0x66, 0x9a, 0xdc, 0x57, 0x7e, 0xfe,
+	0x36, 0xa4, 0xf1, 0xd9, 0x9f, 0xdc, 0x90, 0x6f, 0x73, 0x43, 0xfe, 0x97, 0x1b, 0xf2, 0xaf, 0xb5,
+	0x21, 0xdd, 0xae, 0x0d, 0xe9, 0xef, 0xda, 0x90, 0x3e, 0xf5, 0x6e, 0x36, 0x4b, 0x7c, 0x5e, 0x6d,
+	0x71, 0xba, 0x4a, 0xa8, 0x08, 0xbb, 0xe5, 0x2a, 0xbe, 0xfc, 0x3f, 0x00, 0x49, 0x16, 0x52, 0xf5,
+	0xe9, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisabledContracts) > 0 {
+		for iNdEx := len(m.DisabledContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledContracts[iNdEx])
+			copy(dAtA[i:], m.DisabledContracts[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DisabledContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedCodeIDs) > 0 {
+		dAtA2 := make([]byte, len(m.AllowedCodeIDs)*10)
+		var j1 int
+		for _, num := range m.AllowedCodeIDs {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ContractPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ContractPolicy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CallbackGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CallbackGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExecuteGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExecuteGasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecuteGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.ExecuteGasLimit))
+	}
+	if m.CallbackGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.CallbackGasLimit))
+	}
+	if m.ContractPolicy != 0 {
+		n += 1 + sovParams(uint64(m.ContractPolicy))
+	}
+	if len(m.AllowedCodeIDs) > 0 {
+		l = 0
+		for _, e := range m.AllowedCodeIDs {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DisabledContracts) > 0 {
+		for _, s := range m.DisabledContracts {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGasLimit", wireType)
+			}
+			m.ExecuteGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimit", wireType)
+			}
+			m.CallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractPolicy", wireType)
+			}
+			m.ContractPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractPolicy |= ContractPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedCodeIDs = append(m.AllowedCodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AllowedCodeIDs) == 0 {
+					m.AllowedCodeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedCodeIDs = append(m.AllowedCodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCodeIDs", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledContracts = append(m.DisabledContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -38,32 +38,26 @@ func NewEmitErrorAcknowledgement(ctx sdk.Context, err error, errorContexts ...st
 // denom as represented in the local chain.
 // If the data cannot be unmarshalled this function will panic
 func MustExtractDenomFromPacketOnRecv(packet ibcexported.PacketI) string {
+	// The denomination used to send the coins is either the native denom or the hash of the path
+	// if the denomination is not native.
+	return ExtractDenomTraceFromPacketOnRecv(packet).IBCDenom()
+}
+
+// ExtractDenomTraceFromPacketOnRecv takes a packet with a valid ICS20 token data in the Data field and returns the
+// trace of the denom as represented in the local chain, its path is empty for the denoms of the local chain.
+// If the data cannot be unmarshalled this function will panic
+func ExtractDenomTraceFromPacketOnRecv(packet ibcexported.PacketI) transfertypes.DenomTrace {
 	var data transfertypes.FungibleTokenPacketData
 	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
 		panic("unable to unmarshal ICS20 packet data")
 	}
 
-	var denom string
 	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
 		// remove prefix added by sender chain
 		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
-
-		unprefixedDenom := data.Denom[len(voucherPrefix):]
-
-		// coin denomination used in sending from the escrow address
-		denom = unprefixedDenom
-
-		// The denomination used to send the coins is either the native denom or the hash of the path
-		// if the denomination is not native.
-		denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
-		if denomTrace.Path != "" {
-			denom = denomTrace.IBCDenom()
-		}
-	} else {
-		prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
-		denom = transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+		return transfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):])
 	}
-	return denom
+	return transfertypes.ParseDenomTrace(transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom)
 }
 
 // IsAckError checks an IBC acknowledgement to see if it's an error.
@@ -2,13 +2,17 @@ package ibchooks
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 
 	"github.com/notional-labs/composable/v6/x/ibc-hooks/keeper"
@@ -17,12 +21,10 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/gogoproto/proto"
 )
 
-type ContractAck struct {
-	ContractResult []byte `json:"contract_result"`
-	IbcAck         []byte `json:"ibc_ack"`
-}
+type ContractAck = types.ContractAck
 
 type WasmHooks struct {
 	ContractKeeper      *wasmkeeper.Keeper
@@ -47,21 +49,44 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 		// Not configured
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
+	// Contracts are only executed with the funds of ICS-20 transfers
+	if packet.GetDestPort() != transfertypes.PortID {
+		return im.App.OnRecvPacket(ctx, packet, relayer)
+	}
 	isIcs20, data := isIcs20Packet(packet.GetData())
 	if !isIcs20 {
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
+	receivedPacket := packet
 
 	// Validate the memo
 	isWasmRouted, contractAddr, msgBytes, err := ValidateAndParseMemo(data.GetMemo(), data.Receiver)
 	if !isWasmRouted {
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
+	// errorAck refunds the packet with an error acknowledgement. Core ibc discards the state changes and the events
+	// of the application on an error acknowledgement, so a failure is only observable with the acknowledgement and
+	// the logs.
+	errorAck := func(err error, errorContexts ...string) ibcexported.Acknowledgement {
+		h.ibcHooksKeeper.Logger(ctx).Error("wasm hook failed",
+			"contract", contractAddr.String(),
+			"original_sender", data.GetSender(),
+			"port_id", receivedPacket.GetDestPort(),
+			"channel_id", receivedPacket.GetDestChannel(),
+			"sequence", receivedPacket.GetSequence(),
+			"error", strings.Join(append([]string{err.Error()}, errorContexts...), ": "),
+		)
+		return NewEmitErrorAcknowledgement(ctx, err, errorContexts...)
+	}
 	if err != nil {
-		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
+		return errorAck(types.ErrMsgValidation, err.Error())
 	}
 	if msgBytes == nil || contractAddr == nil { // This should never happen
-		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation)
+		return errorAck(types.ErrMsgValidation)
+	}
+	// the rejection is only observable with the error acknowledgement and the logs, see errorAck
+	if err := h.ibcHooksKeeper.CheckContractPolicy(ctx, contractAddr); err != nil {
+		return errorAck(types.ErrContractNotAllowed, err.Error())
 	}
 
 	// Calculate the receiver / contract caller based on the packet's channel and sender
@@ -69,7 +94,22 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 	sender := data.GetSender()
 	senderBech32, err := keeper.DeriveIntermediateSender(channel, sender, h.bech32PrefixAccAddr)
 	if err != nil {
-		return NewEmitErrorAcknowledgement(ctx, types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", channel, sender, err.Error()))
+		return errorAck(types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", channel, sender, err.Error()))
+	}
+
+	// The funds returned by the contract to the intermediary account are forwarded after the contract call,
+	// so the forward is removed from the memo received by the packet forward middleware
+	forwardMetadata, isForwarded, err := types.ParseForwardMetadata(data.GetMemo())
+	if err != nil { // This should never happen, as the memo was validated
+		return errorAck(types.ErrMsgValidation, err.Error())
+	}
+	var balancesBefore sdk.Coins
+	if isForwarded {
+		data.Memo, err = memoWithoutKey(data.GetMemo(), "forward")
+		if err != nil {
+			return errorAck(types.ErrMarshaling, err.Error())
+		}
+		balancesBefore = h.ibcHooksKeeper.GetIntermediateBalances(ctx, sdk.MustAccAddressFromBech32(senderBech32))
 	}
 
 	// The funds sent on this packet need to be transferred to the intermediary account for the sender.
@@ -81,7 +121,7 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 	data.Receiver = senderBech32
 	bz, err := json.Marshal(data)
 	if err != nil {
-		return NewEmitErrorAcknowledgement(ctx, types.ErrMarshaling, err.Error())
+		return errorAck(types.ErrMarshaling, err.Error())
 	}
 	packet.Data = bz
 
@@ -95,29 +135,105 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 	if !ok {
 		// This should never happen, as it should've been caught in the underlaying call to OnRecvPacket,
 		// but returning here for completeness
-		return NewEmitErrorAcknowledgement(ctx, types.ErrInvalidPacket, "Amount is not an int")
+		return errorAck(types.ErrInvalidPacket, "Amount is not an int")
 	}
 
-	// The packet's denom is the denom in the sender chain. This needs to be converted to the local denom.
-	denom := MustExtractDenomFromPacketOnRecv(packet)
+	// The packet's denom is the denom in the sender chain. This needs to be converted to the local denom,
+	// which is the native denom minted by the transfer middleware for the vouchers of a parachain.
+	denom := h.ibcHooksKeeper.ReceivedDenom(ctx, packet.GetDestChannel(), MustExtractDenomFromPacketOnRecv(packet))
 	funds := sdk.NewCoins(sdk.NewCoin(denom, amount))
 
-	// Execute the contract
+	// Execute the contract, or call its ibc_packet_receive sudo entry point with the packet context. The
+	// packet context can only be passed by the chain, so the contract can trust it to authenticate the sender.
+	asyncAck, withPacketContext := wasmMemoOptions(data.GetMemo())
 	execMsg := wasmtypes.MsgExecuteContract{
 		Sender:   senderBech32,
 		Contract: contractAddr.String(),
 		Msg:      msgBytes,
 		Funds:    funds,
 	}
-	response, err := h.execWasmMsg(ctx, &execMsg)
+	// The contract runs with at most the execute gas limit, so it cannot use all the gas of the relayer's tx
+	var contractResult []byte
+	err = h.ibcHooksKeeper.RunWithGasLimit(ctx, h.ibcHooksKeeper.GetParams(ctx).ExecuteGasLimit, func(ctx sdk.Context) error {
+		if withPacketContext {
+			packetContext := newPacketContext(receivedPacket, sender, denom)
+			var err error
+			contractResult, err = h.ibcHooksKeeper.SudoWithPacketContext(ctx, contractAddr, sdk.MustAccAddressFromBech32(senderBech32), funds, msgBytes, packetContext)
+			return err
+		}
+		response, err := h.execWasmMsg(ctx, &execMsg)
+		if err != nil {
+			return err
+		}
+		contractResult = response.Data
+		return nil
+	})
+	if errors.Is(err, types.ErrGasLimitExceeded) {
+		return errorAck(types.ErrGasLimitExceeded, err.Error())
+	}
 	if err != nil {
-		return NewEmitErrorAcknowledgement(ctx, types.ErrWasmError, err.Error())
+		return errorAck(types.ErrWasmError, err.Error())
+	}
+	h.emitTypedEvent(ctx, &types.EventRecvHookExecuted{
+		Contract:       contractAddr.String(),
+		Sender:         senderBech32,
+		OriginalSender: sender,
+		PortID:         receivedPacket.GetDestPort(),
+		ChannelID:      receivedPacket.GetDestChannel(),
+		Sequence:       receivedPacket.GetSequence(),
+		Funds:          funds.String(),
+		AsyncAck:       asyncAck,
+		PacketContext:  withPacketContext,
+		Forwarded:      isForwarded,
+	})
+
+	// The packet forward middleware writes the acknowledgement once the forward is acknowledged
+	if isForwarded {
+		token, forwardSequence, err := h.ibcHooksKeeper.ForwardReturnedFunds(ctx, receivedPacket, contractAddr, sender, sdk.MustAccAddressFromBech32(senderBech32), balancesBefore, forwardMetadata)
+		if err != nil {
+			return errorAck(types.ErrForward, err.Error())
+		}
+		h.emitTypedEvent(ctx, &types.EventForward{
+			Contract:         contractAddr.String(),
+			Sender:           senderBech32,
+			OriginalSender:   sender,
+			PortID:           receivedPacket.GetDestPort(),
+			ChannelID:        receivedPacket.GetDestChannel(),
+			Sequence:         receivedPacket.GetSequence(),
+			Receiver:         forwardMetadata.Receiver,
+			ForwardPortID:    forwardMetadata.Port,
+			ForwardChannelID: forwardMetadata.Channel,
+			ForwardSequence:  forwardSequence,
+			Amount:           token.String(),
+		})
+		return nil
 	}
 
-	fullAck := ContractAck{ContractResult: response.Data, IbcAck: ack.Acknowledgement()}
+	// The contract writes the acknowledgement later with MsgEmitIBCAck
+	if asyncAck {
+		h.ibcHooksKeeper.SetAsyncAckPacket(ctx, types.AsyncAckPacket{
+			Packet:   receivedPacket,
+			Contract: contractAddr.String(),
+			IbcAck:   ack.Acknowledgement(),
+			Height:   ctx.BlockHeight(),
+			// the rate limit counted the packet received by the intermediate sender
+			RateLimitWindowID: h.ibcHooksKeeper.GetReceiveWindowID(ctx, packet),
+		})
+		h.emitTypedEvent(ctx, &types.EventAsyncAckPacket{
+			Contract:       contractAddr.String(),
+			Sender:         senderBech32,
+			OriginalSender: sender,
+			PortID:         receivedPacket.GetDestPort(),
+			ChannelID:      receivedPacket.GetDestChannel(),
+			Sequence:       receivedPacket.GetSequence(),
+		})
+		return nil
+	}
+
+	fullAck := ContractAck{ContractResult: contractResult, IbcAck: ack.Acknowledgement()}
 	bz, err = json.Marshal(fullAck)
 	if err != nil {
-		return NewEmitErrorAcknowledgement(ctx, types.ErrBadResponse, err.Error())
+		return errorAck(types.ErrBadResponse, err.Error())
 	}
 	return channeltypes.NewResultAcknowledgement(bz)
 }
@@ -132,12 +248,12 @@ func (h WasmHooks) SendPacketOverride(
 	timeoutTimestamp uint64,
 	data []byte,
 ) (sequence uint64, err error) {
-	isIcs20, Ics20PacketData := isIcs20Packet(data)
-	if !isIcs20 {
+	memo, withMemo, hasMemo := packetMemo(sourcePort, data)
+	if !hasMemo {
 		return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data) // continue
 	}
 
-	isCallbackRouted, metadata := jsonStringHasKey(Ics20PacketData.GetMemo(), types.IBCCallbackKey)
+	isCallbackRouted, metadata := types.JSONStringHasKey(memo, types.IBCCallbackKey)
 	if !isCallbackRouted {
 		return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data) // continue
 	}
@@ -156,30 +272,42 @@ func (h WasmHooks) SendPacketOverride(
 	}
 	stringMetadata := string(bzMetadata)
 	if stringMetadata == "{}" {
-		Ics20PacketData.Memo = ""
-	} else {
-		Ics20PacketData.Memo = stringMetadata
+		stringMetadata = ""
 	}
-	dataBytes, err := json.Marshal(Ics20PacketData)
+	dataBytes, err := withMemo(stringMetadata)
 	if err != nil {
 		return 0, errorsmod.Wrap(err, "Send packet with callback error")
 	}
 
+	// Make sure the callback contract is a string and a valid bech32 addr. If it isn't, ignore the callback
+	var callbackContract string
+	if contract, ok := callbackRaw.(string); ok {
+		if contractAddr, err := sdk.AccAddressFromBech32(contract); err == nil {
+			// the packet is not sent rather than sent without the callback the sender relies on
+			if err := h.ibcHooksKeeper.CheckContractPolicy(ctx, contractAddr); err != nil {
+				h.emitContractNotAllowed(ctx, contract, sourcePort, sourceChannel, 0, err)
+				return 0, err
+			}
+			callbackContract = contract
+		}
+	}
+
 	seq, err := i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, dataBytes)
 	if err != nil {
 		return seq, err
 	}
 
-	// Make sure the callback contract is a string and a valid bech32 addr. If it isn't, ignore this packet
-	contract, ok := callbackRaw.(string)
-	if !ok {
+	if callbackContract == "" {
 		return seq, nil
 	}
-	_, err = sdk.AccAddressFromBech32(contract)
-	if err != nil {
-		return seq, nil
-	}
-	h.ibcHooksKeeper.StorePacketCallback(ctx, sourceChannel, seq, contract)
+	h.ibcHooksKeeper.StorePacketCallback(ctx, sourcePort, sourceChannel, seq, callbackContract)
+	h.emitTypedEvent(ctx, &types.EventPacketCallbackRegistered{
+		Contract:  callbackContract,
+		Sender:    packetSender(sourcePort, data),
+		PortID:    sourcePort,
+		ChannelID: sourceChannel,
+		Sequence:  seq,
+	})
 	return seq, nil
 }
 
@@ -194,7 +322,20 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 		return nil
 	}
 
-	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	// The packet forward middleware doesn't refund a failed forward of the funds returned by a contract
+	if forward, found := h.ibcHooksKeeper.GetForwardRefund(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()); found {
+		h.ibcHooksKeeper.DeleteForwardRefund(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		if IsAckError(acknowledgement) {
+			err := h.refundForward(ctx, packet, forward, false, func(app porttypes.IBCModule) error {
+				return app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 	if contract == "" {
 		// No callback configured
 		return nil
@@ -220,18 +361,30 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 	sudoMsg := []byte(fmt.Sprintf(
 		`{"ibc_lifecycle_complete": {"ibc_ack": {"channel": "%s", "sequence": %d, "ack": %s, "success": %s}}}`,
 		packet.SourceChannel, packet.Sequence, ackAsJSON, success))
-	_, err = h.ContractKeeper.Sudo(ctx, contractAddr, sudoMsg)
-	if err != nil {
-		// error processing the callback
-		// ToDo: Open Question: Should we also delete the callback here?
-		return errorsmod.Wrap(err, "Ack callback error")
-	}
-
-	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	h.deletePacketCallback(ctx, packet, contract)
+	event := &types.EventAckCallback{
+		Contract:   contract,
+		PortID:     packet.GetSourcePort(),
+		ChannelID:  packet.GetSourceChannel(),
+		Sequence:   packet.GetSequence(),
+		AckSuccess: success == "true",
+	}
+	if err := h.ibcHooksKeeper.SudoCallback(ctx, contractAddr, sudoMsg); err != nil {
+		// error processing the callback, or the contract is not allowed by the contract policy. Failing here
+		// would leave the ack to be relayed again forever, so the callback is recorded as failed and the ack
+		// completes. Anyone can retry the callback later.
+		h.failCallback(ctx, types.EventTypeAckCallbackError, packet, contractAddr, sudoMsg, err)
+		event.Error = err.Error()
+	}
+	h.emitTypedEvent(ctx, event)
 	return nil
 }
 
 func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	// A forward which is retried by the packet forward middleware is sent again with the next sequence
+	forward, forwarded := h.ibcHooksKeeper.GetForwardRefund(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	nextSequence := h.ibcHooksKeeper.GetNextSequenceSend(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+
 	err := im.App.OnTimeoutPacket(ctx, packet, relayer)
 	if err != nil {
 		return err
@@ -242,7 +395,22 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 		return nil
 	}
 
-	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if forwarded {
+		h.ibcHooksKeeper.DeleteForwardRefund(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		if h.ibcHooksKeeper.GetNextSequenceSend(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) > nextSequence {
+			forward.Sequence = nextSequence
+			h.ibcHooksKeeper.SetForwardRefund(ctx, forward)
+		} else {
+			err := h.refundForward(ctx, packet, forward, true, func(app porttypes.IBCModule) error {
+				return app.OnTimeoutPacket(ctx, packet, relayer)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 	if contract == "" {
 		// No callback configured
 		return nil
@@ -256,81 +424,96 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 	sudoMsg := []byte(fmt.Sprintf(
 		`{"ibc_lifecycle_complete": {"ibc_timeout": {"channel": "%s", "sequence": %d}}}`,
 		packet.SourceChannel, packet.Sequence))
-	_, err = h.ContractKeeper.Sudo(ctx, contractAddr, sudoMsg)
-	if err != nil {
-		// error processing the callback. This could be because the contract doesn't implement the message type to
-		// process the callback. Retrying this will not help, so we can delete the callback from storage.
-		// Since the packet has timed out, we don't expect any other responses that may trigger the callback.
-		ctx.EventManager().EmitEvents(sdk.Events{
-			sdk.NewEvent(
-				"ibc-timeout-callback-error",
-				sdk.NewAttribute("contract", contractAddr.String()),
-				sdk.NewAttribute("message", string(sudoMsg)),
-				sdk.NewAttribute("error", err.Error()),
-			),
-		})
-	}
-	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	h.deletePacketCallback(ctx, packet, contract)
+	event := &types.EventTimeoutCallback{
+		Contract:  contract,
+		PortID:    packet.GetSourcePort(),
+		ChannelID: packet.GetSourceChannel(),
+		Sequence:  packet.GetSequence(),
+	}
+	if err := h.ibcHooksKeeper.SudoCallback(ctx, contractAddr, sudoMsg); err != nil {
+		// error processing the callback, or the contract is not allowed by the contract policy. Since the packet
+		// has timed out, we don't expect any other responses that may trigger the callback, so it is recorded as
+		// failed for anyone to retry it later.
+		h.failCallback(ctx, types.EventTypeTimeoutCallbackError, packet, contractAddr, sudoMsg, err)
+		event.Error = err.Error()
+	}
+	h.emitTypedEvent(ctx, event)
 	return nil
 }
 
-func ValidateAndParseMemo(memo, receiver string) (isWasmRouted bool, contractAddr sdk.AccAddress, msgBytes []byte, err error) {
-	isWasmRouted, metadata := jsonStringHasKey(memo, "wasm")
-	if !isWasmRouted {
-		return isWasmRouted, sdk.AccAddress{}, nil, nil
-	}
-
-	wasmRaw := metadata["wasm"]
-
-	// Make sure the wasm key is a map. If it isn't, ignore this packet
-	wasm, ok := wasmRaw.(map[string]interface{})
-	if !ok {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, "wasm metadata is not a valid JSON map object")
-	}
-
-	// Get the contract
-	contract, ok := wasm["contract"].(string)
-	if !ok {
-		// The tokens will be returned
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `Could not find key wasm["contract"]`)
-	}
-
-	contractAddr, err = sdk.AccAddressFromBech32(contract)
+// refundForward refunds a failed forward of the funds returned by a contract to the contract
+func (h WasmHooks) refundForward(ctx sdk.Context, packet channeltypes.Packet, forward types.ForwardRefund, timeout bool, refund func(app porttypes.IBCModule) error) error {
+	refunded, err := h.ibcHooksKeeper.RefundForward(ctx, forward, refund)
 	if err != nil {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["contract"] is not a valid bech32 address`)
-	}
+		return errorsmod.Wrap(err, "Forward refund error")
+	}
+	h.emitTypedEvent(ctx, &types.EventForwardRefund{
+		Contract:  forward.Contract,
+		Sender:    forward.Sender,
+		PortID:    packet.GetSourcePort(),
+		ChannelID: packet.GetSourceChannel(),
+		Sequence:  packet.GetSequence(),
+		Amount:    refunded.String(),
+		Timeout:   timeout,
+	})
+	return nil
+}
 
-	// The contract and the receiver should be the same for the packet to be valid
-	if contract != receiver {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["contract"] should be the same as the receiver of the packet`)
-	}
+// deletePacketCallback deletes the callback of a sent packet before calling its contract
+func (h WasmHooks) deletePacketCallback(ctx sdk.Context, packet channeltypes.Packet, contract string) {
+	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	h.emitTypedEvent(ctx, &types.EventPacketCallbackDeleted{
+		Contract:  contract,
+		PortID:    packet.GetSourcePort(),
+		ChannelID: packet.GetSourceChannel(),
+		Sequence:  packet.GetSequence(),
+	})
+}
 
-	// Ensure the message key is provided
-	if wasm["msg"] == nil {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `Could not find key wasm["msg"]`)
-	}
+// failCallback records the failed callback of a packet and emits an event of eventType about it, and the
+// rejection of the contract if the contract policy did not allow calling it
+func (h WasmHooks) failCallback(ctx sdk.Context, eventType string, packet channeltypes.Packet, contractAddr sdk.AccAddress, sudoMsg []byte, err error) {
+	if errors.Is(err, types.ErrContractNotAllowed) {
+		h.emitContractNotAllowed(ctx, contractAddr.String(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), err)
+	}
+	h.ibcHooksKeeper.StoreFailedCallback(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), contractAddr.String(), sudoMsg, err)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyContract, contractAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyMessage, string(sudoMsg)),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			sdk.NewAttribute(types.AttributeKeyPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		),
+	})
+}
 
-	// Make sure the msg key is a map. If it isn't, return an error
-	_, ok = wasm["msg"].(map[string]interface{})
-	if !ok {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["msg"] is not a map object`)
-	}
+// emitContractNotAllowed emits the rejection of a contract by the contract policy, the sequence is 0 for a packet
+// which is not sent
+func (h WasmHooks) emitContractNotAllowed(ctx sdk.Context, contract, port, channel string, sequence uint64, err error) {
+	h.emitTypedEvent(ctx, &types.EventContractNotAllowed{
+		Contract:  contract,
+		PortID:    port,
+		ChannelID: channel,
+		Sequence:  sequence,
+		Error:     err.Error(),
+	})
+}
 
-	// Get the message string by serializing the map
-	msgBytes, err = json.Marshal(wasm["msg"])
-	if err != nil {
-		// The tokens will be returned
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, err.Error())
+// emitTypedEvent emits an event of the hooks, an event which cannot be marshaled is only logged as it must not
+// change the outcome of the packet
+func (h WasmHooks) emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		h.ibcHooksKeeper.Logger(ctx).Error("cannot emit event", "event", proto.MessageName(event), "error", err)
 	}
+}
 
-	return isWasmRouted, contractAddr, msgBytes, nil
+// ValidateAndParseMemo returns the contract and the message executed by the wasm memo of a packet sent to the receiver
+func ValidateAndParseMemo(memo, receiver string) (isWasmRouted bool, contractAddr sdk.AccAddress, msgBytes []byte, err error) {
+	return types.ValidateAndParseMemo(memo, receiver)
 }
 
 func isIcs20Packet(data []byte) (isIcs20 bool, ics20data transfertypes.FungibleTokenPacketData) {
@@ -341,30 +524,90 @@ func isIcs20Packet(data []byte) (isIcs20 bool, ics20data transfertypes.FungibleT
 	return true, packetData
 }
 
-// jsonStringHasKey parses the memo as a json object and checks if it contains the key.
-func jsonStringHasKey(memo, key string) (found bool, jsonObject map[string]interface{}) {
-	jsonObject = make(map[string]interface{})
+// packetSender returns the sender of an ICS-20 packet, the packets of the other applications have no known sender
+func packetSender(port string, data []byte) string {
+	if port != transfertypes.PortID {
+		return ""
+	}
+	_, ics20Data := isIcs20Packet(data)
+	return ics20Data.Sender
+}
 
-	// If there is no memo, the packet was either sent with an earlier version of IBC, or the memo was
-	// intentionally left blank. Nothing to do here. Ignore the packet and pass it down the stack.
-	if len(memo) == 0 {
-		return false, jsonObject
+// packetMemo returns the memo of an ICS-20 packet, or of the JSON packet data of another application
+// with a memo string field, and a function returning the packet data with another memo.
+func packetMemo(port string, data []byte) (memo string, withMemo func(memo string) ([]byte, error), hasMemo bool) {
+	if port == transfertypes.PortID {
+		isIcs20, ics20Data := isIcs20Packet(data)
+		if !isIcs20 {
+			return "", nil, false
+		}
+		return ics20Data.Memo, func(memo string) ([]byte, error) {
+			ics20Data.Memo = memo
+			return json.Marshal(ics20Data)
+		}, true
+	}
+
+	var packetData map[string]json.RawMessage
+	if err := json.Unmarshal(data, &packetData); err != nil {
+		return "", nil, false
+	}
+	if err := json.Unmarshal(packetData["memo"], &memo); err != nil {
+		return "", nil, false
+	}
+	return memo, func(memo string) ([]byte, error) {
+		bzMemo, err := json.Marshal(memo)
+		if err != nil {
+			return nil, err
+		}
+		packetData["memo"] = bzMemo
+		return json.Marshal(packetData)
+	}, true
+}
+
+// newPacketContext returns the provenance of a received ICS-20 packet. The denom trace is the trace of the packet
+// denom on this chain while the received denom is the denom of the funds, they differ for the native denoms
+// minted by the transfer middleware.
+func newPacketContext(packet channeltypes.Packet, originalSender, receivedDenom string) types.IBCPacketContext {
+	denomTrace := ExtractDenomTraceFromPacketOnRecv(packet)
+	return types.IBCPacketContext{
+		OriginalSender:     originalSender,
+		SourcePort:         packet.GetSourcePort(),
+		SourceChannel:      packet.GetSourceChannel(),
+		DestinationPort:    packet.GetDestPort(),
+		DestinationChannel: packet.GetDestChannel(),
+		Sequence:           packet.GetSequence(),
+		DenomTrace:         types.PacketDenomTrace{Path: denomTrace.Path, BaseDenom: denomTrace.BaseDenom},
+		ReceivedDenom:      receivedDenom,
 	}
+}
 
-	// the jsonObject must be a valid JSON object
-	err := json.Unmarshal([]byte(memo), &jsonObject)
+// memoWithoutKey returns the memo without a top level key, the other keys are kept unchanged
+func memoWithoutKey(memo, key string) (string, error) {
+	var metadata map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return "", err
+	}
+	delete(metadata, key)
+	bz, err := json.Marshal(metadata)
 	if err != nil {
-		return false, jsonObject
+		return "", err
 	}
+	return string(bz), nil
+}
 
-	// If the key doesn't exist, there's nothing to do on this hook. Continue by passing the packet
-	// down the stack
-	_, ok := jsonObject[key]
-	if !ok {
-		return false, jsonObject
+// wasmMemoOptions returns the async_ack and the packet_context options of the wasm memo validated by
+// ValidateAndParseMemo, they are false if they aren't set
+func wasmMemoOptions(memo string) (asyncAck, packetContext bool) {
+	var metadata struct {
+		Wasm struct {
+			AsyncAck      bool `json:"async_ack"`
+			PacketContext bool `json:"packet_context"`
+		} `json:"wasm"`
 	}
-
-	return true, jsonObject
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return false, false
+	}
+	return metadata.Wasm.AsyncAck, metadata.Wasm.PacketContext
 }
 
 func (h WasmHooks) execWasmMsg(ctx sdk.Context, execMsg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
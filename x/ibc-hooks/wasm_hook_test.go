@@ -0,0 +1,291 @@
+package ibchooks_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	ibchooks "github.com/notional-labs/composable/v6/x/ibc-hooks"
+	"github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+// sentPacketChannel records the data of the packets it sends
+type sentPacketChannel struct {
+	porttypes.ICS4Wrapper
+	data []byte
+}
+
+func (c *sentPacketChannel) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, _, _ string, _ clienttypes.Height, _ uint64, data []byte) (uint64, error) {
+	c.data = data
+	return 7, nil
+}
+
+func TestSendPacketCallbackOnOtherPorts(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+	port := "wasm." + sdk.AccAddress([]byte("sender______________")).String()
+
+	for _, tc := range []struct {
+		name         string
+		data         string
+		expectedData string
+		callback     bool
+	}{
+		{
+			"callback only",
+			`{"amount":100,"memo":"{\"ibc_callback\":\"` + contract + `\"}"}`,
+			`{"amount":100,"memo":""}`,
+			true,
+		},
+		{
+			"callback and other keys",
+			`{"amount":100,"memo":"{\"ibc_callback\":\"` + contract + `\",\"note\":\"hello\"}"}`,
+			`{"amount":100,"memo":"{\"note\":\"hello\"}"}`,
+			true,
+		},
+		{"memo without callback", `{"memo":"{\"note\":\"hello\"}"}`, `{"memo":"{\"note\":\"hello\"}"}`, false},
+		{"no memo field", `{"amount":100}`, `{"amount":100}`, false},
+		{"memo is not a string", `{"memo":{"ibc_callback":"` + contract + `"}}`, `{"memo":{"ibc_callback":"` + contract + `"}}`, false},
+		{"not json", `binary`, `binary`, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			channel := &sentPacketChannel{}
+			ics4 := ibchooks.NewICS4Middleware(channel, app.Ics20WasmHooks)
+
+			seq, err := ics4.SendPacket(ctx, nil, port, "channel-0", clienttypes.NewHeight(0, 100), 0, []byte(tc.data))
+			require.NoError(t, err)
+			require.Equal(t, uint64(7), seq)
+
+			if json.Valid([]byte(tc.expectedData)) {
+				require.JSONEq(t, tc.expectedData, string(channel.data))
+			} else {
+				require.Equal(t, tc.expectedData, string(channel.data))
+			}
+
+			expectedCallback := ""
+			if tc.callback {
+				expectedCallback = contract
+			}
+			require.Equal(t, expectedCallback, app.IBCHooksKeeper.GetPacketCallback(ctx, port, "channel-0", 7))
+			require.Equal(t, "", app.IBCHooksKeeper.GetPacketCallback(ctx, "transfer", "channel-0", 7))
+		})
+	}
+}
+
+func TestSendPacketCallbackContractPolicy(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+	port := "wasm." + sdk.AccAddress([]byte("sender______________")).String()
+	data := `{"amount":100,"memo":"{\"ibc_callback\":\"` + contract + `\"}"}`
+
+	for _, tc := range []struct {
+		name     string
+		policy   types.ContractPolicy
+		allowed  []string
+		disabled []string
+		sent     bool
+	}{
+		{"open", types.CONTRACT_POLICY_OPEN, nil, nil, true},
+		{"disabled", types.CONTRACT_POLICY_OPEN, nil, []string{contract}, false},
+		{"allowed", types.CONTRACT_POLICY_ALLOWLIST, []string{contract}, nil, true},
+		// the contract does not exist, so it has no allowed code id
+		{"not allowed", types.CONTRACT_POLICY_ALLOWLIST, nil, nil, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			params := types.DefaultParams()
+			params.ContractPolicy = tc.policy
+			params.AllowedCodeIDs = []uint64{1}
+			params.AllowedContracts = tc.allowed
+			params.DisabledContracts = tc.disabled
+			app.IBCHooksKeeper.SetParams(ctx, params)
+			channel := &sentPacketChannel{}
+			ics4 := ibchooks.NewICS4Middleware(channel, app.Ics20WasmHooks)
+
+			_, err := ics4.SendPacket(ctx, nil, port, "channel-0", clienttypes.NewHeight(0, 100), 0, []byte(data))
+			if tc.sent {
+				require.NoError(t, err)
+				require.JSONEq(t, `{"amount":100,"memo":""}`, string(channel.data))
+				require.Equal(t, contract, app.IBCHooksKeeper.GetPacketCallback(ctx, port, "channel-0", 7))
+				return
+			}
+
+			require.ErrorIs(t, err, types.ErrContractNotAllowed)
+			require.Nil(t, channel.data)
+			require.Equal(t, "", app.IBCHooksKeeper.GetPacketCallback(ctx, port, "channel-0", 7))
+			requireTypedEvent(t, ctx, &types.EventContractNotAllowed{Contract: contract, PortID: port, ChannelID: "channel-0", Error: err.Error()})
+		})
+	}
+}
+
+// completedPacketApp completes the acks and the timeouts of the packets without doing anything
+type completedPacketApp struct {
+	porttypes.IBCModule
+}
+
+func (completedPacketApp) OnAcknowledgementPacket(_ sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress) error {
+	return nil
+}
+
+func (completedPacketApp) OnTimeoutPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) error {
+	return nil
+}
+
+// requireTypedEvent requires the last event with the type of the expected event to be the expected event
+func requireTypedEvent(t *testing.T, ctx sdk.Context, expected proto.Message) {
+	t.Helper()
+	events := ctx.EventManager().ABCIEvents()
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type != proto.MessageName(expected) {
+			continue
+		}
+		event, err := sdk.ParseTypedEvent(events[i])
+		require.NoError(t, err)
+		require.Equal(t, expected, event)
+		return
+	}
+	require.Failf(t, "missing event", "no %s event", proto.MessageName(expected))
+}
+
+func TestPacketCallbackEvents(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+	sender := sdk.AccAddress([]byte("sender______________")).String()
+	data := transfertypes.NewFungibleTokenPacketData("stake", "100", sender, "receiver", `{"ibc_callback":"`+contract+`"}`)
+	packet := channeltypes.Packet{Sequence: 7, SourcePort: transfertypes.PortID, SourceChannel: "channel-0"}
+	ics4 := ibchooks.NewICS4Middleware(&sentPacketChannel{}, app.Ics20WasmHooks)
+	im := ibchooks.NewIBCMiddleware(completedPacketApp{}, &ics4)
+
+	// the contract keeper is replaced by the recorder after the first case
+	for _, tc := range []struct {
+		name     string
+		complete func(ctx sdk.Context) error
+		expected proto.Message
+	}{
+		{
+			"ack callback error",
+			func(ctx sdk.Context) error {
+				ack := channeltypes.NewErrorAcknowledgement(types.ErrWasmError)
+				return im.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+			},
+			// the contract does not exist
+			&types.EventAckCallback{Contract: contract, PortID: transfertypes.PortID, ChannelID: "channel-0", Sequence: 7, AckSuccess: false, Error: "address " + contract + ": no such contract"},
+		},
+		{
+			"ack callback",
+			func(ctx sdk.Context) error {
+				app.IBCHooksKeeper.SetContractKeeper(&sudoRecorder{})
+				ack := channeltypes.NewResultAcknowledgement([]byte{1})
+				return im.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+			},
+			&types.EventAckCallback{Contract: contract, PortID: transfertypes.PortID, ChannelID: "channel-0", Sequence: 7, AckSuccess: true},
+		},
+		{
+			"timeout callback",
+			func(ctx sdk.Context) error {
+				app.IBCHooksKeeper.SetContractKeeper(&sudoRecorder{})
+				return im.OnTimeoutPacket(ctx, packet, nil)
+			},
+			&types.EventTimeoutCallback{Contract: contract, PortID: transfertypes.PortID, ChannelID: "channel-0", Sequence: 7},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			_, err := ics4.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.NewHeight(0, 100), 0, data.GetBytes())
+			require.NoError(t, err)
+			requireTypedEvent(t, ctx, &types.EventPacketCallbackRegistered{Contract: contract, Sender: sender, PortID: transfertypes.PortID, ChannelID: "channel-0", Sequence: 7})
+
+			require.NoError(t, tc.complete(ctx))
+			requireTypedEvent(t, ctx, &types.EventPacketCallbackDeleted{Contract: contract, PortID: transfertypes.PortID, ChannelID: "channel-0", Sequence: 7})
+			requireTypedEvent(t, ctx, tc.expected)
+		})
+	}
+}
+
+func TestPacketCallbackContractPolicy(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+	sender := sdk.AccAddress([]byte("sender______________")).String()
+	data := transfertypes.NewFungibleTokenPacketData("stake", "100", sender, "receiver", `{"ibc_callback":"`+contract+`"}`)
+	packet := channeltypes.Packet{Sequence: 7, SourcePort: transfertypes.PortID, SourceChannel: "channel-0"}
+	ics4 := ibchooks.NewICS4Middleware(&sentPacketChannel{}, app.Ics20WasmHooks)
+	im := ibchooks.NewIBCMiddleware(completedPacketApp{}, &ics4)
+
+	for _, tc := range []struct {
+		name      string
+		eventType string
+		complete  func(ctx sdk.Context) error
+	}{
+		{
+			"ack callback",
+			types.EventTypeAckCallbackError,
+			func(ctx sdk.Context) error {
+				ack := channeltypes.NewResultAcknowledgement([]byte{1})
+				return im.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+			},
+		},
+		{
+			"timeout callback",
+			types.EventTypeTimeoutCallbackError,
+			func(ctx sdk.Context) error {
+				return im.OnTimeoutPacket(ctx, packet, nil)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			recorder := &sudoRecorder{}
+			app.IBCHooksKeeper.SetContractKeeper(recorder)
+
+			_, err := ics4.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.NewHeight(0, 100), 0, data.GetBytes())
+			require.NoError(t, err)
+
+			// the contract is disabled after the packet was sent, the callback is recorded as failed without
+			// calling the contract
+			params := types.DefaultParams()
+			params.DisabledContracts = []string{contract}
+			app.IBCHooksKeeper.SetParams(ctx, params)
+			require.NoError(t, tc.complete(ctx))
+			require.Empty(t, recorder.msgs)
+
+			callback, found := app.IBCHooksKeeper.GetFailedCallback(ctx, transfertypes.PortID, "channel-0", 7)
+			require.True(t, found)
+			require.Equal(t, contract, callback.Contract)
+			require.Equal(t, "codespace: wasm-hooks, code: 14", callback.Error)
+			var eventTypes []string
+			for _, event := range ctx.EventManager().Events() {
+				eventTypes = append(eventTypes, event.Type)
+			}
+			require.Contains(t, eventTypes, proto.MessageName(&types.EventContractNotAllowed{}))
+			require.Contains(t, eventTypes, tc.eventType)
+
+			// the callback can't be retried until the contract is allowed again
+			_, err = app.IBCHooksKeeper.RetryFailedCallback(ctx, transfertypes.PortID, "channel-0", 7)
+			require.ErrorIs(t, err, types.ErrCallbackFailed)
+			require.ErrorContains(t, err, types.ErrContractNotAllowed.Error())
+			require.Empty(t, recorder.msgs)
+
+			app.IBCHooksKeeper.SetParams(ctx, types.DefaultParams())
+			_, err = app.IBCHooksKeeper.RetryFailedCallback(ctx, transfertypes.PortID, "channel-0", 7)
+			require.NoError(t, err)
+			require.Equal(t, [][]byte{[]byte(callback.SudoMsg)}, recorder.msgs)
+			_, found = app.IBCHooksKeeper.GetFailedCallback(ctx, transfertypes.PortID, "channel-0", 7)
+			require.False(t, found)
+		})
+	}
+}
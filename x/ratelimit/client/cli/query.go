@@ -24,6 +24,9 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdQueryAllRateLimits(),
+		GetCmdQueryPendingSendPackets(),
+		GetCmdQueryEpochInfos(),
+		GetCmdQueryEpochInfo(),
 	)
 	return cmd
 }
@@ -52,3 +55,78 @@ func GetCmdQueryAllRateLimits() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryPendingSendPackets return the pending send packets of a path.
+func GetCmdQueryPendingSendPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pending-send-packets [channel-id] [denom]",
+		Short: "Query the pending send packets of a path",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingSendPacketsRequest{ChannelID: args[0], Denom: args[1]}
+			res, err := queryClient.PendingSendPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEpochInfos return all epochs driving rate limit windows.
+func GetCmdQueryEpochInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-epochs",
+		Short: "Query all epochs driving rate limit windows",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEpochInfosRequest{}
+			res, err := queryClient.EpochInfos(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEpochInfo return an epoch by identifier.
+func GetCmdQueryEpochInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch [identifier]",
+		Short: "Query an epoch by identifier",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEpochInfoRequest{Identifier: args[0]}
+			res, err := queryClient.EpochInfo(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,10 @@ package cli
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	"github.com/notional-labs/composable/v6/x/ratelimit/types"
@@ -15,9 +19,119 @@ func GetTxCmd() *cobra.Command {
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
 		Short:                      fmt.Sprintf("Tx commands for the %s module", types.ModuleName),
+		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand()
+	txCmd.AddCommand(
+		BlacklistDenom(),
+		RemoveBlacklistedDenom(),
+		PausePath(),
+		UnpausePath(),
+	)
 
 	return txCmd
 }
+
+// BlacklistDenom returns the command handler denying all the IBC transfers of a denom.
+func BlacklistDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "blacklist-denom",
+		Short:   "deny all the IBC transfers of a denom",
+		Args:    cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		Example: fmt.Sprintf("%s tx ratelimit blacklist-denom [denom]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBlacklistDenom(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RemoveBlacklistedDenom returns the command handler allowing again the IBC transfers of a blacklisted denom.
+func RemoveBlacklistedDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-blacklisted-denom",
+		Short:   "allow again the IBC transfers of a blacklisted denom",
+		Args:    cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		Example: fmt.Sprintf("%s tx ratelimit remove-blacklisted-denom [denom]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveBlacklistedDenom(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// PausePath returns the command handler denying the IBC transfers of a denom on a channel.
+func PausePath() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause-path",
+		Short:   "deny the IBC transfers of a denom on a channel regardless of the quota",
+		Args:    cobra.MatchAll(cobra.ExactArgs(2), cobra.OnlyValidArgs),
+		Example: fmt.Sprintf("%s tx ratelimit pause-path [denom] [channel_id]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPausePath(clientCtx.GetFromAddress().String(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// UnpausePath returns the command handler resuming the IBC transfers of a denom on a channel.
+func UnpausePath() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unpause-path",
+		Short:   "resume the IBC transfers of a denom on a channel",
+		Args:    cobra.MatchAll(cobra.ExactArgs(2), cobra.OnlyValidArgs),
+		Example: fmt.Sprintf("%s tx ratelimit unpause-path [denom] [channel_id]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnpausePath(clientCtx.GetFromAddress().String(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -112,7 +112,7 @@ func (im IBCMiddleware) OnRecvPacket(
 	// and if so, return an ack error
 	if err := im.keeper.ReceiveRateLimitedPacket(ctx, packet); err != nil {
 		im.keeper.Logger(ctx).Error(fmt.Sprintf("ICS20 packet receive was denied: %s", err.Error()))
-		return channeltypes.NewErrorAcknowledgement(err)
+		return im.keeper.NewDeniedRecvAcknowledgement(ctx, packet, err)
 	}
 
 	// If the packet was not rate-limited, pass it down to the Transfer OnRecvPacket callback
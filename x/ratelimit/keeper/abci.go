@@ -16,9 +16,9 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	k.IterateEpochInfo(ctx, func(index int64, epochInfo types.EpochInfo) (stop bool) {
 		logger := k.Logger(ctx)
 
-		// If blocktime < initial epoch start time, return
+		// If blocktime < initial epoch start time, skip this epoch
 		if ctx.BlockTime().Before(epochInfo.StartTime) {
-			return true
+			return false
 		}
 		// if epoch counting hasn't started, signal we need to start.
 		shouldInitialEpochStart := !epochInfo.EpochCountingStarted
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/composable/v6/x/ratelimit/types"
+)
+
+// Adds an amount to the flow of a single address and checks it against the per-address quota
+// The sender is limited on send and the receiver on receive, in the same window as the path quota
+func (k Keeper) UpdateAddressFlow(ctx sdk.Context, rateLimit types.RateLimit, direction types.PacketDirection, address string, amount math.Int) error {
+	if !rateLimit.Quota.HasAddressQuota() {
+		return nil
+	}
+
+	denom := rateLimit.Path.Denom
+	channelID := rateLimit.Path.ChannelID
+
+	addressFlow := k.GetAddressFlow(ctx, denom, channelID, direction, address).Add(amount)
+	if rateLimit.Quota.CheckExceedsAddressQuota(direction, addressFlow, rateLimit.Flow.ChannelValue, rateLimit.MinRateLimitAmount) {
+		threshold, _ := rateLimit.Quota.GetAddressThreshold(direction, rateLimit.Flow.ChannelValue, rateLimit.MinRateLimitAmount)
+		return errorsmod.Wrapf(types.ErrAddressQuotaExceeded,
+			"%s flow of %s exceeds address quota - Flow: %v, Threshold: %v",
+			direction.String(), address, addressFlow, threshold)
+	}
+
+	k.SetAddressFlow(ctx, types.AddressFlow{
+		Denom:     denom,
+		ChannelID: channelID,
+		Direction: direction,
+		Address:   address,
+		Amount:    addressFlow,
+	})
+	return nil
+}
+
+// Decrements the flow of a single address, used when a sent packet fails or times out
+func (k Keeper) UndoAddressFlow(ctx sdk.Context, denom, channelID string, direction types.PacketDirection, address string, amount math.Int) {
+	addressFlow := k.GetAddressFlow(ctx, denom, channelID, direction, address)
+	if addressFlow.IsZero() {
+		return
+	}
+
+	addressFlow = addressFlow.Sub(amount)
+	if addressFlow.IsNegative() {
+		addressFlow = math.ZeroInt()
+	}
+
+	k.SetAddressFlow(ctx, types.AddressFlow{
+		Denom:     denom,
+		ChannelID: channelID,
+		Direction: direction,
+		Address:   address,
+		Amount:    addressFlow,
+	})
+}
+
+// Stores/Updates the flow of an address on a path
+func (k Keeper) SetAddressFlow(ctx sdk.Context, addressFlow types.AddressFlow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressFlowKeyPrefix)
+	key := types.GetAddressFlowKey(addressFlow.Denom, addressFlow.ChannelID, addressFlow.Direction, addressFlow.Address)
+
+	if addressFlow.Amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&addressFlow))
+}
+
+// Returns the amount transferred by an address on a path within the current window
+func (k Keeper) GetAddressFlow(ctx sdk.Context, denom, channelID string, direction types.PacketDirection, address string) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressFlowKeyPrefix)
+	key := types.GetAddressFlowKey(denom, channelID, direction, address)
+
+	value := store.Get(key)
+	if len(value) == 0 {
+		return math.ZeroInt()
+	}
+
+	addressFlow := types.AddressFlow{}
+	k.cdc.MustUnmarshal(value, &addressFlow)
+	return addressFlow.Amount
+}
+
+// Removes the flows of all addresses on a path
+// This is executed when the quota resets
+func (k Keeper) RemoveAllAddressFlows(ctx sdk.Context, denom, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressFlowKeyPrefix)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAddressFlowPathPrefix(denom, channelID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
+
+// Get the flows of all addresses
+func (k Keeper) GetAllAddressFlows(ctx sdk.Context) []types.AddressFlow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressFlowKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allAddressFlows := []types.AddressFlow{}
+	for ; iterator.Valid(); iterator.Next() {
+		addressFlow := types.AddressFlow{}
+		k.cdc.MustUnmarshal(iterator.Value(), &addressFlow)
+		allAddressFlows = append(allAddressFlows, addressFlow)
+	}
+
+	return allAddressFlows
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/notional-labs/composable/v6/x/ratelimit/types"
@@ -42,7 +43,7 @@ func (k Keeper) AddEpochInfo(ctx sdk.Context, epoch types.EpochInfo) error {
 	}
 	// Check if identifier already exists
 	if (k.GetEpochInfo(ctx, epoch.Identifier) != types.EpochInfo{}) {
-		return fmt.Errorf("epoch with identifier %s already exists", epoch.Identifier)
+		return errorsmod.Wrapf(types.ErrEpochAlreadyExists, "epoch with identifier %s already exists", epoch.Identifier)
 	}
 
 	// Initialize empty and default epoch values
@@ -64,6 +65,40 @@ func (k Keeper) setEpochInfo(ctx sdk.Context, epoch types.EpochInfo) {
 	store.Set(append(types.EpochKeyPrefix, []byte(epoch.Identifier)...), value)
 }
 
+// UpdateEpochDuration updates the duration of an existing epoch.
+// The new duration applies from the current epoch on.
+func (k Keeper) UpdateEpochDuration(ctx sdk.Context, identifier string, duration time.Duration) error {
+	if !k.HasEpochInfo(ctx, identifier) {
+		return errorsmod.Wrapf(types.ErrEpochNotFound, "epoch with identifier %s not found", identifier)
+	}
+
+	epoch := k.GetEpochInfo(ctx, identifier)
+	epoch.Duration = duration
+	if err := epoch.Validate(); err != nil {
+		return err
+	}
+	k.setEpochInfo(ctx, epoch)
+	return nil
+}
+
+// RemoveEpochInfo removes an epoch. Will return an error if the epoch does not exist
+// or still drives the window of a rate limit.
+func (k Keeper) RemoveEpochInfo(ctx sdk.Context, identifier string) error {
+	if !k.HasEpochInfo(ctx, identifier) {
+		return errorsmod.Wrapf(types.ErrEpochNotFound, "epoch with identifier %s not found", identifier)
+	}
+
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if rateLimit.Quota.EpochIdentifierOrDefault() == identifier {
+			return errorsmod.Wrapf(types.ErrEpochInUse, "epoch %s is used by the rate limit of %s on %s",
+				identifier, rateLimit.Path.Denom, rateLimit.Path.ChannelID)
+		}
+	}
+
+	k.DeleteEpochInfo(ctx, identifier)
+	return nil
+}
+
 // DeleteEpochInfo delete epoch info.
 func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) {
 	store := ctx.KVStore(k.storeKey)
@@ -116,16 +151,36 @@ func (k Keeper) NumBlocksSinceEpochStart(ctx sdk.Context, identifier string) (in
 	return ctx.BlockHeight() - epoch.CurrentEpochStartHeight, nil
 }
 
+// GetQuotaResetTime returns the time at which the flow of the given rate limit is next reset,
+// or a zero time if the epoch driving its window has not started yet.
+func (k Keeper) GetQuotaResetTime(ctx sdk.Context, rateLimit types.RateLimit) time.Time {
+	if rateLimit.Quota == nil || rateLimit.Quota.DurationHours == 0 {
+		return time.Time{}
+	}
+	epochInfo := k.GetEpochInfo(ctx, rateLimit.Quota.EpochIdentifierOrDefault())
+	if !epochInfo.EpochCountingStarted {
+		return time.Time{}
+	}
+
+	// The quota is reset at the end of the first epoch whose number is a multiple of DurationHours
+	duration := rateLimit.Quota.DurationHours
+	remainingEpochs := (duration - uint64(epochInfo.CurrentEpoch)%duration) % duration
+	return epochInfo.CurrentEpochStartTime.Add(time.Duration(remainingEpochs+1) * epochInfo.Duration)
+}
+
+// AfterEpochEnd resets the rate limits whose window is driven by the ending epoch,
+// once every DurationHours epochs
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo types.EpochInfo) {
-	if epochInfo.Identifier == types.DayEpoch {
-		epochHour := uint64(epochInfo.CurrentEpoch)
-
-		for _, rateLimit := range k.GetAllRateLimits(ctx) {
-			if epochHour%rateLimit.Quota.DurationHours == 0 {
-				err := k.ResetRateLimit(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelID)
-				if err != nil {
-					k.Logger(ctx).Error(fmt.Sprintf("Unable to reset quota for Denom: %s, ChannelID: %s", rateLimit.Path.Denom, rateLimit.Path.ChannelID))
-				}
+	epochNumber := uint64(epochInfo.CurrentEpoch)
+
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if rateLimit.Quota.EpochIdentifierOrDefault() != epochInfo.Identifier {
+			continue
+		}
+		if epochNumber%rateLimit.Quota.DurationHours == 0 {
+			err := k.ResetRateLimit(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelID)
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Unable to reset quota for Denom: %s, ChannelID: %s", rateLimit.Path.Denom, rateLimit.Path.ChannelID))
 			}
 		}
 	}
@@ -1,9 +1,6 @@
 package keeper
 
 import (
-	"strconv"
-	"strings"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/notional-labs/composable/v6/x/ratelimit/types"
@@ -17,17 +14,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, addressPair := range genState.WhitelistedAddressPairs {
 		k.SetWhitelistedAddressPair(ctx, addressPair)
 	}
-	for _, pendingPacketID := range genState.PendingSendPacketSequenceNumbers {
-		splits := strings.Split(pendingPacketID, "/")
-		if len(splits) != 2 {
-			panic("Invalid pending send packet, must be of form: {channelID}/{sequenceNumber}")
-		}
-		channelID := splits[0]
-		sequence, err := strconv.ParseUint(splits[1], 10, 64)
-		if err != nil {
-			panic(err)
-		}
-		k.SetPendingSendPacket(ctx, channelID, sequence)
+	// Legacy pending packets (PendingSendPacketSequenceNumbers) don't record the denom
+	// and amount of the transfer so the outflow can't be reverted, they are ignored
+	for _, pendingPacket := range genState.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, pendingPacket)
+	}
+	for _, denom := range genState.BlacklistedDenoms {
+		k.AddDenomToBlacklist(ctx, denom)
+	}
+	for _, path := range genState.PausedPaths {
+		k.PausePath(ctx, path.Denom, path.ChannelID)
+	}
+	for _, addressFlow := range genState.AddressFlows {
+		k.SetAddressFlow(ctx, addressFlow)
 	}
 	for _, epoch := range genState.Epochs {
 		err := k.AddEpochInfo(ctx, epoch)
@@ -43,7 +42,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 	genesis.RateLimits = k.GetAllRateLimits(ctx)
 	genesis.WhitelistedAddressPairs = k.GetAllWhitelistedAddressPairs(ctx)
-	genesis.PendingSendPacketSequenceNumbers = k.GetAllPendingSendPackets(ctx)
+	genesis.PendingSendPackets = k.GetAllPendingSendPackets(ctx)
+	genesis.BlacklistedDenoms = k.GetAllBlacklistedDenoms(ctx)
+	genesis.PausedPaths = k.GetAllPausedPaths(ctx)
+	genesis.AddressFlows = k.GetAllAddressFlows(ctx)
+	genesis.Epochs = k.AllEpochInfos(ctx)
 
 	return genesis
 }
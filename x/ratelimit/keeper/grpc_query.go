@@ -77,3 +77,25 @@ func (k Keeper) AllWhitelistedAddresses(goCtx context.Context, _ *types.QueryAll
 	whitelistedAddresses := k.GetAllWhitelistedAddressPairs(ctx)
 	return &types.QueryAllWhitelistedAddressesResponse{AddressPairs: whitelistedAddresses}, nil
 }
+
+// Query all pending send packets of a path by denom and channelID
+func (k Keeper) PendingSendPackets(goCtx context.Context, req *types.QueryPendingSendPacketsRequest) (*types.QueryPendingSendPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pendingPackets := k.GetPendingSendPacketsByPath(ctx, req.ChannelID, req.Denom)
+	return &types.QueryPendingSendPacketsResponse{PendingSendPackets: pendingPackets}, nil
+}
+
+// Query all epochs driving rate limit windows
+func (k Keeper) EpochInfos(goCtx context.Context, _ *types.QueryEpochInfosRequest) (*types.QueryEpochInfosResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryEpochInfosResponse{Epochs: k.AllEpochInfos(ctx)}, nil
+}
+
+// Query an epoch by identifier
+func (k Keeper) EpochInfo(goCtx context.Context, req *types.QueryEpochInfoRequest) (*types.QueryEpochInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasEpochInfo(ctx, req.Identifier) {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch with identifier %s not found", req.Identifier)
+	}
+	return &types.QueryEpochInfoResponse{Epoch: k.GetEpochInfo(ctx, req.Identifier)}, nil
+}
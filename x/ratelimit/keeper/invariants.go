@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/composable/v6/x/ratelimit/types"
+)
+
+// RegisterInvariants registers the ratelimit module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "non-negative-flow", NonNegativeFlowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pending-send-packets", PendingSendPacketsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the ratelimit module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := NonNegativeFlowInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return PendingSendPacketsInvariant(k)(ctx)
+	}
+}
+
+// NonNegativeFlowInvariant checks that the inflow and outflow of all paths
+// and the flow of all addresses are never negative
+func NonNegativeFlowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, rateLimit := range k.GetAllRateLimits(ctx) {
+			if rateLimit.Flow.Inflow.IsNegative() || rateLimit.Flow.Outflow.IsNegative() {
+				count++
+				msg += fmt.Sprintf("\t%s on %s has a negative flow - Inflow: %v, Outflow: %v\n",
+					rateLimit.Path.Denom, rateLimit.Path.ChannelID, rateLimit.Flow.Inflow, rateLimit.Flow.Outflow)
+			}
+		}
+		for _, addressFlow := range k.GetAllAddressFlows(ctx) {
+			if addressFlow.Amount.IsNegative() {
+				count++
+				msg += fmt.Sprintf("\t%s flow of %s for %s on %s is negative: %v\n",
+					addressFlow.Direction.String(), addressFlow.Address, addressFlow.Denom, addressFlow.ChannelID, addressFlow.Amount)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "non-negative-flow",
+			fmt.Sprintf("amount of negative flows found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// PendingSendPacketsInvariant checks that all pending send packets reference an existing
+// rate limit and were not sent in a future quota window
+func PendingSendPacketsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, pendingPacket := range k.GetAllPendingSendPackets(ctx) {
+			rateLimit, found := k.GetRateLimit(ctx, pendingPacket.Denom, pendingPacket.ChannelID)
+			if !found {
+				count++
+				msg += fmt.Sprintf("\tpending packet %s/%d of %s has no rate limit\n",
+					pendingPacket.ChannelID, pendingPacket.Sequence, pendingPacket.Denom)
+				continue
+			}
+
+			if pendingPacket.WindowID > rateLimit.Flow.WindowID {
+				count++
+				msg += fmt.Sprintf("\tpending packet %s/%d of %s was sent in window %d, current window is %d\n",
+					pendingPacket.ChannelID, pendingPacket.Sequence, pendingPacket.Denom, pendingPacket.WindowID, rateLimit.Flow.WindowID)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "pending-send-packets",
+			fmt.Sprintf("amount of invalid pending send packets found %d\n%s", count, msg),
+		), broken
+	}
+}
@@ -90,3 +90,122 @@ func (k Keeper) ResetTransferRateLimit(goCtx context.Context, msg *types.MsgRese
 	}
 	return &types.MsgResetRateLimitResponse{}, nil
 }
+
+func (k Keeper) AddEpoch(goCtx context.Context, msg *types.MsgAddEpoch) (*types.MsgAddEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	epoch := types.NewGenesisEpochInfo(msg.Identifier, msg.Duration)
+	epoch.StartTime = msg.StartTime
+	err := k.AddEpochInfo(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddEpochResponse{}, nil
+}
+
+func (k Keeper) UpdateEpoch(goCtx context.Context, msg *types.MsgUpdateEpoch) (*types.MsgUpdateEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	err := k.UpdateEpochDuration(ctx, msg.Identifier, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEpochResponse{}, nil
+}
+
+func (k Keeper) RemoveEpoch(goCtx context.Context, msg *types.MsgRemoveEpoch) (*types.MsgRemoveEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	err := k.RemoveEpochInfo(ctx, msg.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveEpochResponse{}, nil
+}
+
+func (k Keeper) BlacklistDenom(goCtx context.Context, msg *types.MsgBlacklistDenom) (*types.MsgBlacklistDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	k.AddDenomToBlacklist(ctx, msg.Denom)
+
+	return &types.MsgBlacklistDenomResponse{}, nil
+}
+
+func (k Keeper) RemoveBlacklistedDenom(goCtx context.Context, msg *types.MsgRemoveBlacklistedDenom) (*types.MsgRemoveBlacklistedDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	k.RemoveDenomFromBlacklist(ctx, msg.Denom)
+
+	return &types.MsgRemoveBlacklistedDenomResponse{}, nil
+}
+
+func (k Keeper) PauseTransferPath(goCtx context.Context, msg *types.MsgPausePath) (*types.MsgPausePathResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	k.PausePath(ctx, msg.Denom, msg.ChannelID)
+
+	return &types.MsgPausePathResponse{}, nil
+}
+
+func (k Keeper) UnpauseTransferPath(goCtx context.Context, msg *types.MsgUnpausePath) (*types.MsgUnpausePathResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	k.UnpausePath(ctx, msg.Denom, msg.ChannelID)
+
+	return &types.MsgUnpausePathResponse{}, nil
+}
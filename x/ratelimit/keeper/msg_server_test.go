@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	"github.com/notional-labs/composable/v6/x/ratelimit/keeper"
+	"github.com/notional-labs/composable/v6/x/ratelimit/types"
+)
+
+func TestBlacklistDenom(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	msgServer := keeper.NewMsgServerImpl(app.RatelimitKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	other := sdk.AccAddress([]byte("other_______________")).String()
+
+	_, err := msgServer.BlacklistDenom(ctx, types.NewMsgBlacklistDenom(other, "ppica"))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.False(t, app.RatelimitKeeper.IsDenomBlacklisted(ctx, "ppica"))
+
+	_, err = msgServer.BlacklistDenom(ctx, types.NewMsgBlacklistDenom(authority, ""))
+	require.Error(t, err)
+
+	_, err = msgServer.BlacklistDenom(ctx, types.NewMsgBlacklistDenom(authority, "ppica"))
+	require.NoError(t, err)
+	require.True(t, app.RatelimitKeeper.IsDenomBlacklisted(ctx, "ppica"))
+	require.Equal(t, []string{"ppica"}, app.RatelimitKeeper.GetAllBlacklistedDenoms(ctx))
+
+	_, err = msgServer.RemoveBlacklistedDenom(ctx, types.NewMsgRemoveBlacklistedDenom(other, "ppica"))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.True(t, app.RatelimitKeeper.IsDenomBlacklisted(ctx, "ppica"))
+
+	_, err = msgServer.RemoveBlacklistedDenom(ctx, types.NewMsgRemoveBlacklistedDenom(authority, "ppica"))
+	require.NoError(t, err)
+	require.False(t, app.RatelimitKeeper.IsDenomBlacklisted(ctx, "ppica"))
+}
+
+func TestPausePath(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	msgServer := keeper.NewMsgServerImpl(app.RatelimitKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	other := sdk.AccAddress([]byte("other_______________")).String()
+
+	_, err := msgServer.PauseTransferPath(ctx, types.NewMsgPausePath(other, "ppica", "channel-0"))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.False(t, app.RatelimitKeeper.IsPathPaused(ctx, "ppica", "channel-0"))
+
+	_, err = msgServer.PauseTransferPath(ctx, types.NewMsgPausePath(authority, "ppica", "invalid channel"))
+	require.Error(t, err)
+
+	_, err = msgServer.PauseTransferPath(ctx, types.NewMsgPausePath(authority, "ppica", "channel-0"))
+	require.NoError(t, err)
+	require.True(t, app.RatelimitKeeper.IsPathPaused(ctx, "ppica", "channel-0"))
+	require.False(t, app.RatelimitKeeper.IsPathPaused(ctx, "ppica", "channel-1"))
+	require.Equal(t, []types.Path{{Denom: "ppica", ChannelID: "channel-0"}}, app.RatelimitKeeper.GetAllPausedPaths(ctx))
+
+	_, err = msgServer.UnpauseTransferPath(ctx, types.NewMsgUnpausePath(other, "ppica", "channel-0"))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.True(t, app.RatelimitKeeper.IsPathPaused(ctx, "ppica", "channel-0"))
+
+	_, err = msgServer.UnpauseTransferPath(ctx, types.NewMsgUnpausePath(authority, "ppica", "channel-0"))
+	require.NoError(t, err)
+	require.False(t, app.RatelimitKeeper.IsPathPaused(ctx, "ppica", "channel-0"))
+}
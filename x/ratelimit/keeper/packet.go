@@ -166,10 +166,18 @@ func (k Keeper) SendRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packe
 	if err != nil {
 		return err
 	}
-	// Store the sequence number of the packet so that if the transfer fails,
-	// we can identify if it was sent during this quota and can revert the outflow
+	// Store the packet along with the amount and the quota window it was sent in so that
+	// if the transfer fails, we can identify if it was sent during this quota and can revert the outflow
 	if updatedFlow {
-		k.SetPendingSendPacket(ctx, packetInfo.ChannelID, packet.Sequence)
+		rateLimit, _ := k.GetRateLimit(ctx, packetInfo.Denom, packetInfo.ChannelID)
+		k.SetPendingSendPacket(ctx, types.PendingSendPacket{
+			ChannelID: packetInfo.ChannelID,
+			Denom:     packetInfo.Denom,
+			Sequence:  packet.Sequence,
+			Sender:    packetInfo.Sender,
+			Amount:    packetInfo.Amount,
+			WindowID:  rateLimit.Flow.WindowID,
+		})
 	}
 
 	return nil
@@ -187,6 +195,24 @@ func (k Keeper) ReceiveRateLimitedPacket(ctx sdk.Context, packet channeltypes.Pa
 	return err
 }
 
+// Builds the error acknowledgement for a RecvPacket that was denied
+// If the packet was denied by the rate limiter, the ack carries the denial reason and,
+// when the quota was exceeded, the time after which the transfer can be retried
+func (k Keeper) NewDeniedRecvAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, err error) channeltypes.Acknowledgement {
+	retryAfter := int64(0)
+	if errorsmod.IsOf(err, types.ErrQuotaExceeded) || errorsmod.IsOf(err, types.ErrAddressQuotaExceeded) {
+		if packetInfo, parseErr := k.ParsePacketInfo(packet, types.PACKET_RECV); parseErr == nil {
+			if rateLimit, found := k.GetRateLimit(ctx, packetInfo.Denom, packetInfo.ChannelID); found {
+				if resetTime := k.GetQuotaResetTime(ctx, rateLimit); !resetTime.IsZero() {
+					retryAfter = resetTime.Unix()
+				}
+			}
+		}
+	}
+
+	return types.NewRateLimitedAcknowledgement(err, retryAfter)
+}
+
 // Middleware implementation for OnAckPacket with rate limiting
 // If the packet failed, we should decrement the Outflow
 func (k Keeper) AcknowledgeRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet, ack []byte) error {
@@ -209,11 +235,17 @@ func (k Keeper) AcknowledgeRateLimitedPacket(ctx sdk.Context, packet channeltype
 			return errorsmod.Wrapf(channeltypes.ErrInvalidAcknowledgement, "acknowledgement result cannot be empty")
 		}
 		// If the ack was successful, remove the pending packet
-		k.RemovePendingSendPacket(ctx, packetInfo.ChannelID, packet.Sequence)
+		k.RemovePendingSendPacket(ctx, packetInfo.ChannelID, packetInfo.Denom, packet.Sequence)
 		return nil
 	default:
+		// If the counterparty denied the packet because of its own rate limit, surface the reason
+		if errAck, ok := response.(*channeltypes.Acknowledgement_Error); ok {
+			if reason, retryAfter, ok := types.ParseRateLimitedAcknowledgement(errAck.Error); ok {
+				EmitTransferRateLimitedEvent(ctx, packet, packetInfo, reason, retryAfter)
+			}
+		}
 		// If the ack failed, undo the change to the rate limit Outflow
-		return k.UndoSendPacket(ctx, packetInfo.ChannelID, packet.Sequence, packetInfo.Denom, packetInfo.Amount)
+		return k.UndoSendPacket(ctx, packetInfo.ChannelID, packetInfo.Denom, packet.Sequence)
 	}
 }
 
@@ -225,7 +257,7 @@ func (k Keeper) TimeoutRateLimitedPacket(ctx sdk.Context, packet channeltypes.Pa
 		return err
 	}
 
-	return k.UndoSendPacket(ctx, packetInfo.ChannelID, packet.Sequence, packetInfo.Denom, packetInfo.Amount)
+	return k.UndoSendPacket(ctx, packetInfo.ChannelID, packetInfo.Denom, packet.Sequence)
 }
 
 // SendPacket wraps IBC ChannelKeeper's SendPacket function
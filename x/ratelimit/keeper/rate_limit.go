@@ -1,8 +1,7 @@
 package keeper
 
 import (
-	"encoding/binary"
-	"fmt"
+	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -10,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	"github.com/notional-labs/composable/v6/x/ratelimit/types"
 )
@@ -41,6 +41,24 @@ func EmitTransferDeniedEvent(ctx sdk.Context, reason, denom, channelID string, d
 	)
 }
 
+// If a sent packet was denied by the counterparty's rate limiter, we emit an event
+// so that the sender can be told why the transfer failed and when it can be retried
+func EmitTransferRateLimitedEvent(ctx sdk.Context, packet channeltypes.Packet, packetInfo RateLimitedPacketInfo, reason string, retryAfter int64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTransferRateLimited,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeySender, packetInfo.Sender),
+			sdk.NewAttribute(types.AttributeKeyDenom, packetInfo.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannel, packetInfo.ChannelID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, packetInfo.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRetryAfter, strconv.FormatInt(retryAfter, 10)),
+		),
+	)
+}
+
 // Adds an amount to the flow in either the SEND or RECV direction
 func (k Keeper) UpdateFlow(_ sdk.Context, rateLimit types.RateLimit, direction types.PacketDirection, amount math.Int) error {
 	switch direction {
@@ -64,6 +82,20 @@ func (k Keeper) CheckRateLimitAndUpdateFlow(
 	channelID := packetInfo.ChannelID
 	amount := packetInfo.Amount
 
+	// First check if the denom is blacklisted
+	if k.IsDenomBlacklisted(ctx, denom) {
+		err := errorsmod.Wrapf(types.ErrDenomIsBlacklisted, "denom %s is blacklisted", denom)
+		EmitTransferDeniedEvent(ctx, types.EventBlacklistedDenom, denom, channelID, direction, amount, err)
+		return false, err
+	}
+
+	// Then check if transfers on the path are paused
+	if k.IsPathPaused(ctx, denom, channelID) {
+		err := errorsmod.Wrapf(types.ErrPathIsPaused, "transfers of %s on %s are paused", denom, channelID)
+		EmitTransferDeniedEvent(ctx, types.EventPausedPath, denom, channelID, direction, amount, err)
+		return false, err
+	}
+
 	// If there's no rate limit yet for this denom, no action is necessary
 	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
 	if !found {
@@ -81,6 +113,16 @@ func (k Keeper) CheckRateLimitAndUpdateFlow(
 		EmitTransferDeniedEvent(ctx, types.EventRateLimitExceeded, denom, channelID, direction, amount, err)
 		return false, err
 	}
+	// The sender is limited on send and the receiver on receive
+	address := packetInfo.Sender
+	if direction == types.PACKET_RECV {
+		address = packetInfo.Receiver
+	}
+	if err := k.UpdateAddressFlow(ctx, rateLimit, direction, address, amount); err != nil {
+		// If the address quota was exceeded, emit an event
+		EmitTransferDeniedEvent(ctx, types.EventAddressRateLimitExceeded, denom, channelID, direction, amount, err)
+		return false, err
+	}
 	// If there's no quota error, update the rate limit object in the store with the new flow
 	k.SetRateLimit(ctx, rateLimit)
 
@@ -88,20 +130,81 @@ func (k Keeper) CheckRateLimitAndUpdateFlow(
 }
 
 // If a SendPacket fails or times out, undo the outflow increment that happened during the send
-func (k Keeper) UndoSendPacket(ctx sdk.Context, channelID string, sequence uint64, denom string, amount math.Int) error {
+// The amount and sender are read from the pending packet stored during the send
+func (k Keeper) UndoSendPacket(ctx sdk.Context, channelID, denom string, sequence uint64) error {
+	pendingPacket, found := k.GetPendingSendPacket(ctx, channelID, denom, sequence)
+	if !found {
+		return nil
+	}
+	k.RemovePendingSendPacket(ctx, channelID, denom, sequence)
+
 	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
 	if !found {
 		return nil
 	}
 
-	// If the packet was sent during this quota, decrement the outflow
-	// Otherwise, it can be ignored
-	if k.CheckPacketSentDuringCurrentQuota(ctx, channelID, sequence) {
-		rateLimit.Flow.Outflow = rateLimit.Flow.Outflow.Sub(amount)
-		k.SetRateLimit(ctx, rateLimit)
+	// If the packet was sent during this quota window, decrement the outflow
+	// Otherwise, the flow was already reset and it can be ignored
+	if pendingPacket.WindowID != rateLimit.Flow.WindowID {
+		return nil
+	}
 
-		k.RemovePendingSendPacket(ctx, channelID, sequence)
+	rateLimit.Flow.Outflow = rateLimit.Flow.Outflow.Sub(pendingPacket.Amount)
+	if rateLimit.Flow.Outflow.IsNegative() {
+		rateLimit.Flow.Outflow = math.ZeroInt()
 	}
+	k.SetRateLimit(ctx, rateLimit)
+	k.UndoAddressFlow(ctx, denom, channelID, types.PACKET_SEND, pendingPacket.Sender, pendingPacket.Amount)
+
+	return nil
+}
+
+// Returns the quota window of the rate limit of a received packet, or 0 if the packet is not rate limited
+// The window is stored with a packet whose receive can be reverted later, see UndoReceivePacket
+func (k Keeper) GetReceiveWindowID(ctx sdk.Context, packet channeltypes.Packet) uint64 {
+	packetInfo, err := k.ParsePacketInfo(packet, types.PACKET_RECV)
+	if err != nil {
+		return 0
+	}
+	rateLimit, found := k.GetRateLimit(ctx, packetInfo.Denom, packetInfo.ChannelID)
+	if !found {
+		return 0
+	}
+	return rateLimit.Flow.WindowID
+}
+
+// If the receive of a packet is reverted after the packet was received, e.g. its acknowledgement is written
+// later with an error, undo the inflow increment that happened during the receive
+// The inflow is only decremented if the packet was received during the quota window of windowID, and only
+// down to the inflow the sends of the window relied on, so the net outflow stays within the send quota
+func (k Keeper) UndoReceivePacket(ctx sdk.Context, packet channeltypes.Packet, windowID uint64) error {
+	packetInfo, err := k.ParsePacketInfo(packet, types.PACKET_RECV)
+	if err != nil {
+		return err
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, packetInfo.Denom, packetInfo.ChannelID)
+	if !found || rateLimit.Flow.WindowID != windowID {
+		return nil
+	}
+	// The flow of a whitelisted pair was not incremented
+	if k.IsAddressPairWhitelisted(ctx, packetInfo.Sender, packetInfo.Receiver) {
+		return nil
+	}
+
+	flow := rateLimit.Flow
+	undone := math.MinInt(packetInfo.Amount, flow.Inflow)
+	// The sends of the window may have used the inflow of the packet, the send quota isn't checked without
+	// a channel value
+	if !flow.ChannelValue.IsZero() {
+		sendThreshold := rateLimit.Quota.GetThreshold(types.PACKET_SEND, flow.ChannelValue, rateLimit.MinRateLimitAmount)
+		undone = math.MinInt(undone, flow.Inflow.Add(sendThreshold).Sub(flow.Outflow))
+	}
+	if undone.IsPositive() {
+		flow.Inflow = flow.Inflow.Sub(undone)
+		k.SetRateLimit(ctx, rateLimit)
+	}
+	k.UndoAddressFlow(ctx, packetInfo.Denom, packetInfo.ChannelID, types.PACKET_RECV, packetInfo.Receiver, packetInfo.Amount)
 
 	return nil
 }
@@ -110,11 +213,8 @@ func (k Keeper) UndoSendPacket(ctx sdk.Context, channelID string, sequence uint6
 // The inflow and outflow should get reset to 0, the channelValue should be updated,
 // and all pending send packet sequence numbers should be removed
 func (k Keeper) ResetRateLimit(ctx sdk.Context, denom, channelID string) error {
-	if k.tfmwKeeper.HasParachainIBCTokenInfoByNativeDenom(ctx, denom) {
-		tokenInfo := k.tfmwKeeper.GetParachainIBCTokenInfoByNativeDenom(ctx, denom)
-		if channelID == tokenInfo.ChannelID {
-			denom = tokenInfo.IbcDenom
-		}
+	if tokenInfo, found := k.tfmwKeeper.GetParachainIBCTokenInfo(ctx, denom, channelID); found {
+		denom = tokenInfo.IbcDenom
 	}
 
 	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
@@ -126,11 +226,13 @@ func (k Keeper) ResetRateLimit(ctx sdk.Context, denom, channelID string) error {
 		Inflow:       math.ZeroInt(),
 		Outflow:      math.ZeroInt(),
 		ChannelValue: k.GetChannelValue(ctx, denom),
+		WindowID:     rateLimit.Flow.WindowID + 1,
 	}
 	rateLimit.Flow = &flow
 
 	k.SetRateLimit(ctx, rateLimit)
-	k.RemoveAllChannelPendingSendPackets(ctx, channelID)
+	k.RemoveAllAddressFlows(ctx, denom, channelID)
+	k.RemoveAllPendingSendPackets(ctx, channelID, denom)
 	return nil
 }
 
@@ -146,11 +248,8 @@ func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
 
 // Removes a rate limit object from the store using denom and channel-id
 func (k Keeper) RemoveRateLimit(ctx sdk.Context, denom, channelID string) error {
-	if k.tfmwKeeper.HasParachainIBCTokenInfoByNativeDenom(ctx, denom) {
-		tokenInfo := k.tfmwKeeper.GetParachainIBCTokenInfoByNativeDenom(ctx, denom)
-		if channelID == tokenInfo.ChannelID {
-			denom = tokenInfo.IbcDenom
-		}
+	if tokenInfo, found := k.tfmwKeeper.GetParachainIBCTokenInfo(ctx, denom, channelID); found {
+		denom = tokenInfo.IbcDenom
 	}
 
 	_, found := k.GetRateLimit(ctx, denom, channelID)
@@ -161,6 +260,8 @@ func (k Keeper) RemoveRateLimit(ctx sdk.Context, denom, channelID string) error
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKeyPrefix)
 	rateLimitKey := GetRateLimitItemKey(denom, channelID)
 	store.Delete(rateLimitKey)
+	k.RemoveAllAddressFlows(ctx, denom, channelID)
+	k.RemoveAllPendingSendPackets(ctx, channelID, denom)
 
 	return nil
 }
@@ -184,12 +285,14 @@ func (k Keeper) GetRateLimit(ctx sdk.Context, denom, channelID string) (rateLimi
 func (k Keeper) AddRateLimit(ctx sdk.Context, msg *types.MsgAddRateLimit) error {
 	// Check if this is denom - channel transfer from Picasso
 	denom := msg.Denom
-	if k.tfmwKeeper.HasParachainIBCTokenInfoByNativeDenom(ctx, denom) {
-		tokenInfo := k.tfmwKeeper.GetParachainIBCTokenInfoByNativeDenom(ctx, denom)
-		if msg.ChannelID == tokenInfo.ChannelID {
-			denom = tokenInfo.IbcDenom
-		}
+	if tokenInfo, found := k.tfmwKeeper.GetParachainIBCTokenInfo(ctx, denom, msg.ChannelID); found {
+		denom = tokenInfo.IbcDenom
+	}
+	// Confirm the epoch driving the quota window exists
+	if err := k.validateQuotaEpoch(ctx, msg.EpochIdentifier); err != nil {
+		return err
 	}
+
 	// Confirm the channel value is not zero
 	channelValue := k.GetChannelValue(ctx, denom)
 	if channelValue.IsZero() {
@@ -208,9 +311,12 @@ func (k Keeper) AddRateLimit(ctx sdk.Context, msg *types.MsgAddRateLimit) error
 		ChannelID: msg.ChannelID,
 	}
 	quota := types.Quota{
-		MaxPercentSend: msg.MaxPercentSend,
-		MaxPercentRecv: msg.MaxPercentRecv,
-		DurationHours:  msg.DurationHours,
+		MaxPercentSend:       msg.MaxPercentSend,
+		MaxPercentRecv:       msg.MaxPercentRecv,
+		DurationHours:        msg.DurationHours,
+		EpochIdentifier:      msg.EpochIdentifier,
+		MaxPercentPerAddress: msg.MaxPercentPerAddress,
+		MaxAmountPerAddress:  msg.MaxAmountPerAddress,
 	}
 	flow := types.Flow{
 		Inflow:       math.ZeroInt(),
@@ -232,19 +338,21 @@ func (k Keeper) AddRateLimit(ctx sdk.Context, msg *types.MsgAddRateLimit) error
 func (k Keeper) UpdateRateLimit(ctx sdk.Context, msg *types.MsgUpdateRateLimit) error {
 	// Check if this is denom - channel transfer from Picasso
 	denom := msg.Denom
-	if k.tfmwKeeper.HasParachainIBCTokenInfoByNativeDenom(ctx, denom) {
-		tokenInfo := k.tfmwKeeper.GetParachainIBCTokenInfoByNativeDenom(ctx, denom)
-		if msg.ChannelID == tokenInfo.ChannelID {
-			denom = tokenInfo.IbcDenom
-		}
+	if tokenInfo, found := k.tfmwKeeper.GetParachainIBCTokenInfo(ctx, denom, msg.ChannelID); found {
+		denom = tokenInfo.IbcDenom
 	}
 
 	// Confirm the rate limit exists
-	_, found := k.GetRateLimit(ctx, denom, msg.ChannelID)
+	rateLimit, found := k.GetRateLimit(ctx, denom, msg.ChannelID)
 	if !found {
 		return errorsmod.Wrap(types.ErrRateLimitNotFound, "rate limit not found")
 	}
 
+	// Confirm the epoch driving the quota window exists
+	if err := k.validateQuotaEpoch(ctx, msg.EpochIdentifier); err != nil {
+		return err
+	}
+
 	// Update the rate limit object with the new quota information
 	// The flow should also get reset to 0
 	path := types.Path{
@@ -252,14 +360,18 @@ func (k Keeper) UpdateRateLimit(ctx sdk.Context, msg *types.MsgUpdateRateLimit)
 		ChannelID: msg.ChannelID,
 	}
 	quota := types.Quota{
-		MaxPercentSend: msg.MaxPercentSend,
-		MaxPercentRecv: msg.MaxPercentRecv,
-		DurationHours:  msg.DurationHours,
+		MaxPercentSend:       msg.MaxPercentSend,
+		MaxPercentRecv:       msg.MaxPercentRecv,
+		DurationHours:        msg.DurationHours,
+		EpochIdentifier:      msg.EpochIdentifier,
+		MaxPercentPerAddress: msg.MaxPercentPerAddress,
+		MaxAmountPerAddress:  msg.MaxAmountPerAddress,
 	}
 	flow := types.Flow{
 		Inflow:       math.ZeroInt(),
 		Outflow:      math.ZeroInt(),
 		ChannelValue: k.GetChannelValue(ctx, denom),
+		WindowID:     rateLimit.Flow.WindowID + 1,
 	}
 
 	k.SetRateLimit(ctx, types.RateLimit{
@@ -268,7 +380,18 @@ func (k Keeper) UpdateRateLimit(ctx sdk.Context, msg *types.MsgUpdateRateLimit)
 		Flow:               &flow,
 		MinRateLimitAmount: msg.MinRateLimitAmount,
 	})
+	k.RemoveAllAddressFlows(ctx, denom, msg.ChannelID)
+	k.RemoveAllPendingSendPackets(ctx, msg.ChannelID, denom)
+
+	return nil
+}
 
+// Checks that the epoch referenced by a quota exists, an empty identifier refers to the hour epoch
+func (k Keeper) validateQuotaEpoch(ctx sdk.Context, epochIdentifier string) error {
+	quota := types.Quota{EpochIdentifier: epochIdentifier}
+	if !k.HasEpochInfo(ctx, quota.EpochIdentifierOrDefault()) {
+		return errorsmod.Wrapf(types.ErrEpochNotFound, "epoch with identifier %s not found", quota.EpochIdentifierOrDefault())
+	}
 	return nil
 }
 
@@ -290,59 +413,76 @@ func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
 	return allRateLimits
 }
 
-// Sets the sequence number of a packet that was just sent
-func (k Keeper) SetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+// Stores a packet that was just sent on a rate limited path, along with the amount
+// that was added to the outflow so that it can be reverted if the transfer fails
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, pendingPacket types.PendingSendPacket) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
-	key := types.GetPendingSendPacketKey(channelID, sequence)
-	store.Set(key, []byte{1})
+	key := types.GetPendingSendPacketKey(pendingPacket.ChannelID, pendingPacket.Denom, pendingPacket.Sequence)
+	store.Set(key, k.cdc.MustMarshal(&pendingPacket))
+}
+
+// Grabs a pending packet from the store using the channel-id, denom and sequence number
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID, denom string, sequence uint64) (pendingPacket types.PendingSendPacket, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
+	key := types.GetPendingSendPacketKey(channelID, denom, sequence)
+
+	valueBz := store.Get(key)
+	if len(valueBz) == 0 {
+		return pendingPacket, false
+	}
+
+	k.cdc.MustUnmarshal(valueBz, &pendingPacket)
+	return pendingPacket, true
 }
 
-// Remove a pending packet sequence number from the store
+// Remove a pending packet from the store
 // Used after the ack or timeout for a packet has been received
-func (k Keeper) RemovePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+func (k Keeper) RemovePendingSendPacket(ctx sdk.Context, channelID, denom string, sequence uint64) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
-	key := types.GetPendingSendPacketKey(channelID, sequence)
+	key := types.GetPendingSendPacketKey(channelID, denom, sequence)
 	store.Delete(key)
 }
 
-// Checks whether the packet sequence number is in the store - indicating that it was
-// sent during the current quota
-func (k Keeper) CheckPacketSentDuringCurrentQuota(ctx sdk.Context, channelID string, sequence uint64) bool {
+// Get all pending packets of a path
+func (k Keeper) GetPendingSendPacketsByPath(ctx sdk.Context, channelID, denom string) []types.PendingSendPacket {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
-	key := types.GetPendingSendPacketKey(channelID, sequence)
-	valueBz := store.Get(key)
-	found := len(valueBz) != 0
-	return found
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPendingSendPacketPathPrefix(channelID, denom))
+	defer iterator.Close()
+
+	pendingPackets := []types.PendingSendPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		pendingPacket := types.PendingSendPacket{}
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingPacket)
+		pendingPackets = append(pendingPackets, pendingPacket)
+	}
+
+	return pendingPackets
 }
 
-// Get all pending packet sequence numbers
-func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []string {
+// Get all pending packets
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
 
 	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
 
-	pendingPackets := []string{}
+	pendingPackets := []types.PendingSendPacket{}
 	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
-
-		channelID := string(key[:types.PendingSendPacketChannelLength])
-		channelID = strings.TrimRight(channelID, "\x00") // removes null bytes from suffix
-		sequence := binary.BigEndian.Uint64(key[types.PendingSendPacketChannelLength:])
-
-		packetID := fmt.Sprintf("%s/%d", channelID, sequence)
-		pendingPackets = append(pendingPackets, packetID)
+		pendingPacket := types.PendingSendPacket{}
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingPacket)
+		pendingPackets = append(pendingPackets, pendingPacket)
 	}
 
 	return pendingPackets
 }
 
-// Remove all pending sequence numbers from the store
+// Remove all pending packets of a path from the store
 // This is executed when the quota resets
-func (k Keeper) RemoveAllChannelPendingSendPackets(ctx sdk.Context, channelID string) {
+func (k Keeper) RemoveAllPendingSendPackets(ctx sdk.Context, channelID, denom string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
 
-	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefix(channelID))
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPendingSendPacketPathPrefix(channelID, denom))
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -393,3 +533,76 @@ func (k Keeper) GetAllWhitelistedAddressPairs(ctx sdk.Context) []types.Whitelist
 
 	return allWhitelistedAddresses
 }
+
+// Adds a denom to the blacklist to prevent all IBC transfers with this denom
+func (k Keeper) AddDenomToBlacklist(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomBlacklistKeyPrefix)
+	key := types.KeyPrefix(denom)
+	store.Set(key, []byte{1})
+}
+
+// Removes a denom from the blacklist to re-enable IBC transfers for that denom
+func (k Keeper) RemoveDenomFromBlacklist(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomBlacklistKeyPrefix)
+	key := types.KeyPrefix(denom)
+	store.Delete(key)
+}
+
+// Check if a denom is currently blacklisted
+func (k Keeper) IsDenomBlacklisted(ctx sdk.Context, denom string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomBlacklistKeyPrefix)
+	return store.Has(types.KeyPrefix(denom))
+}
+
+// Get all the blacklisted denoms
+func (k Keeper) GetAllBlacklistedDenoms(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomBlacklistKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allBlacklistedDenoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		allBlacklistedDenoms = append(allBlacklistedDenoms, string(iterator.Key()))
+	}
+
+	return allBlacklistedDenoms
+}
+
+// Pauses all IBC transfers of a denom on a channel, regardless of the quota
+func (k Keeper) PausePath(ctx sdk.Context, denom, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedPathKeyPrefix)
+	key := GetRateLimitItemKey(denom, channelID)
+	path := types.Path{Denom: denom, ChannelID: channelID}
+	store.Set(key, k.cdc.MustMarshal(&path))
+}
+
+// Resumes IBC transfers of a denom on a channel
+func (k Keeper) UnpausePath(ctx sdk.Context, denom, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedPathKeyPrefix)
+	key := GetRateLimitItemKey(denom, channelID)
+	store.Delete(key)
+}
+
+// Check if IBC transfers of a denom on a channel are currently paused
+func (k Keeper) IsPathPaused(ctx sdk.Context, denom, channelID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedPathKeyPrefix)
+	return store.Has(GetRateLimitItemKey(denom, channelID))
+}
+
+// Get all the paused paths
+func (k Keeper) GetAllPausedPaths(ctx sdk.Context) []types.Path {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedPathKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allPausedPaths := []types.Path{}
+	for ; iterator.Valid(); iterator.Next() {
+		path := types.Path{}
+		k.cdc.MustUnmarshal(iterator.Value(), &path)
+		allPausedPaths = append(allPausedPaths, path)
+	}
+
+	return allPausedPaths
+}
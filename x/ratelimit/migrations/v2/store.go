@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/composable/v6/x/ratelimit/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2:
+//
+//   - Pending send packets were keyed by a padded channelID and the sequence number only
+//     and didn't record the denom and amount of the transfer. They are now keyed by
+//     (channelID, denom, sequence) and store the amount and the quota window they were
+//     sent in. Legacy entries can't be converted: the chain only keeps the commitment hash
+//     of a sent packet, so the denom and amount of a legacy entry can't be read back, and
+//     a channel can carry several rate limited denoms. They are deleted instead.
+//
+//     Dropping them is safe: the module holds no funds, the refund of a failed packet is
+//     done by the transfer module. The only effect is that the outflow of a packet in flight
+//     during the upgrade that fails or times out is not reverted, so its path quota stays
+//     stricter than needed until the window resets, as for a packet that fails after a reset.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migration of ratelimit pending send packets begin")
+
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.PendingSendPacketPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	ctx.Logger().Info(
+		"Migration of ratelimit pending send packets done",
+		"totalDeleted", len(keys),
+	)
+	return nil
+}
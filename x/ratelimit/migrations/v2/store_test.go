@@ -0,0 +1,58 @@
+package v2_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	v2 "github.com/notional-labs/composable/v6/x/ratelimit/migrations/v2"
+	"github.com/notional-labs/composable/v6/x/ratelimit/types"
+)
+
+// legacyPendingSendPacketKey is the v1 key of a pending send packet, a channelID padded to 16 bytes and the sequence
+func legacyPendingSendPacketKey(channelID string, sequence uint64) []byte {
+	key := make([]byte, 16+8)
+	copy(key, channelID)
+	binary.BigEndian.PutUint64(key[16:], sequence)
+	return key
+}
+
+func TestMigrateStore(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	storeKey := app.GetKey(types.StoreKey)
+	k := app.RatelimitKeeper
+
+	rateLimit := types.RateLimit{
+		Path:  &types.Path{Denom: "ppica", ChannelID: "channel-0"},
+		Quota: &types.Quota{MaxPercentSend: math.NewInt(10), MaxPercentRecv: math.NewInt(10), DurationHours: 1},
+		Flow:  &types.Flow{Inflow: math.ZeroInt(), Outflow: math.NewInt(100), ChannelValue: math.NewInt(1_000)},
+	}
+	k.SetRateLimit(ctx, rateLimit)
+
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.PendingSendPacketPrefix)
+	store.Set(legacyPendingSendPacketKey("channel-0", 1), []byte{1})
+	store.Set(legacyPendingSendPacketKey("channel-0", 2), []byte{1})
+	store.Set(legacyPendingSendPacketKey("channel-12", 1), []byte{1})
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey))
+
+	// the legacy packets are dropped and the new packets can be stored
+	iterator := store.Iterator(nil, nil)
+	require.False(t, iterator.Valid())
+	iterator.Close()
+	require.Empty(t, k.GetAllPendingSendPackets(ctx))
+
+	pendingPacket := types.PendingSendPacket{ChannelID: "channel-0", Denom: "ppica", Sequence: 3, Amount: math.NewInt(10)}
+	k.SetPendingSendPacket(ctx, pendingPacket)
+	require.Equal(t, []types.PendingSendPacket{pendingPacket}, k.GetAllPendingSendPackets(ctx))
+
+	// the flows are kept, so the outflow of the dropped packets is not reverted
+	migrated, found := k.GetRateLimit(ctx, "ppica", "channel-0")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(100), migrated.Flow.Outflow)
+}
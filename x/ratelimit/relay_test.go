@@ -2,13 +2,16 @@ package ratelimit_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/stretchr/testify/suite"
 
 	customibctesting "github.com/notional-labs/composable/v6/app/ibctesting"
+	ratelimitkeeper "github.com/notional-labs/composable/v6/x/ratelimit/keeper"
 	ratelimittypes "github.com/notional-labs/composable/v6/x/ratelimit/types"
 )
 
@@ -508,3 +511,260 @@ func (suite *RateLimitTestSuite) TestSendIBCTokenWithMinRateLimitAmount() {
 	balances := suite.chainB.AllBalances(suite.chainB.SenderAccount.GetAddress())
 	suite.Require().Equal(expBalance, balances)
 }
+
+func (suite *RateLimitTestSuite) TestReceiveBlacklistedDenomReturnsRateLimitedAck() {
+	var (
+		transferAmount          = sdk.NewInt(1_000_000_000)
+		nativeTokenSendOnChainA = sdk.NewCoin(sdk.DefaultBondDenom, transferAmount)
+		timeoutHeight           = clienttypes.NewHeight(1, 110)
+	)
+
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// blacklist the voucher denom of chain A's native token on chain B
+	prefixedDenom := transfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)
+	voucherDenom := transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+	suite.chainB.RateLimit().AddDenomToBlacklist(suite.chainB.GetContext(), voucherDenom)
+
+	originalChainABalance := suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress())
+
+	msg := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nativeTokenSendOnChainA, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	_, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, len(suite.chainA.PendingSendPackets))
+
+	// relay the packet to chain B
+	packet := suite.chainA.PendingSendPackets[0]
+	suite.coordinator.IncrementTime()
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+	suite.chainA.PendingSendPackets = nil
+
+	// then the ack carries the denial reason
+	suite.Require().Equal(1, len(suite.chainB.PendingAckPackets))
+	var ack channeltypes.Acknowledgement
+	err = transfertypes.ModuleCdc.UnmarshalJSON(suite.chainB.PendingAckPackets[0].Ack, &ack)
+	suite.Require().NoError(err)
+	suite.Require().False(ack.Success())
+
+	reason, retryAfter, rateLimited := ratelimittypes.ParseRateLimitedAcknowledgement(ack.GetError())
+	suite.Require().True(rateLimited)
+	suite.Require().Equal(ratelimittypes.DenialReasonDenomBlacklisted, reason)
+	suite.Require().Equal(int64(0), retryAfter)
+
+	// and when the ack is relayed back, chain A refunds the sender
+	suite.coordinator.IncrementTime()
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, suite.chainB.PendingAckPackets[0].Ack))
+	suite.chainB.PendingAckPackets = nil
+
+	suite.Require().Equal(originalChainABalance, suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress()))
+}
+
+func (suite *RateLimitTestSuite) TestQuotaResetByCustomEpoch() {
+	epochIdentifier := "10min"
+
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	chainBRateLimitKeeper := suite.chainB.RateLimit()
+	err := chainBRateLimitKeeper.AddEpochInfo(suite.chainB.GetContext(), ratelimittypes.NewGenesisEpochInfo(epochIdentifier, 10*time.Minute))
+	suite.Require().NoError(err)
+
+	// rate limits can't reference an unknown epoch
+	msgAddRateLimit := ratelimittypes.MsgAddRateLimit{
+		Denom:              sdk.DefaultBondDenom,
+		ChannelID:          path.EndpointB.ChannelID,
+		MaxPercentSend:     sdk.NewInt(5),
+		MaxPercentRecv:     sdk.NewInt(5),
+		MinRateLimitAmount: sdk.NewInt(10_000_000_000),
+		DurationHours:      1,
+		EpochIdentifier:    "unknown",
+	}
+	err = chainBRateLimitKeeper.AddRateLimit(suite.chainB.GetContext(), &msgAddRateLimit)
+	suite.Require().ErrorIs(err, ratelimittypes.ErrEpochNotFound)
+
+	msgAddRateLimit.EpochIdentifier = epochIdentifier
+	err = chainBRateLimitKeeper.AddRateLimit(suite.chainB.GetContext(), &msgAddRateLimit)
+	suite.Require().NoError(err)
+
+	// the epoch can't be removed while it drives a rate limit
+	err = chainBRateLimitKeeper.RemoveEpochInfo(suite.chainB.GetContext(), epochIdentifier)
+	suite.Require().ErrorIs(err, ratelimittypes.ErrEpochInUse)
+
+	// record some outflow in the current window
+	rateLimit, found := chainBRateLimitKeeper.GetRateLimit(suite.chainB.GetContext(), sdk.DefaultBondDenom, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	rateLimit.Flow.Outflow = sdk.NewInt(1_000)
+	chainBRateLimitKeeper.SetRateLimit(suite.chainB.GetContext(), rateLimit)
+
+	// start counting epochs
+	suite.coordinator.CommitBlock(suite.chainB)
+	rateLimit, _ = chainBRateLimitKeeper.GetRateLimit(suite.chainB.GetContext(), sdk.DefaultBondDenom, path.EndpointB.ChannelID)
+	suite.Require().Equal(sdk.NewInt(1_000), rateLimit.Flow.Outflow)
+
+	// and when the first 10 minute epoch ends, the flow is reset
+	suite.coordinator.IncrementTimeBy(10 * time.Minute)
+	suite.coordinator.CommitBlock(suite.chainB)
+	rateLimit, _ = chainBRateLimitKeeper.GetRateLimit(suite.chainB.GetContext(), sdk.DefaultBondDenom, path.EndpointB.ChannelID)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+
+	epoch := chainBRateLimitKeeper.GetEpochInfo(suite.chainB.GetContext(), epochIdentifier)
+	suite.Require().Equal(int64(2), epoch.CurrentEpoch)
+}
+
+func (suite *RateLimitTestSuite) TestPerAddressQuota() {
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	chainBRateLimitKeeper := suite.chainB.RateLimit()
+	msgAddRateLimit := ratelimittypes.MsgAddRateLimit{
+		Denom:               sdk.DefaultBondDenom,
+		ChannelID:           path.EndpointB.ChannelID,
+		MaxPercentSend:      sdk.NewInt(10),
+		MaxPercentRecv:      sdk.NewInt(10),
+		MinRateLimitAmount:  sdk.NewInt(1),
+		DurationHours:       1,
+		MaxAmountPerAddress: sdk.NewInt(100),
+	}
+	err := chainBRateLimitKeeper.AddRateLimit(suite.chainB.GetContext(), &msgAddRateLimit)
+	suite.Require().NoError(err)
+
+	packetInfo := func(sender, receiver string) ratelimitkeeper.RateLimitedPacketInfo {
+		return ratelimitkeeper.RateLimitedPacketInfo{
+			ChannelID: path.EndpointB.ChannelID,
+			Denom:     sdk.DefaultBondDenom,
+			Amount:    sdk.NewInt(60),
+			Sender:    sender,
+			Receiver:  receiver,
+		}
+	}
+
+	// the first transfer of a sender fits its quota
+	updated, err := chainBRateLimitKeeper.CheckRateLimitAndUpdateFlow(suite.chainB.GetContext(), ratelimittypes.PACKET_SEND, packetInfo("alice", "bob"))
+	suite.Require().NoError(err)
+	suite.Require().True(updated)
+
+	// the second one exceeds it, while the path quota is not exceeded
+	_, err = chainBRateLimitKeeper.CheckRateLimitAndUpdateFlow(suite.chainB.GetContext(), ratelimittypes.PACKET_SEND, packetInfo("alice", "bob"))
+	suite.Require().ErrorIs(err, ratelimittypes.ErrAddressQuotaExceeded)
+
+	// other senders are not affected
+	_, err = chainBRateLimitKeeper.CheckRateLimitAndUpdateFlow(suite.chainB.GetContext(), ratelimittypes.PACKET_SEND, packetInfo("carol", "bob"))
+	suite.Require().NoError(err)
+
+	// the receiver is only limited on receive
+	_, err = chainBRateLimitKeeper.CheckRateLimitAndUpdateFlow(suite.chainB.GetContext(), ratelimittypes.PACKET_RECV, packetInfo("bob", "alice"))
+	suite.Require().NoError(err)
+
+	// whitelisted address pairs bypass the address quota
+	chainBRateLimitKeeper.SetWhitelistedAddressPair(suite.chainB.GetContext(), ratelimittypes.WhitelistedAddressPair{Sender: "alice", Receiver: "bob"})
+	_, err = chainBRateLimitKeeper.CheckRateLimitAndUpdateFlow(suite.chainB.GetContext(), ratelimittypes.PACKET_SEND, packetInfo("alice", "bob"))
+	suite.Require().NoError(err)
+	chainBRateLimitKeeper.RemoveWhitelistedAddressPair(suite.chainB.GetContext(), "alice", "bob")
+
+	// and when the rate limit is reset, so are the address flows
+	err = chainBRateLimitKeeper.ResetRateLimit(suite.chainB.GetContext(), sdk.DefaultBondDenom, path.EndpointB.ChannelID)
+	suite.Require().NoError(err)
+	suite.Require().Empty(chainBRateLimitKeeper.GetAllAddressFlows(suite.chainB.GetContext()))
+
+	_, err = chainBRateLimitKeeper.CheckRateLimitAndUpdateFlow(suite.chainB.GetContext(), ratelimittypes.PACKET_SEND, packetInfo("alice", "bob"))
+	suite.Require().NoError(err)
+}
+
+func (suite *RateLimitTestSuite) TestUndoPendingSendPacket() {
+	var (
+		transferAmount          = sdk.NewInt(1_000_000_000)
+		nativeTokenSendOnChainA = sdk.NewCoin(sdk.DefaultBondDenom, transferAmount)
+		timeoutHeight           = clienttypes.NewHeight(1, 110)
+	)
+
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	chainARateLimitKeeper := suite.chainA.RateLimit()
+	channelID := path.EndpointA.ChannelID
+	msgAddRateLimit := ratelimittypes.MsgAddRateLimit{
+		Denom:              sdk.DefaultBondDenom,
+		ChannelID:          channelID,
+		MaxPercentSend:     sdk.NewInt(5),
+		MaxPercentRecv:     sdk.NewInt(5),
+		MinRateLimitAmount: sdk.NewInt(10_000_000_000),
+		DurationHours:      1,
+	}
+	err := chainARateLimitKeeper.AddRateLimit(suite.chainA.GetContext(), &msgAddRateLimit)
+	suite.Require().NoError(err)
+
+	// send two packets on the rate limited path
+	sender := suite.chainA.SenderAccount.GetAddress().String()
+	for i := 0; i < 2; i++ {
+		msg := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, channelID, nativeTokenSendOnChainA, sender, suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+		_, err = suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+	}
+	suite.Require().Equal(2, len(suite.chainA.PendingSendPackets))
+
+	// then both packets are pending with the amount and window they were sent in
+	res, err := chainARateLimitKeeper.PendingSendPackets(suite.chainA.GetContext(), &ratelimittypes.QueryPendingSendPacketsRequest{
+		Denom:     sdk.DefaultBondDenom,
+		ChannelID: channelID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, len(res.PendingSendPackets))
+	for _, pendingPacket := range res.PendingSendPackets {
+		suite.Require().Equal(sender, pendingPacket.Sender)
+		suite.Require().Equal(transferAmount, pendingPacket.Amount)
+		suite.Require().Equal(uint64(0), pendingPacket.WindowID)
+	}
+	rateLimit, _ := chainARateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, channelID)
+	suite.Require().Equal(transferAmount.MulRaw(2), rateLimit.Flow.Outflow)
+
+	// when the first packet fails, only its amount is reverted
+	firstSequence := suite.chainA.PendingSendPackets[0].Sequence
+	err = chainARateLimitKeeper.UndoSendPacket(suite.chainA.GetContext(), channelID, sdk.DefaultBondDenom, firstSequence)
+	suite.Require().NoError(err)
+	rateLimit, _ = chainARateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, channelID)
+	suite.Require().Equal(transferAmount, rateLimit.Flow.Outflow)
+	_, found := chainARateLimitKeeper.GetPendingSendPacket(suite.chainA.GetContext(), channelID, sdk.DefaultBondDenom, firstSequence)
+	suite.Require().False(found)
+
+	// and failing it twice doesn't revert the amount again
+	err = chainARateLimitKeeper.UndoSendPacket(suite.chainA.GetContext(), channelID, sdk.DefaultBondDenom, firstSequence)
+	suite.Require().NoError(err)
+	rateLimit, _ = chainARateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, channelID)
+	suite.Require().Equal(transferAmount, rateLimit.Flow.Outflow)
+
+	// when the quota resets, a new window starts and the pending packets of the path are dropped
+	err = chainARateLimitKeeper.ResetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, channelID)
+	suite.Require().NoError(err)
+	rateLimit, _ = chainARateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, channelID)
+	suite.Require().Equal(uint64(1), rateLimit.Flow.WindowID)
+	suite.Require().Empty(chainARateLimitKeeper.GetPendingSendPacketsByPath(suite.chainA.GetContext(), channelID, sdk.DefaultBondDenom))
+
+	// and a packet sent in a previous window doesn't decrement the new outflow
+	rateLimit.Flow.Outflow = transferAmount
+	chainARateLimitKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+	chainARateLimitKeeper.SetPendingSendPacket(suite.chainA.GetContext(), ratelimittypes.PendingSendPacket{
+		ChannelID: channelID,
+		Denom:     sdk.DefaultBondDenom,
+		Sequence:  suite.chainA.PendingSendPackets[1].Sequence,
+		Sender:    sender,
+		Amount:    transferAmount,
+		WindowID:  0,
+	})
+	err = chainARateLimitKeeper.UndoSendPacket(suite.chainA.GetContext(), channelID, sdk.DefaultBondDenom, suite.chainA.PendingSendPackets[1].Sequence)
+	suite.Require().NoError(err)
+	rateLimit, _ = chainARateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, channelID)
+	suite.Require().Equal(transferAmount, rateLimit.Flow.Outflow)
+}
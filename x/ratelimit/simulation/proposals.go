@@ -0,0 +1,130 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/notional-labs/composable/v6/x/ratelimit/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgAddRateLimit    int = 100
+	DefaultWeightMsgUpdateRateLimit int = 50
+	DefaultWeightMsgResetRateLimit  int = 50
+	DefaultWeightMsgRemoveRateLimit int = 25
+
+	OpWeightMsgAddRateLimit    = "op_weight_msg_add_rate_limit"    //nolint:gosec
+	OpWeightMsgUpdateRateLimit = "op_weight_msg_update_rate_limit" //nolint:gosec
+	OpWeightMsgResetRateLimit  = "op_weight_msg_reset_rate_limit"  //nolint:gosec
+	OpWeightMsgRemoveRateLimit = "op_weight_msg_remove_rate_limit" //nolint:gosec
+
+	// number of transfer channels the rate limits are spread over
+	simChannelCount = 2
+)
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs() []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgAddRateLimit,
+			DefaultWeightMsgAddRateLimit,
+			SimulateMsgAddRateLimit,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateRateLimit,
+			DefaultWeightMsgUpdateRateLimit,
+			SimulateMsgUpdateRateLimit,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgResetRateLimit,
+			DefaultWeightMsgResetRateLimit,
+			SimulateMsgResetRateLimit,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgRemoveRateLimit,
+			DefaultWeightMsgRemoveRateLimit,
+			SimulateMsgRemoveRateLimit,
+		),
+	}
+}
+
+// SimulateMsgAddRateLimit returns a random MsgAddRateLimit on the bond denom
+func SimulateMsgAddRateLimit(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	quota := randomQuota(r)
+	return &types.MsgAddRateLimit{
+		Authority:            authority(),
+		Denom:                sdk.DefaultBondDenom,
+		ChannelID:            randomChannelID(r),
+		MaxPercentSend:       quota.MaxPercentSend,
+		MaxPercentRecv:       quota.MaxPercentRecv,
+		MinRateLimitAmount:   randomMinRateLimitAmount(r),
+		DurationHours:        quota.DurationHours,
+		MaxPercentPerAddress: quota.MaxPercentPerAddress,
+		MaxAmountPerAddress:  quota.MaxAmountPerAddress,
+	}
+}
+
+// SimulateMsgUpdateRateLimit returns a random MsgUpdateRateLimit on the bond denom
+func SimulateMsgUpdateRateLimit(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	quota := randomQuota(r)
+	return &types.MsgUpdateRateLimit{
+		Authority:            authority(),
+		Denom:                sdk.DefaultBondDenom,
+		ChannelID:            randomChannelID(r),
+		MaxPercentSend:       quota.MaxPercentSend,
+		MaxPercentRecv:       quota.MaxPercentRecv,
+		MinRateLimitAmount:   randomMinRateLimitAmount(r),
+		DurationHours:        quota.DurationHours,
+		MaxPercentPerAddress: quota.MaxPercentPerAddress,
+		MaxAmountPerAddress:  quota.MaxAmountPerAddress,
+	}
+}
+
+// SimulateMsgResetRateLimit returns a random MsgResetRateLimit on the bond denom
+func SimulateMsgResetRateLimit(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	return types.NewMsgResetRateLimit(authority(), sdk.DefaultBondDenom, randomChannelID(r))
+}
+
+// SimulateMsgRemoveRateLimit returns a random MsgRemoveRateLimit on the bond denom
+func SimulateMsgRemoveRateLimit(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	return types.NewMsgRemoveRateLimit(authority(), sdk.DefaultBondDenom, randomChannelID(r))
+}
+
+// use the default gov module account address as authority
+func authority() string {
+	var authority sdk.AccAddress = address.Module("gov")
+	return authority.String()
+}
+
+func randomChannelID(r *rand.Rand) string {
+	return fmt.Sprintf("channel-%d", r.Intn(simChannelCount))
+}
+
+func randomMinRateLimitAmount(r *rand.Rand) math.Int {
+	return math.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_000_000_000_000)))
+}
+
+// randomQuota returns a quota with at least one direction limited
+// and, half of the time, a per-address limit
+func randomQuota(r *rand.Rand) types.Quota {
+	quota := types.Quota{
+		MaxPercentSend:       math.NewInt(int64(simtypes.RandIntBetween(r, 0, 101))),
+		MaxPercentRecv:       math.NewInt(int64(simtypes.RandIntBetween(r, 1, 101))),
+		DurationHours:        uint64(simtypes.RandIntBetween(r, 1, 25)),
+		MaxPercentPerAddress: math.ZeroInt(),
+		MaxAmountPerAddress:  math.ZeroInt(),
+	}
+
+	if r.Intn(2) == 0 {
+		quota.MaxPercentPerAddress = math.NewInt(int64(simtypes.RandIntBetween(r, 1, 101)))
+	}
+
+	return quota
+}
@@ -0,0 +1,62 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/notional-labs/composable/v6/x/ratelimit/simulation"
+	"github.com/notional-labs/composable/v6/x/ratelimit/types"
+)
+
+func TestProposalMsgs(t *testing.T) {
+	// initialize parameters
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, true, nil)
+	accounts := simtypes.RandomAccounts(r, 3)
+	authority := sdk.AccAddress(address.Module("gov")).String()
+
+	// execute ProposalMsgs function
+	weightedProposalMsgs := simulation.ProposalMsgs()
+	assert.Assert(t, len(weightedProposalMsgs) == 4)
+
+	// tests the weighted proposals interface
+	assert.Equal(t, simulation.OpWeightMsgAddRateLimit, weightedProposalMsgs[0].AppParamsKey())
+	assert.Equal(t, simulation.DefaultWeightMsgAddRateLimit, weightedProposalMsgs[0].DefaultWeight())
+	assert.Equal(t, simulation.OpWeightMsgUpdateRateLimit, weightedProposalMsgs[1].AppParamsKey())
+	assert.Equal(t, simulation.DefaultWeightMsgUpdateRateLimit, weightedProposalMsgs[1].DefaultWeight())
+	assert.Equal(t, simulation.OpWeightMsgResetRateLimit, weightedProposalMsgs[2].AppParamsKey())
+	assert.Equal(t, simulation.DefaultWeightMsgResetRateLimit, weightedProposalMsgs[2].DefaultWeight())
+	assert.Equal(t, simulation.OpWeightMsgRemoveRateLimit, weightedProposalMsgs[3].AppParamsKey())
+	assert.Equal(t, simulation.DefaultWeightMsgRemoveRateLimit, weightedProposalMsgs[3].DefaultWeight())
+
+	for i := 0; i < 10; i++ {
+		msgAddRateLimit, ok := weightedProposalMsgs[0].MsgSimulatorFn()(r, ctx, accounts).(*types.MsgAddRateLimit)
+		assert.Assert(t, ok)
+		assert.Equal(t, authority, msgAddRateLimit.Authority)
+		assert.NilError(t, msgAddRateLimit.ValidateBasic())
+
+		msgUpdateRateLimit, ok := weightedProposalMsgs[1].MsgSimulatorFn()(r, ctx, accounts).(*types.MsgUpdateRateLimit)
+		assert.Assert(t, ok)
+		assert.Equal(t, authority, msgUpdateRateLimit.Authority)
+		assert.NilError(t, msgUpdateRateLimit.ValidateBasic())
+
+		msgResetRateLimit, ok := weightedProposalMsgs[2].MsgSimulatorFn()(r, ctx, accounts).(*types.MsgResetRateLimit)
+		assert.Assert(t, ok)
+		assert.Equal(t, authority, msgResetRateLimit.Authority)
+		assert.NilError(t, msgResetRateLimit.ValidateBasic())
+
+		msgRemoveRateLimit, ok := weightedProposalMsgs[3].MsgSimulatorFn()(r, ctx, accounts).(*types.MsgRemoveRateLimit)
+		assert.Assert(t, ok)
+		assert.Equal(t, authority, msgRemoveRateLimit.Authority)
+		assert.NilError(t, msgRemoveRateLimit.ValidateBasic())
+	}
+}
@@ -0,0 +1,150 @@
+package ratelimit_test
+
+import (
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	customibctesting "github.com/notional-labs/composable/v6/app/ibctesting"
+	ratelimitkeeper "github.com/notional-labs/composable/v6/x/ratelimit/keeper"
+	ratelimitsimulation "github.com/notional-labs/composable/v6/x/ratelimit/simulation"
+	ratelimittypes "github.com/notional-labs/composable/v6/x/ratelimit/types"
+)
+
+// Runs random governance updates of the rate limits and ICS-20 transfers through the
+// coordinator, and checks that the ratelimit invariants hold on both chains after each step
+func (suite *RateLimitTestSuite) TestSimulateRateLimitedTransfers() {
+	var (
+		timeoutHeight = clienttypes.NewHeight(1, 1000)
+		r             = rand.New(rand.NewSource(1))
+		steps         = 60
+	)
+
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// a first transfer creates the voucher supply on chain B, which is then rate limited on receive
+	suite.simulateTransfer(path, sdk.NewInt(1_000_000_000_000), timeoutHeight)
+	suite.Require().NoError(suite.coordinator.RelayAndAckPendingPackets(path))
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)
+	voucherDenom := transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+	err := suite.chainB.RateLimit().AddRateLimit(suite.chainB.GetContext(), &ratelimittypes.MsgAddRateLimit{
+		Denom:              voucherDenom,
+		ChannelID:          path.EndpointB.ChannelID,
+		MaxPercentSend:     sdk.NewInt(100),
+		MaxPercentRecv:     sdk.NewInt(20),
+		MinRateLimitAmount: sdk.NewInt(1),
+		DurationHours:      1,
+	})
+	suite.Require().NoError(err)
+
+	// the send quota of chain A is driven by the min rate limit amount as the bond denom supply is large
+	err = suite.chainA.RateLimit().AddRateLimit(suite.chainA.GetContext(), &ratelimittypes.MsgAddRateLimit{
+		Denom:               sdk.DefaultBondDenom,
+		ChannelID:           path.EndpointA.ChannelID,
+		MaxPercentSend:      sdk.NewInt(0),
+		MaxPercentRecv:      sdk.NewInt(1),
+		MinRateLimitAmount:  sdk.NewInt(500_000_000_000),
+		DurationHours:       1,
+		MaxAmountPerAddress: sdk.NewInt(400_000_000_000),
+	})
+	suite.Require().NoError(err)
+
+	proposalMsgs := ratelimitsimulation.ProposalMsgs()
+	accounts := simtypes.RandomAccounts(r, 1)
+	for i := 0; i < steps; i++ {
+		switch op := r.Intn(10); {
+		case op < 1:
+			// governance updates the rate limits of chain A
+			proposalMsg := proposalMsgs[r.Intn(len(proposalMsgs))]
+			suite.executeProposalMsg(suite.chainA, proposalMsg.MsgSimulatorFn()(r, suite.chainA.GetContext(), accounts))
+		case op < 6:
+			amount := sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 200_000_000_000)))
+			suite.simulateTransfer(path, amount, timeoutHeight)
+		case op < 9:
+			suite.relayPendingPackets(path)
+		default:
+			// let the hour epoch reset the flows
+			suite.coordinator.IncrementTimeBy(time.Duration(r.Int63n(int64(2 * time.Hour))))
+			suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+		}
+
+		suite.assertInvariants(suite.chainA)
+		suite.assertInvariants(suite.chainB)
+	}
+
+	suite.relayPendingPackets(path)
+	suite.assertInvariants(suite.chainA)
+	suite.assertInvariants(suite.chainB)
+	suite.Require().Empty(suite.chainA.RateLimit().GetAllPendingSendPackets(suite.chainA.GetContext()))
+}
+
+// Sends a transfer from chain A to chain B, whether the rate limiter denies it or not
+func (suite *RateLimitTestSuite) simulateTransfer(path *customibctesting.Path, amount sdk.Int, timeoutHeight clienttypes.Height) {
+	sender := suite.chainA.SenderAccount.GetAddress().String()
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+
+	// check whether the rate limiter would deny the transfer without writing to the store
+	cacheCtx, _ := suite.chainA.GetContext().CacheContext()
+	_, rateLimitErr := suite.chainA.RateLimit().CheckRateLimitAndUpdateFlow(cacheCtx, ratelimittypes.PACKET_SEND, ratelimitkeeper.RateLimitedPacketInfo{
+		ChannelID: path.EndpointA.ChannelID,
+		Denom:     sdk.DefaultBondDenom,
+		Amount:    amount,
+		Sender:    sender,
+		Receiver:  receiver,
+	})
+
+	msg := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount), sender, receiver, timeoutHeight, 0, "")
+	if rateLimitErr == nil {
+		_, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+		return
+	}
+
+	_, err := suite.chainA.SendMsgsWithExpPass(false, msg)
+	suite.Require().Error(err)
+
+	// SignAndDeliver calls app.Commit()
+	suite.chainA.NextBlock()
+
+	// the sequence is incremented even if the transaction failed
+	err = suite.chainA.SenderAccount.SetSequence(suite.chainA.SenderAccount.GetSequence() + 1)
+	suite.Require().NoError(err)
+	suite.coordinator.IncrementTime()
+}
+
+// Relays the pending packets from chain A to chain B and their acks back one by one,
+// as receiving a packet updates the client on chain A and invalidates the proofs of the others
+func (suite *RateLimitTestSuite) relayPendingPackets(path *customibctesting.Path) {
+	pendingPackets := suite.chainA.PendingSendPackets
+	for _, packet := range pendingPackets {
+		suite.chainA.PendingSendPackets = []channeltypes.Packet{packet}
+		suite.Require().NoError(suite.coordinator.RelayAndAckPendingPackets(path))
+	}
+}
+
+// Executes a governance msg, discarding its changes if it fails
+func (suite *RateLimitTestSuite) executeProposalMsg(chain *customibctesting.TestChain, msg sdk.Msg) {
+	if err := msg.ValidateBasic(); err != nil {
+		return
+	}
+
+	cacheCtx, write := chain.GetContext().CacheContext()
+	handler := chain.App.GetBaseApp().MsgServiceRouter().Handler(msg)
+	if _, err := handler(cacheCtx, msg); err == nil {
+		write()
+	}
+}
+
+func (suite *RateLimitTestSuite) assertInvariants(chain *customibctesting.TestChain) {
+	msg, broken := ratelimitkeeper.AllInvariants(chain.RateLimit())(chain.GetContext())
+	suite.Require().False(broken, msg)
+}
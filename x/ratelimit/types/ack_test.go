@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/notional-labs/composable/v6/x/ratelimit/types"
+)
+
+func TestRateLimitedAcknowledgement(t *testing.T) {
+	testCases := map[string]struct {
+		err        error
+		retryAfter int64
+
+		expRateLimited bool
+		expReason      string
+	}{
+		"quota exceeded": {
+			err:            errorsmod.Wrapf(types.ErrQuotaExceeded, "Inflow exceeds quota"),
+			retryAfter:     1_700_000_000,
+			expRateLimited: true,
+			expReason:      types.DenialReasonQuotaExceeded,
+		},
+		"denom blacklisted": {
+			err:            errorsmod.Wrapf(types.ErrDenomIsBlacklisted, "denom ppica is blacklisted"),
+			expRateLimited: true,
+			expReason:      types.DenialReasonDenomBlacklisted,
+		},
+		"path paused": {
+			err:            types.ErrPathIsPaused,
+			expRateLimited: true,
+			expReason:      types.DenialReasonPathPaused,
+		},
+		"not rate limited": {
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ack := types.NewRateLimitedAcknowledgement(tc.err, tc.retryAfter)
+			require.False(t, ack.Success())
+			require.NoError(t, ack.ValidateBasic())
+
+			errAck, ok := ack.Response.(*channeltypes.Acknowledgement_Error)
+			require.True(t, ok)
+
+			reason, retryAfter, rateLimited := types.ParseRateLimitedAcknowledgement(errAck.Error)
+			require.Equal(t, tc.expRateLimited, rateLimited)
+			if !tc.expRateLimited {
+				require.Equal(t, channeltypes.NewErrorAcknowledgement(tc.err), ack)
+				return
+			}
+			require.Equal(t, tc.expReason, reason)
+			require.Equal(t, tc.retryAfter, retryAfter)
+		})
+	}
+}
@@ -20,6 +20,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateRateLimit{}, "composable/MsgUpdateRateLimit")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveRateLimit{}, "composable/MsgRemoveRateLimit")
 	legacy.RegisterAminoMsg(cdc, &MsgResetRateLimit{}, "composable/MsgResetRateLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgAddEpoch{}, "composable/MsgAddEpoch")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateEpoch{}, "composable/MsgUpdateEpoch")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveEpoch{}, "composable/MsgRemoveEpoch")
+	legacy.RegisterAminoMsg(cdc, &MsgBlacklistDenom{}, "composable/MsgBlacklistDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveBlacklistedDenom{}, "composable/MsgRemoveBlacklistedDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgPausePath{}, "composable/MsgPausePath")
+	legacy.RegisterAminoMsg(cdc, &MsgUnpausePath{}, "composable/MsgUnpausePath")
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -29,6 +36,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateRateLimit{},
 		&MsgRemoveRateLimit{},
 		&MsgResetRateLimit{},
+		&MsgAddEpoch{},
+		&MsgUpdateEpoch{},
+		&MsgRemoveEpoch{},
+		&MsgBlacklistDenom{},
+		&MsgRemoveBlacklistedDenom{},
+		&MsgPausePath{},
+		&MsgUnpausePath{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
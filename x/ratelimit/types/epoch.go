@@ -6,7 +6,10 @@ import (
 )
 
 var (
-	DayEpoch        = "hour"
+	// HourEpoch is the default epoch that drives rate limit windows
+	HourEpoch = "hour"
+	// Deprecated: DayEpoch ticks every hour, use HourEpoch instead
+	DayEpoch        = HourEpoch
 	EpochHourPeriod = 24 * time.Hour
 )
 
@@ -15,8 +18,8 @@ func (epoch EpochInfo) Validate() error {
 	if epoch.Identifier == "" {
 		return errors.New("epoch identifier should NOT be empty")
 	}
-	if epoch.Duration == 0 {
-		return errors.New("epoch duration should NOT be 0")
+	if epoch.Duration <= 0 {
+		return errors.New("epoch duration should be positive")
 	}
 	if epoch.CurrentEpoch < 0 {
 		return errors.New("epoch CurrentEpoch must be non-negative")
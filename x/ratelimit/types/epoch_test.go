@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/notional-labs/composable/v6/x/ratelimit/types"
+)
+
+func TestEpochInfoValidate(t *testing.T) {
+	testCases := map[string]struct {
+		epoch  types.EpochInfo
+		expErr bool
+	}{
+		"valid":                 {epoch: types.NewGenesisEpochInfo(types.HourEpoch, time.Hour)},
+		"empty identifier":      {epoch: types.NewGenesisEpochInfo("", time.Hour), expErr: true},
+		"zero duration":         {epoch: types.NewGenesisEpochInfo(types.HourEpoch, 0), expErr: true},
+		"negative duration":     {epoch: types.NewGenesisEpochInfo(types.HourEpoch, -time.Hour), expErr: true},
+		"negative epoch":        {epoch: types.EpochInfo{Identifier: types.HourEpoch, Duration: time.Hour, CurrentEpoch: -1}, expErr: true},
+		"negative start height": {epoch: types.EpochInfo{Identifier: types.HourEpoch, Duration: time.Hour, CurrentEpochStartHeight: -1}, expErr: true},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.epoch.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
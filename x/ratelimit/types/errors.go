@@ -13,4 +13,9 @@ var (
 	ErrInvalidClientState     = errorsmod.Register(ModuleName, 5, "unable to determine client state from channelID")
 	ErrChannelNotFound        = errorsmod.Register(ModuleName, 6, "channel does not exist")
 	ErrDenomIsBlacklisted     = errorsmod.Register(ModuleName, 7, "denom is blacklisted")
+	ErrPathIsPaused           = errorsmod.Register(ModuleName, 8, "rate limit path is paused")
+	ErrEpochNotFound          = errorsmod.Register(ModuleName, 9, "epoch not found")
+	ErrEpochAlreadyExists     = errorsmod.Register(ModuleName, 10, "epoch already exists")
+	ErrEpochInUse             = errorsmod.Register(ModuleName, 11, "epoch is used by a rate limit")
+	ErrAddressQuotaExceeded   = errorsmod.Register(ModuleName, 12, "address quota exceeded")
 )
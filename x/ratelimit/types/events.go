@@ -3,15 +3,23 @@ package types
 var (
 	EventTransferDenied = "transfer_denied"
 
-	EventRateLimitExceeded = "rate_limit_exceeded"
+	EventRateLimitExceeded        = "rate_limit_exceeded"
+	EventAddressRateLimitExceeded = "address_rate_limit_exceeded"
+	EventBlacklistedDenom         = "blacklisted_denom"
+	EventPausedPath               = "paused_path"
 
-	AttributeKeyReason  = "reason"
-	AttributeKeyModule  = "module"
-	AttributeKeyAction  = "action"
-	AttributeKeyDenom   = "denom"
-	AttributeKeyChannel = "channel"
-	AttributeKeyAmount  = "amount"
-	AttributeKeyError   = "error"
+	EventTransferRateLimited = "transfer_rate_limited"
+
+	AttributeKeyReason     = "reason"
+	AttributeKeyModule     = "module"
+	AttributeKeyAction     = "action"
+	AttributeKeyDenom      = "denom"
+	AttributeKeyChannel    = "channel"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyError      = "error"
+	AttributeKeySender     = "sender"
+	AttributeKeySequence   = "sequence"
+	AttributeKeyRetryAfter = "retry_after"
 
 	EventTypeEpochEnd       = "epoch_end" // TODO: need to clean up (not use)
 	EventTypeEpochStart     = "epoch_start"
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -12,5 +14,16 @@ func DefaultGenesisState() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func ValidateGenesis(data GenesisState) error {
+	epochs := make(map[string]bool)
+	for _, epoch := range data.Epochs {
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+		if epochs[epoch.Identifier] {
+			return fmt.Errorf("duplicated epoch identifier %s", epoch.Identifier)
+		}
+		epochs[epoch.Identifier] = true
+	}
+
 	return data.Params.Validate()
 }
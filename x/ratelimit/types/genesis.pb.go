@@ -25,11 +25,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ratelimit module's genesis state.
 type GenesisState struct {
-	Params                           Params                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
-	RateLimits                       []RateLimit              `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
-	WhitelistedAddressPairs          []WhitelistedAddressPair `protobuf:"bytes,3,rep,name=whitelisted_address_pairs,json=whitelistedAddressPairs,proto3" json:"whitelisted_address_pairs" yaml:"whitelisted_address_pairs"`
-	PendingSendPacketSequenceNumbers []string                 `protobuf:"bytes,4,rep,name=pending_send_packet_sequence_numbers,json=pendingSendPacketSequenceNumbers,proto3" json:"pending_send_packet_sequence_numbers,omitempty"`
-	Epochs                           []EpochInfo              `protobuf:"bytes,5,rep,name=epochs,proto3" json:"epochs"`
+	Params                  Params                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+	RateLimits              []RateLimit              `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
+	WhitelistedAddressPairs []WhitelistedAddressPair `protobuf:"bytes,3,rep,name=whitelisted_address_pairs,json=whitelistedAddressPairs,proto3" json:"whitelisted_address_pairs" yaml:"whitelisted_address_pairs"`
+	// Deprecated: legacy pending packets don't record the denom and the amount
+	// that was sent, they are ignored on import. Use pending_send_packets.
+	PendingSendPacketSequenceNumbers []string            `protobuf:"bytes,4,rep,name=pending_send_packet_sequence_numbers,json=pendingSendPacketSequenceNumbers,proto3" json:"pending_send_packet_sequence_numbers,omitempty"` // Deprecated: Do not use.
+	Epochs                           []EpochInfo         `protobuf:"bytes,5,rep,name=epochs,proto3" json:"epochs"`
+	BlacklistedDenoms                []string            `protobuf:"bytes,6,rep,name=blacklisted_denoms,json=blacklistedDenoms,proto3" json:"blacklisted_denoms,omitempty"`
+	PausedPaths                      []Path              `protobuf:"bytes,7,rep,name=paused_paths,json=pausedPaths,proto3" json:"paused_paths" yaml:"paused_paths"`
+	AddressFlows                     []AddressFlow       `protobuf:"bytes,8,rep,name=address_flows,json=addressFlows,proto3" json:"address_flows" yaml:"address_flows"`
+	PendingSendPackets               []PendingSendPacket `protobuf:"bytes,9,rep,name=pending_send_packets,json=pendingSendPackets,proto3" json:"pending_send_packets" yaml:"pending_send_packets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +92,7 @@ func (m *GenesisState) GetWhitelistedAddressPairs() []WhitelistedAddressPair {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *GenesisState) GetPendingSendPacketSequenceNumbers() []string {
 	if m != nil {
 		return m.PendingSendPacketSequenceNumbers
@@ -100,6 +107,34 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 	return nil
 }
 
+func (m *GenesisState) GetBlacklistedDenoms() []string {
+	if m != nil {
+		return m.BlacklistedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedPaths() []Path {
+	if m != nil {
+		return m.PausedPaths
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAddressFlows() []AddressFlow {
+	if m != nil {
+		return m.AddressFlows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSendPackets() []PendingSendPacket {
+	if m != nil {
+		return m.PendingSendPackets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "composable.ratelimit.v1beta1.GenesisState")
 }
@@ -109,33 +144,41 @@ func init() {
 }
 
 var fileDescriptor_206604392405a216 = []byte{
-	// 405 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x14, 0xc6, 0x13, 0x73, 0x2d, 0x38, 0xd5, 0x85, 0x41, 0x31, 0x06, 0xc9, 0x0d, 0xe1, 0x82, 0xf1,
-	0x0f, 0x09, 0xf7, 0xea, 0xca, 0x9d, 0x81, 0x22, 0x82, 0x94, 0x92, 0x2c, 0x04, 0x37, 0x61, 0x92,
-	0x1c, 0xd3, 0x60, 0x33, 0x33, 0xce, 0x4c, 0xad, 0x7d, 0x0b, 0xd7, 0x3e, 0x51, 0x97, 0x5d, 0xba,
-	0x2a, 0xd2, 0xbe, 0x81, 0x7b, 0x41, 0x92, 0x19, 0x6d, 0x17, 0x1a, 0xdc, 0x85, 0xf0, 0xfb, 0x7e,
-	0xdf, 0x39, 0xc9, 0x41, 0x8f, 0x4b, 0xda, 0x32, 0x2a, 0x70, 0xb1, 0x80, 0x98, 0x63, 0x09, 0x8b,
-	0xa6, 0x6d, 0x64, 0xfc, 0xe9, 0xb2, 0x00, 0x89, 0x2f, 0xe3, 0x1a, 0x08, 0x88, 0x46, 0x44, 0x8c,
-	0x53, 0x49, 0xed, 0x07, 0x47, 0x36, 0xfa, 0xc3, 0x46, 0x9a, 0x75, 0xef, 0xd4, 0xb4, 0xa6, 0x3d,
-	0x18, 0x77, 0x4f, 0x2a, 0xe3, 0x3e, 0x1a, 0xf4, 0x33, 0xcc, 0x71, 0xab, 0xf5, 0xee, 0xd3, 0x41,
-	0xf4, 0x58, 0xa8, 0xe8, 0x70, 0x90, 0x06, 0x46, 0xcb, 0xb9, 0x22, 0x83, 0x9f, 0x16, 0xba, 0xf9,
-	0x4a, 0x2d, 0x92, 0x49, 0x2c, 0xc1, 0xce, 0xd0, 0x48, 0x15, 0x3b, 0xa6, 0x6f, 0x86, 0xe3, 0xab,
-	0x8b, 0x68, 0x68, 0xb1, 0x68, 0xd6, 0xb3, 0xc9, 0xdd, 0xcd, 0xee, 0xdc, 0xf8, 0xb1, 0x3b, 0xbf,
-	0xb5, 0xc6, 0xed, 0xe2, 0x45, 0xa0, 0x0c, 0x41, 0xaa, 0x55, 0x76, 0x85, 0xc6, 0x5d, 0x34, 0xef,
-	0xb3, 0xc2, 0xb9, 0xe6, 0x5b, 0xe1, 0xf8, 0xea, 0xe1, 0xb0, 0x39, 0xc5, 0x12, 0xde, 0x74, 0x6f,
-	0x12, 0x57, 0xcb, 0x6d, 0x25, 0x3f, 0x31, 0x05, 0x29, 0xe2, 0xbf, 0x31, 0x61, 0x7f, 0x35, 0xd1,
-	0xfd, 0xd5, 0xbc, 0xe9, 0x44, 0x42, 0x42, 0x95, 0xe3, 0xaa, 0xe2, 0x20, 0x44, 0xce, 0x70, 0xc3,
-	0x85, 0x63, 0xf5, 0xa5, 0xcf, 0x87, 0x4b, 0xdf, 0x1e, 0xe3, 0x2f, 0x55, 0x7a, 0x86, 0x1b, 0x9e,
-	0x84, 0x7a, 0x02, 0x5f, 0x4d, 0xf0, 0xcf, 0x92, 0x20, 0xbd, 0xb7, 0xfa, 0xab, 0x41, 0xd8, 0x53,
-	0x74, 0xc1, 0x80, 0x54, 0x0d, 0xa9, 0x73, 0x01, 0xa4, 0xca, 0x19, 0x2e, 0x3f, 0x80, 0xcc, 0x05,
-	0x7c, 0x5c, 0x02, 0x29, 0x21, 0x27, 0xcb, 0xb6, 0x00, 0x2e, 0x9c, 0x33, 0xdf, 0x0a, 0x6f, 0xa4,
-	0xbe, 0x66, 0x33, 0x20, 0xd5, 0xac, 0x27, 0x33, 0x0d, 0x4e, 0x15, 0x67, 0x4f, 0xd0, 0xa8, 0xff,
-	0x8f, 0xc2, 0xb9, 0xfe, 0x3f, 0x5f, 0x73, 0xd2, 0xb1, 0xaf, 0xc9, 0x7b, 0x9a, 0x9c, 0x75, 0xbb,
-	0xa4, 0x3a, 0x9c, 0x3c, 0xd9, 0xec, 0x3d, 0x73, 0xbb, 0xf7, 0xcc, 0xef, 0x7b, 0xcf, 0xfc, 0x72,
-	0xf0, 0x8c, 0xed, 0xc1, 0x33, 0xbe, 0x1d, 0x3c, 0xe3, 0xdd, 0xed, 0xcf, 0x27, 0xa7, 0x23, 0xd7,
-	0x0c, 0x44, 0x31, 0xea, 0x6f, 0xe6, 0xd9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x7f, 0x1e,
-	0xe5, 0x18, 0x03, 0x00, 0x00,
+	// 537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0xb6, 0x5f, 0xfa, 0x75, 0x92, 0x2e, 0x3a, 0x04, 0x61, 0xd2, 0xca, 0x8d, 0x4c,
+	0x25, 0x52, 0xfe, 0x24, 0x6a, 0x61, 0xc5, 0x0e, 0x8b, 0x82, 0x90, 0x10, 0x8a, 0x26, 0x0b, 0x24,
+	0x36, 0xd6, 0x38, 0xbe, 0x4d, 0xac, 0xda, 0x1e, 0xe3, 0x3b, 0x21, 0xf4, 0x05, 0xd8, 0xb0, 0x61,
+	0xcd, 0x13, 0x75, 0xd9, 0x25, 0xab, 0x0a, 0x25, 0x6f, 0xc0, 0x13, 0x20, 0xcf, 0x4c, 0x48, 0x80,
+	0xe0, 0xb2, 0x4b, 0x3c, 0xbf, 0x7b, 0xce, 0x3d, 0xf7, 0xce, 0x90, 0x7b, 0x03, 0x91, 0x64, 0x02,
+	0x79, 0x10, 0x43, 0x37, 0xe7, 0x12, 0xe2, 0x28, 0x89, 0x64, 0xf7, 0xfd, 0x51, 0x00, 0x92, 0x1f,
+	0x75, 0x87, 0x90, 0x02, 0x46, 0xd8, 0xc9, 0x72, 0x21, 0x05, 0xdd, 0x5b, 0xb0, 0x9d, 0x9f, 0x6c,
+	0xc7, 0xb0, 0xcd, 0xc6, 0x50, 0x0c, 0x85, 0x02, 0xbb, 0xc5, 0x2f, 0x5d, 0xd3, 0x3c, 0x2c, 0xd5,
+	0xcf, 0x78, 0xce, 0x13, 0x23, 0xdf, 0x7c, 0x50, 0x8a, 0x2e, 0x0c, 0x35, 0xdd, 0x2e, 0xa5, 0x21,
+	0x13, 0x83, 0x91, 0x26, 0xdd, 0x4f, 0x9b, 0xa4, 0xfe, 0x42, 0x07, 0xe9, 0x4b, 0x2e, 0x81, 0xf6,
+	0x49, 0x55, 0x1b, 0xdb, 0x56, 0xcb, 0x6a, 0xd7, 0x8e, 0x0f, 0x3a, 0x65, 0xc1, 0x3a, 0x3d, 0xc5,
+	0x7a, 0x37, 0x2f, 0xae, 0xf6, 0x2b, 0xdf, 0xaf, 0xf6, 0xb7, 0xcf, 0x79, 0x12, 0x3f, 0x71, 0xb5,
+	0x82, 0xcb, 0x8c, 0x14, 0x0d, 0x49, 0xad, 0x28, 0xf5, 0x55, 0x2d, 0xda, 0x6b, 0xad, 0xf5, 0x76,
+	0xed, 0xf8, 0x6e, 0xb9, 0x32, 0xe3, 0x12, 0x5e, 0x15, 0x5f, 0xbc, 0xa6, 0x11, 0xa7, 0x5a, 0x7c,
+	0x49, 0xc9, 0x65, 0x24, 0x9f, 0x63, 0x48, 0xbf, 0x58, 0xe4, 0xf6, 0x64, 0x14, 0x15, 0x42, 0x28,
+	0x21, 0xf4, 0x79, 0x18, 0xe6, 0x80, 0xe8, 0x67, 0x3c, 0xca, 0xd1, 0x5e, 0x57, 0xa6, 0x8f, 0xcb,
+	0x4d, 0xdf, 0x2c, 0xca, 0x9f, 0xea, 0xea, 0x1e, 0x8f, 0x72, 0xaf, 0x6d, 0x3a, 0x68, 0xe9, 0x0e,
+	0xfe, 0x6a, 0xe2, 0xb2, 0x5b, 0x93, 0x95, 0x0a, 0x48, 0x19, 0x39, 0xc8, 0x20, 0x0d, 0xa3, 0x74,
+	0xe8, 0x23, 0xa4, 0xa1, 0x9f, 0xf1, 0xc1, 0x19, 0x48, 0x1f, 0xe1, 0xdd, 0x18, 0xd2, 0x01, 0xf8,
+	0xe9, 0x38, 0x09, 0x20, 0x47, 0x7b, 0xa3, 0xb5, 0xde, 0xde, 0xf2, 0xd6, 0x6c, 0x8b, 0xb5, 0x0c,
+	0xdf, 0x87, 0x34, 0xec, 0x29, 0xba, 0x6f, 0xe0, 0xd7, 0x9a, 0xa5, 0x27, 0xa4, 0xaa, 0x76, 0x89,
+	0xf6, 0x7f, 0xff, 0x32, 0xd1, 0x93, 0x82, 0x7d, 0x99, 0x9e, 0x0a, 0x6f, 0xa3, 0xc8, 0xc3, 0x4c,
+	0x31, 0x7d, 0x48, 0x68, 0x10, 0xf3, 0xc1, 0x99, 0x49, 0x14, 0x42, 0x2a, 0x12, 0xb4, 0xab, 0x45,
+	0x23, 0x6c, 0x67, 0xe9, 0xe4, 0x99, 0x3a, 0xa0, 0x01, 0xa9, 0x67, 0x7c, 0x8c, 0x50, 0x64, 0x90,
+	0x23, 0xb4, 0x37, 0x95, 0xb7, 0x7b, 0xdd, 0x3d, 0x91, 0x23, 0x6f, 0xd7, 0x8c, 0xf1, 0xc6, 0xfc,
+	0x96, 0x2c, 0x54, 0x5c, 0x56, 0xd3, 0x7f, 0x0b, 0x10, 0x69, 0x4c, 0xb6, 0xe7, 0x83, 0x3d, 0x8d,
+	0xc5, 0x04, 0xed, 0xff, 0x95, 0xc9, 0x61, 0xb9, 0x89, 0x19, 0xf8, 0xf3, 0x58, 0x4c, 0xbc, 0x3d,
+	0xe3, 0xd5, 0xd0, 0x5e, 0xbf, 0xa8, 0xb9, 0xac, 0xce, 0x17, 0x28, 0xd2, 0x8f, 0x16, 0x69, 0xac,
+	0x58, 0x0e, 0xda, 0x5b, 0xca, 0xb5, 0x7b, 0x4d, 0xb4, 0xdf, 0xd7, 0xe4, 0xdd, 0x31, 0xde, 0xbb,
+	0x26, 0xe7, 0x0a, 0x69, 0x97, 0xd1, 0x3f, 0xd6, 0x8b, 0xde, 0xfd, 0x8b, 0xa9, 0x63, 0x5d, 0x4e,
+	0x1d, 0xeb, 0xdb, 0xd4, 0xb1, 0x3e, 0xcf, 0x9c, 0xca, 0xe5, 0xcc, 0xa9, 0x7c, 0x9d, 0x39, 0x95,
+	0xb7, 0x3b, 0x1f, 0x96, 0x1e, 0xb2, 0x3c, 0xcf, 0x00, 0x83, 0xaa, 0x7a, 0xc1, 0x8f, 0x7e, 0x0c,
+	0x00, 0x7f, 0x84, 0x39, 0x3f, 0xa6, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -158,6 +201,57 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingSendPackets) > 0 {
+		for iNdEx := len(m.PendingSendPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSendPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AddressFlows) > 0 {
+		for iNdEx := len(m.AddressFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PausedPaths) > 0 {
+		for iNdEx := len(m.PausedPaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedPaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BlacklistedDenoms) > 0 {
+		for iNdEx := len(m.BlacklistedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlacklistedDenoms[iNdEx])
+			copy(dAtA[i:], m.BlacklistedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlacklistedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Epochs) > 0 {
 		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -265,6 +359,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BlacklistedDenoms) > 0 {
+		for _, s := range m.BlacklistedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedPaths) > 0 {
+		for _, e := range m.PausedPaths {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressFlows) > 0 {
+		for _, e := range m.AddressFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSendPackets) > 0 {
+		for _, e := range m.PendingSendPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -470,6 +588,140 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlacklistedDenoms = append(m.BlacklistedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedPaths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedPaths = append(m.PausedPaths, Path{})
+			if err := m.PausedPaths[len(m.PausedPaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressFlows = append(m.AddressFlows, AddressFlow{})
+			if err := m.AddressFlows[len(m.AddressFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSendPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSendPackets = append(m.PendingSendPackets, PendingSendPacket{})
+			if err := m.PendingSendPackets[len(m.PendingSendPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,10 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	ModuleName = "ratelimit"
@@ -24,22 +28,36 @@ var (
 	RateLimitKeyPrefix        = KeyPrefix("rate-limit")
 	PendingSendPacketPrefix   = KeyPrefix("pending-send-packet")
 	DenomBlacklistKeyPrefix   = KeyPrefix("denom-blacklist")
+	PausedPathKeyPrefix       = KeyPrefix("paused-path")
+	AddressFlowKeyPrefix      = KeyPrefix("address-flow")
 	AddressWhitelistKeyPrefix = KeyPrefix("address-blacklist")
 	EpochKeyPrefix            = KeyPrefix("epoch")
-
-	PendingSendPacketChannelLength = 16
 )
 
-func GetPendingSendPacketKey(channelID string, sequenceNumber uint64) []byte {
-	channelIDBz := make([]byte, PendingSendPacketChannelLength)
-	copy(channelIDBz, channelID)
+// Returns the prefix of the pending send packets of a path, the channelID and denom are
+// length-prefixed so that the packets of a path can be iterated unambiguously
+func GetPendingSendPacketPathPrefix(channelID, denom string) []byte {
+	return append(address.MustLengthPrefix([]byte(channelID)), address.MustLengthPrefix([]byte(denom))...)
+}
 
+func GetPendingSendPacketKey(channelID, denom string, sequenceNumber uint64) []byte {
 	sequenceNumberBz := make([]byte, 8)
 	binary.BigEndian.PutUint64(sequenceNumberBz, sequenceNumber)
 
-	return append(channelIDBz, sequenceNumberBz...)
+	return append(GetPendingSendPacketPathPrefix(channelID, denom), sequenceNumberBz...)
 }
 
 func GetAddressWhitelistKey(sender, receiver string) []byte {
 	return append(KeyPrefix(sender), KeyPrefix(receiver)...)
 }
+
+// Returns the prefix of the address flows of a path, the denom and channelID are
+// length-prefixed so that the flows of a path can be iterated unambiguously
+func GetAddressFlowPathPrefix(denom, channelID string) []byte {
+	return append(address.MustLengthPrefix([]byte(denom)), address.MustLengthPrefix([]byte(channelID))...)
+}
+
+func GetAddressFlowKey(denom, channelID string, direction PacketDirection, addr string) []byte {
+	key := append(GetAddressFlowPathPrefix(denom, channelID), byte(direction))
+	return append(key, KeyPrefix(addr)...)
+}
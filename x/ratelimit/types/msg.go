@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,6 +15,14 @@ const (
 	TypeMsgUpdateRateLimit = "update_rate_limit"
 	TypeMsgRemoveRateLimit = "remove_rate_limit"
 	TypeMsgResetRateLimit  = "reset_rate_limit"
+	TypeMsgAddEpoch        = "add_epoch"
+	TypeMsgUpdateEpoch     = "update_epoch"
+	TypeMsgRemoveEpoch     = "remove_epoch"
+
+	TypeMsgBlacklistDenom         = "blacklist_denom"
+	TypeMsgRemoveBlacklistedDenom = "remove_blacklisted_denom"
+	TypeMsgPausePath              = "pause_path"
+	TypeMsgUnpausePath            = "unpause_path"
 )
 
 var _ sdk.Msg = &MsgAddRateLimit{}
@@ -84,7 +94,7 @@ func (msg *MsgAddRateLimit) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duration can not be zero")
 	}
 
-	return nil
+	return validateAddressQuota(msg.MaxPercentPerAddress, msg.MaxAmountPerAddress)
 }
 
 var _ sdk.Msg = &MsgUpdateRateLimit{}
@@ -156,7 +166,7 @@ func (msg *MsgUpdateRateLimit) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duration can not be zero")
 	}
 
-	return nil
+	return validateAddressQuota(msg.MaxPercentPerAddress, msg.MaxAmountPerAddress)
 }
 
 var _ sdk.Msg = &MsgRemoveRateLimit{}
@@ -246,3 +256,329 @@ func (msg *MsgResetRateLimit) ValidateBasic() error {
 
 	return err
 }
+
+var _ sdk.Msg = &MsgAddEpoch{}
+
+func NewMsgAddEpoch(
+	authority string,
+	identifier string,
+	startTime time.Time,
+	duration time.Duration,
+) *MsgAddEpoch {
+	return &MsgAddEpoch{
+		Authority:  authority,
+		Identifier: identifier,
+		StartTime:  startTime,
+		Duration:   duration,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgAddEpoch) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgAddEpoch) Type() string { return TypeMsgAddEpoch }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgAddEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgAddEpoch message.
+func (msg *MsgAddEpoch) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgAddEpoch) ValidateBasic() error {
+	// validate authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return NewGenesisEpochInfo(msg.Identifier, msg.Duration).Validate()
+}
+
+var _ sdk.Msg = &MsgUpdateEpoch{}
+
+func NewMsgUpdateEpoch(
+	authority string,
+	identifier string,
+	duration time.Duration,
+) *MsgUpdateEpoch {
+	return &MsgUpdateEpoch{
+		Authority:  authority,
+		Identifier: identifier,
+		Duration:   duration,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateEpoch) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUpdateEpoch) Type() string { return TypeMsgUpdateEpoch }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateEpoch message.
+func (msg *MsgUpdateEpoch) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateEpoch) ValidateBasic() error {
+	// validate authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return NewGenesisEpochInfo(msg.Identifier, msg.Duration).Validate()
+}
+
+var _ sdk.Msg = &MsgRemoveEpoch{}
+
+func NewMsgRemoveEpoch(
+	authority string,
+	identifier string,
+) *MsgRemoveEpoch {
+	return &MsgRemoveEpoch{
+		Authority:  authority,
+		Identifier: identifier,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRemoveEpoch) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRemoveEpoch) Type() string { return TypeMsgRemoveEpoch }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRemoveEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveEpoch message.
+func (msg *MsgRemoveEpoch) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRemoveEpoch) ValidateBasic() error {
+	// validate authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if msg.Identifier == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "epoch identifier should NOT be empty")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgBlacklistDenom{}
+
+func NewMsgBlacklistDenom(
+	authority string,
+	denom string,
+) *MsgBlacklistDenom {
+	return &MsgBlacklistDenom{
+		Authority: authority,
+		Denom:     denom,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgBlacklistDenom) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBlacklistDenom) Type() string { return TypeMsgBlacklistDenom }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgBlacklistDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgBlacklistDenom message.
+func (msg *MsgBlacklistDenom) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgBlacklistDenom) ValidateBasic() error {
+	// validate authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if msg.Denom == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "denom should NOT be empty")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgRemoveBlacklistedDenom{}
+
+func NewMsgRemoveBlacklistedDenom(
+	authority string,
+	denom string,
+) *MsgRemoveBlacklistedDenom {
+	return &MsgRemoveBlacklistedDenom{
+		Authority: authority,
+		Denom:     denom,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRemoveBlacklistedDenom) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRemoveBlacklistedDenom) Type() string { return TypeMsgRemoveBlacklistedDenom }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRemoveBlacklistedDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveBlacklistedDenom message.
+func (msg *MsgRemoveBlacklistedDenom) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRemoveBlacklistedDenom) ValidateBasic() error {
+	// validate authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if msg.Denom == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "denom should NOT be empty")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgPausePath{}
+
+func NewMsgPausePath(
+	authority string,
+	denom string,
+	channelID string,
+) *MsgPausePath {
+	return &MsgPausePath{
+		Authority: authority,
+		Denom:     denom,
+		ChannelID: channelID,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgPausePath) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgPausePath) Type() string { return TypeMsgPausePath }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgPausePath) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgPausePath message.
+func (msg *MsgPausePath) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgPausePath) ValidateBasic() error {
+	// validate authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if msg.Denom == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "denom should NOT be empty")
+	}
+
+	// validate channelIDs
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnpausePath{}
+
+func NewMsgUnpausePath(
+	authority string,
+	denom string,
+	channelID string,
+) *MsgUnpausePath {
+	return &MsgUnpausePath{
+		Authority: authority,
+		Denom:     denom,
+		ChannelID: channelID,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgUnpausePath) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUnpausePath) Type() string { return TypeMsgUnpausePath }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUnpausePath) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUnpausePath message.
+func (msg *MsgUnpausePath) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUnpausePath) ValidateBasic() error {
+	// validate authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if msg.Denom == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "denom should NOT be empty")
+	}
+
+	// validate channelIDs
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// per-address limits are optional, a nil or zero value disables them
+func validateAddressQuota(maxPercentPerAddress, maxAmountPerAddress math.Int) error {
+	if !maxPercentPerAddress.IsNil() && (maxPercentPerAddress.GT(math.NewInt(100)) || maxPercentPerAddress.IsNegative()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max-percent-per-address must be between 0 and 100 (inclusively), Provided: %v", maxPercentPerAddress)
+	}
+
+	if !maxAmountPerAddress.IsNil() && maxAmountPerAddress.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max-amount-per-address can not be negative, Provided: %v", maxAmountPerAddress)
+	}
+
+	return nil
+}
@@ -461,6 +461,270 @@ func (m *QueryAllWhitelistedAddressesResponse) GetAddressPairs() []WhitelistedAd
 	return nil
 }
 
+type QueryPendingSendPacketsRequest struct {
+	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelID string `protobuf:"bytes,2,opt,name=ChannelID,proto3" json:"ChannelID,omitempty"`
+}
+
+func (m *QueryPendingSendPacketsRequest) Reset()         { *m = QueryPendingSendPacketsRequest{} }
+func (m *QueryPendingSendPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSendPacketsRequest) ProtoMessage()    {}
+func (*QueryPendingSendPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcd0dc17fb77b132, []int{10}
+}
+func (m *QueryPendingSendPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSendPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSendPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingSendPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSendPacketsRequest.Merge(m, src)
+}
+func (m *QueryPendingSendPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSendPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSendPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingSendPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingSendPacketsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryPendingSendPacketsRequest) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+type QueryPendingSendPacketsResponse struct {
+	PendingSendPackets []PendingSendPacket `protobuf:"bytes,1,rep,name=pending_send_packets,json=pendingSendPackets,proto3" json:"pending_send_packets"`
+}
+
+func (m *QueryPendingSendPacketsResponse) Reset()         { *m = QueryPendingSendPacketsResponse{} }
+func (m *QueryPendingSendPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSendPacketsResponse) ProtoMessage()    {}
+func (*QueryPendingSendPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcd0dc17fb77b132, []int{11}
+}
+func (m *QueryPendingSendPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSendPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSendPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingSendPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSendPacketsResponse.Merge(m, src)
+}
+func (m *QueryPendingSendPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSendPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSendPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingSendPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingSendPacketsResponse) GetPendingSendPackets() []PendingSendPacket {
+	if m != nil {
+		return m.PendingSendPackets
+	}
+	return nil
+}
+
+type QueryEpochInfosRequest struct {
+}
+
+func (m *QueryEpochInfosRequest) Reset()         { *m = QueryEpochInfosRequest{} }
+func (m *QueryEpochInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInfosRequest) ProtoMessage()    {}
+func (*QueryEpochInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcd0dc17fb77b132, []int{12}
+}
+func (m *QueryEpochInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInfosRequest.Merge(m, src)
+}
+func (m *QueryEpochInfosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochInfosRequest proto.InternalMessageInfo
+
+type QueryEpochInfosResponse struct {
+	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+}
+
+func (m *QueryEpochInfosResponse) Reset()         { *m = QueryEpochInfosResponse{} }
+func (m *QueryEpochInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInfosResponse) ProtoMessage()    {}
+func (*QueryEpochInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcd0dc17fb77b132, []int{13}
+}
+func (m *QueryEpochInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInfosResponse.Merge(m, src)
+}
+func (m *QueryEpochInfosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInfosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochInfosResponse proto.InternalMessageInfo
+
+func (m *QueryEpochInfosResponse) GetEpochs() []EpochInfo {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+type QueryEpochInfoRequest struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *QueryEpochInfoRequest) Reset()         { *m = QueryEpochInfoRequest{} }
+func (m *QueryEpochInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInfoRequest) ProtoMessage()    {}
+func (*QueryEpochInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcd0dc17fb77b132, []int{14}
+}
+func (m *QueryEpochInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInfoRequest.Merge(m, src)
+}
+func (m *QueryEpochInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochInfoRequest proto.InternalMessageInfo
+
+func (m *QueryEpochInfoRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type QueryEpochInfoResponse struct {
+	Epoch EpochInfo `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch"`
+}
+
+func (m *QueryEpochInfoResponse) Reset()         { *m = QueryEpochInfoResponse{} }
+func (m *QueryEpochInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInfoResponse) ProtoMessage()    {}
+func (*QueryEpochInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcd0dc17fb77b132, []int{15}
+}
+func (m *QueryEpochInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInfoResponse.Merge(m, src)
+}
+func (m *QueryEpochInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochInfoResponse proto.InternalMessageInfo
+
+func (m *QueryEpochInfoResponse) GetEpoch() EpochInfo {
+	if m != nil {
+		return m.Epoch
+	}
+	return EpochInfo{}
+}
+
 func init() {
 	proto.RegisterType((*QueryAllRateLimitsRequest)(nil), "composable.ratelimit.v1beta1.QueryAllRateLimitsRequest")
 	proto.RegisterType((*QueryAllRateLimitsResponse)(nil), "composable.ratelimit.v1beta1.QueryAllRateLimitsResponse")
@@ -472,6 +736,12 @@ func init() {
 	proto.RegisterType((*QueryRateLimitsByChannelIDResponse)(nil), "composable.ratelimit.v1beta1.QueryRateLimitsByChannelIDResponse")
 	proto.RegisterType((*QueryAllWhitelistedAddressesRequest)(nil), "composable.ratelimit.v1beta1.QueryAllWhitelistedAddressesRequest")
 	proto.RegisterType((*QueryAllWhitelistedAddressesResponse)(nil), "composable.ratelimit.v1beta1.QueryAllWhitelistedAddressesResponse")
+	proto.RegisterType((*QueryPendingSendPacketsRequest)(nil), "composable.ratelimit.v1beta1.QueryPendingSendPacketsRequest")
+	proto.RegisterType((*QueryPendingSendPacketsResponse)(nil), "composable.ratelimit.v1beta1.QueryPendingSendPacketsResponse")
+	proto.RegisterType((*QueryEpochInfosRequest)(nil), "composable.ratelimit.v1beta1.QueryEpochInfosRequest")
+	proto.RegisterType((*QueryEpochInfosResponse)(nil), "composable.ratelimit.v1beta1.QueryEpochInfosResponse")
+	proto.RegisterType((*QueryEpochInfoRequest)(nil), "composable.ratelimit.v1beta1.QueryEpochInfoRequest")
+	proto.RegisterType((*QueryEpochInfoResponse)(nil), "composable.ratelimit.v1beta1.QueryEpochInfoResponse")
 }
 
 func init() {
@@ -479,46 +749,61 @@ func init() {
 }
 
 var fileDescriptor_dcd0dc17fb77b132 = []byte{
-	// 620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x16, 0x4a, 0xc9, 0x94, 0x1e, 0x58, 0x5a, 0x48, 0x4d, 0xe5, 0x04, 0x03, 0x22, 0x52,
-	0x8a, 0xad, 0x36, 0x11, 0x70, 0x28, 0x1f, 0x49, 0x2b, 0xa4, 0x48, 0x08, 0x15, 0x5f, 0x90, 0x7a,
-	0x31, 0x9b, 0x7a, 0x95, 0x58, 0x72, 0xbc, 0xae, 0xd7, 0x05, 0xa2, 0xaa, 0x17, 0x2e, 0x5c, 0x91,
-	0xf8, 0x1b, 0x1c, 0xf9, 0x11, 0xe5, 0x16, 0x09, 0x21, 0x71, 0xaa, 0x50, 0xd2, 0x1f, 0x82, 0xec,
-	0x6c, 0x6c, 0xd2, 0x26, 0x4e, 0x52, 0xd4, 0xdb, 0x7a, 0x76, 0xe6, 0xcd, 0x7b, 0xb3, 0xfb, 0xd6,
-	0x90, 0xdf, 0x65, 0x4d, 0x97, 0x71, 0x52, 0xb3, 0xa9, 0xe6, 0x11, 0x9f, 0xda, 0x56, 0xd3, 0xf2,
-	0xb5, 0xf7, 0x6b, 0x35, 0xea, 0x93, 0x35, 0x6d, 0x6f, 0x9f, 0x7a, 0x2d, 0xd5, 0xf5, 0x98, 0xcf,
-	0xf0, 0x4a, 0x9c, 0xa9, 0x46, 0x99, 0xaa, 0xc8, 0x94, 0x56, 0x13, 0x71, 0xe2, 0xfc, 0x10, 0x4b,
-	0x5a, 0xa9, 0x33, 0x56, 0xb7, 0xa9, 0x46, 0x5c, 0x4b, 0x23, 0x8e, 0xc3, 0x7c, 0xe2, 0x5b, 0xcc,
-	0xe1, 0x62, 0x77, 0xb1, 0xce, 0xea, 0x2c, 0x5c, 0x6a, 0xc1, 0xaa, 0x17, 0x55, 0x6e, 0xc3, 0xf2,
-	0x9b, 0x80, 0x4e, 0xd9, 0xb6, 0x75, 0xe2, 0xd3, 0x57, 0x01, 0x1c, 0xd7, 0xe9, 0xde, 0x3e, 0xe5,
-	0xbe, 0x62, 0x83, 0x34, 0x6c, 0x93, 0xbb, 0xcc, 0xe1, 0x14, 0xbf, 0x86, 0xf9, 0x80, 0x81, 0x11,
-	0x52, 0xe0, 0x19, 0x94, 0xbb, 0x94, 0x9f, 0x5f, 0x7f, 0xa0, 0x26, 0x09, 0x52, 0x23, 0x98, 0xca,
-	0xe5, 0xa3, 0xe3, 0x6c, 0x4a, 0x07, 0x2f, 0xc2, 0x55, 0x76, 0x60, 0x29, 0xec, 0x16, 0xe5, 0x08,
-	0x1a, 0x78, 0x11, 0x66, 0x4d, 0xea, 0xb0, 0x66, 0x06, 0xe5, 0x50, 0x3e, 0xad, 0xf7, 0x3e, 0x70,
-	0x01, 0xd2, 0x9b, 0x0d, 0xe2, 0x38, 0xd4, 0xae, 0x6e, 0x65, 0x66, 0x82, 0x9d, 0xca, 0x42, 0xe7,
-	0x38, 0x1b, 0x07, 0xf5, 0x78, 0xa9, 0xbc, 0x83, 0x9b, 0xa7, 0xb1, 0x85, 0x8a, 0x97, 0x00, 0xb1,
-	0x8a, 0xb0, 0xc3, 0xe4, 0x22, 0xf4, 0x74, 0x44, 0x5f, 0xd9, 0x80, 0xec, 0x60, 0x07, 0x5e, 0x69,
-	0x6d, 0x36, 0x88, 0xe5, 0x54, 0xb7, 0xfa, 0x3a, 0x96, 0xe1, 0xea, 0x6e, 0x10, 0x31, 0x2c, 0x53,
-	0x48, 0x99, 0x0b, 0xbf, 0xab, 0xa6, 0xe2, 0x41, 0x6e, 0x74, 0xf5, 0x05, 0xcd, 0x7b, 0x1b, 0xee,
-	0x0c, 0xeb, 0x29, 0x86, 0x27, 0x38, 0x0f, 0x4c, 0x19, 0x8d, 0x99, 0xb2, 0x0f, 0x4a, 0x12, 0xe2,
-	0x05, 0xe9, 0xb8, 0x0f, 0x77, 0xfb, 0xb7, 0xf4, 0x6d, 0xc3, 0x0a, 0x2a, 0xb9, 0x4f, 0xcd, 0xb2,
-	0x69, 0x7a, 0x94, 0x73, 0x1a, 0x5d, 0xe6, 0xcf, 0x08, 0xee, 0x25, 0xe7, 0x09, 0x7e, 0x06, 0x2c,
-	0x90, 0x5e, 0xd0, 0x70, 0x89, 0xe5, 0xf5, 0x19, 0x96, 0x92, 0x19, 0x9e, 0x85, 0xdc, 0x26, 0x96,
-	0x27, 0xe8, 0x5e, 0x23, 0x71, 0x88, 0xaf, 0x9f, 0xcc, 0xc1, 0x6c, 0xc8, 0x04, 0x7f, 0x43, 0xb0,
-	0x30, 0x60, 0x2e, 0xfc, 0x38, 0xb9, 0xcb, 0x48, 0xaf, 0x4a, 0x4f, 0xa6, 0x2f, 0xec, 0xe9, 0x55,
-	0xf2, 0x9f, 0x7e, 0x9e, 0x7c, 0x9d, 0x51, 0x70, 0x4e, 0x1b, 0xfa, 0xda, 0x44, 0x2b, 0x8e, 0xbf,
-	0x23, 0x48, 0x47, 0x00, 0xb8, 0x38, 0x41, 0xc7, 0xd3, 0x5e, 0x96, 0x4a, 0xd3, 0x15, 0x09, 0x8a,
-	0x1b, 0x21, 0xc5, 0x47, 0xb8, 0x34, 0x86, 0xa2, 0x76, 0x10, 0x5d, 0xb7, 0x43, 0xad, 0xd6, 0x32,
-	0x7a, 0x2f, 0xc5, 0x0f, 0x04, 0x37, 0x86, 0x18, 0x0b, 0x3f, 0x9d, 0x86, 0xcb, 0x19, 0x3b, 0x4b,
-	0xcf, 0xce, 0x5b, 0x2e, 0x44, 0x15, 0x43, 0x51, 0x0f, 0x71, 0x61, 0xdc, 0xdc, 0xb5, 0x83, 0xfe,
-	0xb3, 0x71, 0x88, 0xdb, 0x08, 0x96, 0x86, 0xda, 0x0b, 0x3f, 0x9f, 0x9e, 0xce, 0x80, 0xd5, 0xa5,
-	0x17, 0xe7, 0x07, 0x10, 0x8a, 0x4a, 0xa1, 0x22, 0x15, 0xaf, 0x8e, 0x57, 0x14, 0x9f, 0x13, 0xfe,
-	0x85, 0xe0, 0xd6, 0x08, 0x4f, 0xe2, 0xf2, 0x64, 0xb7, 0x3a, 0xc1, 0xf7, 0x52, 0xe5, 0x7f, 0x20,
-	0x26, 0x3b, 0xaa, 0x0f, 0x71, 0xad, 0x41, 0xfa, 0xc5, 0x95, 0xc2, 0x51, 0x47, 0x46, 0xed, 0x8e,
-	0x8c, 0xfe, 0x74, 0x64, 0xf4, 0xa5, 0x2b, 0xa7, 0xda, 0x5d, 0x39, 0xf5, 0xbb, 0x2b, 0xa7, 0x76,
-	0xae, 0x7f, 0xfc, 0xa7, 0xd8, 0x6f, 0xb9, 0x94, 0xd7, 0xae, 0x84, 0xbf, 0xe3, 0xe2, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x73, 0x82, 0x8b, 0x51, 0x3a, 0x08, 0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0xd0, 0x42, 0xeb, 0x07, 0x1c, 0x3a, 0x85, 0x62, 0xb6, 0x68, 0xed, 0x6e, 0xa9, 0xea,
+	0xca, 0xd4, 0x2b, 0xc0, 0x2d, 0x3d, 0x40, 0xa9, 0x0d, 0xb4, 0xb2, 0x54, 0x55, 0xae, 0x7b, 0xa8,
+	0x84, 0x54, 0x2d, 0x6b, 0xef, 0x60, 0xaf, 0x6a, 0x76, 0x97, 0x9d, 0xa5, 0x8d, 0x85, 0xb8, 0xe4,
+	0x12, 0x29, 0x97, 0x44, 0xca, 0x0f, 0xc8, 0x39, 0x52, 0x8e, 0xf9, 0x11, 0xe4, 0x86, 0x14, 0x45,
+	0x8a, 0x72, 0x40, 0x91, 0xc9, 0x0f, 0x89, 0x76, 0x76, 0xbc, 0x8b, 0xed, 0xf5, 0x7a, 0x4d, 0xc2,
+	0x6d, 0x3c, 0xf3, 0xde, 0xf7, 0xbe, 0xef, 0xed, 0xcc, 0xf7, 0x0c, 0xd9, 0xba, 0x79, 0x64, 0x99,
+	0x54, 0xad, 0xb5, 0x88, 0x6c, 0xab, 0x0e, 0x69, 0xe9, 0x47, 0xba, 0x23, 0xff, 0xb7, 0x5a, 0x23,
+	0x8e, 0xba, 0x2a, 0x1f, 0x9f, 0x10, 0xbb, 0x9d, 0xb7, 0x6c, 0xd3, 0x31, 0xf1, 0x52, 0x10, 0x99,
+	0xf7, 0x23, 0xf3, 0x3c, 0x52, 0x58, 0x89, 0xc4, 0x09, 0xe2, 0x19, 0x96, 0x10, 0x5d, 0x95, 0x58,
+	0x66, 0xbd, 0xc9, 0x23, 0x97, 0x1a, 0xa6, 0xd9, 0x68, 0x11, 0x59, 0xb5, 0x74, 0x59, 0x35, 0x0c,
+	0xd3, 0x51, 0x1d, 0xdd, 0x34, 0x28, 0x3f, 0x9d, 0x6b, 0x98, 0x0d, 0x93, 0x2d, 0x65, 0x77, 0xe5,
+	0xed, 0x4a, 0x5f, 0xc2, 0xe2, 0x9f, 0x2e, 0xf1, 0x62, 0xab, 0x55, 0x55, 0x1d, 0xf2, 0xbb, 0x0b,
+	0x4e, 0xab, 0xe4, 0xf8, 0x84, 0x50, 0x47, 0x6a, 0x81, 0x10, 0x76, 0x48, 0x2d, 0xd3, 0xa0, 0x04,
+	0xff, 0x01, 0xd3, 0x2e, 0x1f, 0x85, 0x11, 0xa2, 0x29, 0x94, 0xf9, 0x28, 0x3b, 0xbd, 0xf6, 0x6d,
+	0x3e, 0x4a, 0x7a, 0xde, 0x87, 0x29, 0x7d, 0x7c, 0x7e, 0x99, 0x4e, 0x54, 0xc1, 0xf6, 0x71, 0xa5,
+	0x7d, 0x98, 0x67, 0xd5, 0xfc, 0x18, 0x4e, 0x03, 0xcf, 0xc1, 0xa4, 0x46, 0x0c, 0xf3, 0x28, 0x85,
+	0x32, 0x28, 0x9b, 0xac, 0x7a, 0x3f, 0x70, 0x0e, 0x92, 0x3b, 0x4d, 0xd5, 0x30, 0x48, 0xab, 0xbc,
+	0x9b, 0x9a, 0x70, 0x4f, 0x4a, 0xb3, 0x9d, 0xcb, 0x74, 0xb0, 0x59, 0x0d, 0x96, 0xd2, 0x01, 0x7c,
+	0xd1, 0x8f, 0xcd, 0x55, 0xfc, 0x0a, 0x10, 0xa8, 0x60, 0x15, 0xe2, 0x8b, 0xa8, 0x26, 0x7d, 0xfa,
+	0xd2, 0x26, 0xa4, 0x7b, 0x2b, 0xd0, 0x52, 0x7b, 0xa7, 0xa9, 0xea, 0x46, 0x79, 0xb7, 0xab, 0x63,
+	0x11, 0x3e, 0xad, 0xbb, 0x3b, 0x8a, 0xae, 0x71, 0x29, 0x9f, 0xb0, 0xdf, 0x65, 0x4d, 0xb2, 0x21,
+	0x33, 0x3c, 0xfb, 0x96, 0xfa, 0x5d, 0x81, 0xaf, 0xc2, 0x6a, 0xf2, 0xe6, 0x71, 0xce, 0x3d, 0x5d,
+	0x46, 0x23, 0xba, 0xec, 0x80, 0x14, 0x85, 0x78, 0x4b, 0x3a, 0xbe, 0x81, 0xaf, 0xbb, 0xb7, 0xf4,
+	0xef, 0xa6, 0xee, 0x66, 0x52, 0x87, 0x68, 0x45, 0x4d, 0xb3, 0x09, 0xa5, 0xc4, 0xbf, 0xcc, 0xf7,
+	0x10, 0x2c, 0x47, 0xc7, 0x71, 0x7e, 0x0a, 0xcc, 0xaa, 0xde, 0xa6, 0x62, 0xa9, 0xba, 0xdd, 0x65,
+	0x58, 0x88, 0x66, 0x38, 0x08, 0x59, 0x51, 0x75, 0x9b, 0xd3, 0x9d, 0x51, 0x83, 0x2d, 0x2a, 0xd5,
+	0x41, 0x64, 0x44, 0x2a, 0xc4, 0xd0, 0x74, 0xa3, 0xf1, 0x17, 0x31, 0xb4, 0x8a, 0x5a, 0xff, 0x97,
+	0x38, 0xf4, 0x03, 0xde, 0xf8, 0xfb, 0x08, 0xd2, 0x43, 0xab, 0x70, 0xa5, 0x0d, 0x98, 0xb3, 0xbc,
+	0x53, 0x85, 0x12, 0x43, 0x53, 0x2c, 0xef, 0x9c, 0x0b, 0x96, 0xa3, 0x05, 0x0f, 0xe0, 0x72, 0xad,
+	0xd8, 0x1a, 0x28, 0x28, 0xa5, 0xf8, 0xf3, 0xdb, 0x73, 0xdd, 0xaa, 0x6c, 0x1c, 0x9a, 0xfe, 0x57,
+	0x39, 0x80, 0x85, 0x81, 0x13, 0xce, 0x6e, 0x0f, 0xa6, 0x98, 0xbb, 0xc5, 0xbc, 0x22, 0x3e, 0x02,
+	0xe7, 0xc1, 0x93, 0xa5, 0x0d, 0x6e, 0x2b, 0xfe, 0x79, 0xb7, 0xc9, 0x22, 0x80, 0xae, 0x11, 0xc3,
+	0xd1, 0x0f, 0x75, 0x62, 0xf3, 0x4e, 0x5f, 0xdb, 0x91, 0xfe, 0xe9, 0x27, 0xed, 0x33, 0xdb, 0x81,
+	0x49, 0x06, 0x1e, 0xcf, 0x2e, 0xfa, 0x89, 0x79, 0xb9, 0x6b, 0x0f, 0x66, 0x60, 0x92, 0xe1, 0xe3,
+	0xa7, 0x08, 0x66, 0x7b, 0x2c, 0x16, 0x6f, 0x44, 0x23, 0x0e, 0x75, 0x6c, 0xe1, 0xa7, 0xf1, 0x13,
+	0x3d, 0x4d, 0x52, 0xf6, 0xee, 0x8b, 0xb7, 0x8f, 0x26, 0x24, 0x9c, 0x91, 0x43, 0xe7, 0x8d, 0xbf,
+	0xa2, 0xf8, 0x19, 0x82, 0xa4, 0x0f, 0x80, 0xd7, 0x63, 0x54, 0xec, 0x77, 0x74, 0xa1, 0x30, 0x5e,
+	0x12, 0xa7, 0xb8, 0xc9, 0x28, 0xfe, 0x88, 0x0b, 0x23, 0x28, 0xca, 0xa7, 0xfe, 0x33, 0x38, 0x93,
+	0x6b, 0x6d, 0xc5, 0x7b, 0x3d, 0xcf, 0x11, 0x7c, 0x1e, 0x62, 0xaf, 0x78, 0x6b, 0x1c, 0x2e, 0x03,
+	0xa6, 0x2e, 0xfc, 0x7c, 0xd3, 0x74, 0x2e, 0x6a, 0x9d, 0x89, 0xfa, 0x1e, 0xe7, 0x46, 0xf5, 0x5d,
+	0x3e, 0xed, 0x0e, 0x8f, 0x33, 0x7c, 0x81, 0x60, 0x3e, 0xd4, 0x64, 0xf1, 0xf6, 0xf8, 0x74, 0x7a,
+	0x0c, 0x5f, 0xf8, 0xe5, 0xe6, 0x00, 0x5c, 0x51, 0x81, 0x29, 0xca, 0xe3, 0x95, 0xd1, 0x8a, 0x82,
+	0xef, 0x84, 0x5f, 0x22, 0x58, 0x18, 0xe2, 0xcc, 0xb8, 0x18, 0xef, 0x56, 0x47, 0xb8, 0xbf, 0x50,
+	0x7a, 0x1f, 0x88, 0x78, 0x9f, 0xea, 0xff, 0x20, 0x57, 0x51, 0x7d, 0xee, 0xaf, 0x11, 0xe0, 0x41,
+	0x0b, 0xc6, 0x9b, 0x31, 0xf8, 0x0c, 0x9d, 0x0f, 0xc2, 0xd6, 0x0d, 0xb3, 0xb9, 0x90, 0xdf, 0x98,
+	0x90, 0x22, 0xde, 0x0e, 0x17, 0x12, 0x36, 0x13, 0xc2, 0xdf, 0xd4, 0x63, 0x04, 0x10, 0x38, 0x37,
+	0x8e, 0xf3, 0xac, 0x07, 0x46, 0x80, 0xf0, 0xc3, 0x98, 0x59, 0x5c, 0xc4, 0x32, 0x13, 0x21, 0xe2,
+	0xa5, 0x70, 0x11, 0x9e, 0xfb, 0xe3, 0x27, 0x08, 0x92, 0x7e, 0x72, 0x2c, 0xb3, 0xea, 0x9f, 0x13,
+	0x42, 0x61, 0xbc, 0x24, 0x4e, 0x6f, 0x95, 0xd1, 0xcb, 0xe1, 0xef, 0xa2, 0xe8, 0xc9, 0xa7, 0xc1,
+	0xbc, 0x39, 0x2b, 0xe5, 0xce, 0x3b, 0x22, 0xba, 0xe8, 0x88, 0xe8, 0x4d, 0x47, 0x44, 0x0f, 0xaf,
+	0xc4, 0xc4, 0xc5, 0x95, 0x98, 0x78, 0x75, 0x25, 0x26, 0xf6, 0x3f, 0xbb, 0x73, 0x2d, 0xd5, 0x69,
+	0x5b, 0x84, 0xd6, 0xa6, 0xd8, 0xff, 0xf7, 0xf5, 0x77, 0x03, 0x00, 0x75, 0x5e, 0xe9, 0xed, 0x95,
+	0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -538,6 +823,9 @@ type QueryClient interface {
 	RateLimitsByChainID(ctx context.Context, in *QueryRateLimitsByChainIDRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChainIDResponse, error)
 	RateLimitsByChannelID(ctx context.Context, in *QueryRateLimitsByChannelIDRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChannelIDResponse, error)
 	AllWhitelistedAddresses(ctx context.Context, in *QueryAllWhitelistedAddressesRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedAddressesResponse, error)
+	PendingSendPackets(ctx context.Context, in *QueryPendingSendPacketsRequest, opts ...grpc.CallOption) (*QueryPendingSendPacketsResponse, error)
+	EpochInfos(ctx context.Context, in *QueryEpochInfosRequest, opts ...grpc.CallOption) (*QueryEpochInfosResponse, error)
+	EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error)
 }
 
 type queryClient struct {
@@ -593,6 +881,33 @@ func (c *queryClient) AllWhitelistedAddresses(ctx context.Context, in *QueryAllW
 	return out, nil
 }
 
+func (c *queryClient) PendingSendPackets(ctx context.Context, in *QueryPendingSendPacketsRequest, opts ...grpc.CallOption) (*QueryPendingSendPacketsResponse, error) {
+	out := new(QueryPendingSendPacketsResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Query/PendingSendPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochInfos(ctx context.Context, in *QueryEpochInfosRequest, opts ...grpc.CallOption) (*QueryEpochInfosResponse, error) {
+	out := new(QueryEpochInfosResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Query/EpochInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error) {
+	out := new(QueryEpochInfoResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Query/EpochInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	AllRateLimits(context.Context, *QueryAllRateLimitsRequest) (*QueryAllRateLimitsResponse, error)
@@ -600,6 +915,9 @@ type QueryServer interface {
 	RateLimitsByChainID(context.Context, *QueryRateLimitsByChainIDRequest) (*QueryRateLimitsByChainIDResponse, error)
 	RateLimitsByChannelID(context.Context, *QueryRateLimitsByChannelIDRequest) (*QueryRateLimitsByChannelIDResponse, error)
 	AllWhitelistedAddresses(context.Context, *QueryAllWhitelistedAddressesRequest) (*QueryAllWhitelistedAddressesResponse, error)
+	PendingSendPackets(context.Context, *QueryPendingSendPacketsRequest) (*QueryPendingSendPacketsResponse, error)
+	EpochInfos(context.Context, *QueryEpochInfosRequest) (*QueryEpochInfosResponse, error)
+	EpochInfo(context.Context, *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -621,6 +939,15 @@ func (*UnimplementedQueryServer) RateLimitsByChannelID(ctx context.Context, req
 func (*UnimplementedQueryServer) AllWhitelistedAddresses(ctx context.Context, req *QueryAllWhitelistedAddressesRequest) (*QueryAllWhitelistedAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllWhitelistedAddresses not implemented")
 }
+func (*UnimplementedQueryServer) PendingSendPackets(ctx context.Context, req *QueryPendingSendPacketsRequest) (*QueryPendingSendPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSendPackets not implemented")
+}
+func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpochInfosRequest) (*QueryEpochInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochInfos not implemented")
+}
+func (*UnimplementedQueryServer) EpochInfo(ctx context.Context, req *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochInfo not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -716,6 +1043,60 @@ func _Query_AllWhitelistedAddresses_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingSendPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSendPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSendPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Query/PendingSendPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSendPackets(ctx, req.(*QueryPendingSendPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Query/EpochInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochInfos(ctx, req.(*QueryEpochInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Query/EpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochInfo(ctx, req.(*QueryEpochInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "composable.ratelimit.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -740,6 +1121,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllWhitelistedAddresses",
 			Handler:    _Query_AllWhitelistedAddresses_Handler,
 		},
+		{
+			MethodName: "PendingSendPackets",
+			Handler:    _Query_PendingSendPackets_Handler,
+		},
+		{
+			MethodName: "EpochInfos",
+			Handler:    _Query_EpochInfos_Handler,
+		},
+		{
+			MethodName: "EpochInfo",
+			Handler:    _Query_EpochInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "composable/ratelimit/v1beta1/query.proto",
@@ -1071,30 +1464,227 @@ func (m *QueryAllWhitelistedAddressesResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPendingSendPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAllRateLimitsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryPendingSendPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllRateLimitsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPendingSendPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSendPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSendPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSendPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingSendPackets) > 0 {
+		for iNdEx := len(m.PendingSendPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSendPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if len(m.RateLimits) > 0 {
@@ -1216,13 +1806,593 @@ func (m *QueryAllWhitelistedAddressesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingSendPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSendPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingSendPackets) > 0 {
+		for _, e := range m.PendingSendPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEpochInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEpochInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Epoch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryAllRateLimitsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsByChainIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsByChainIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsByChainIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsByChainIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsByChainIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsByChainIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsByChannelIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1245,12 +2415,44 @@ func (m *QueryAllRateLimitsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRateLimitsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsByChannelIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsByChannelIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1272,7 +2474,7 @@ func (m *QueryAllRateLimitsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRateLimitsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsByChannelIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1295,10 +2497,10 @@ func (m *QueryAllRateLimitsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRateLimitsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsByChannelIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsByChannelIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1356,7 +2558,7 @@ func (m *QueryAllRateLimitsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllWhitelistedAddressesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1379,76 +2581,12 @@ func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllWhitelistedAddressesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllWhitelistedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1470,7 +2608,7 @@ func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllWhitelistedAddressesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1493,15 +2631,15 @@ func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllWhitelistedAddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllWhitelistedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressPairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1528,10 +2666,8 @@ func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RateLimit == nil {
-				m.RateLimit = &RateLimit{}
-			}
-			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AddressPairs = append(m.AddressPairs, WhitelistedAddressPair{})
+			if err := m.AddressPairs[len(m.AddressPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1556,7 +2692,7 @@ func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitsByChainIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingSendPacketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1579,15 +2715,15 @@ func (m *QueryRateLimitsByChainIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitsByChainIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingSendPacketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitsByChainIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingSendPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1615,7 +2751,39 @@ func (m *QueryRateLimitsByChainIDRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1638,7 +2806,7 @@ func (m *QueryRateLimitsByChainIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitsByChainIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingSendPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1661,15 +2829,15 @@ func (m *QueryRateLimitsByChainIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitsByChainIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingSendPacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitsByChainIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingSendPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSendPackets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1696,8 +2864,8 @@ func (m *QueryRateLimitsByChainIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RateLimits = append(m.RateLimits, RateLimit{})
-			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingSendPackets = append(m.PendingSendPackets, PendingSendPacket{})
+			if err := m.PendingSendPackets[len(m.PendingSendPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1722,7 +2890,7 @@ func (m *QueryRateLimitsByChainIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitsByChannelIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1745,44 +2913,12 @@ func (m *QueryRateLimitsByChannelIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitsByChannelIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitsByChannelIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1804,7 +2940,7 @@ func (m *QueryRateLimitsByChannelIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitsByChannelIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1827,15 +2963,15 @@ func (m *QueryRateLimitsByChannelIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitsByChannelIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitsByChannelIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1862,8 +2998,8 @@ func (m *QueryRateLimitsByChannelIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RateLimits = append(m.RateLimits, RateLimit{})
-			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Epochs = append(m.Epochs, EpochInfo{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1888,7 +3024,7 @@ func (m *QueryRateLimitsByChannelIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllWhitelistedAddressesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1911,12 +3047,44 @@ func (m *QueryAllWhitelistedAddressesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllWhitelistedAddressesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllWhitelistedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1938,7 +3106,7 @@ func (m *QueryAllWhitelistedAddressesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllWhitelistedAddressesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1961,15 +3129,15 @@ func (m *QueryAllWhitelistedAddressesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllWhitelistedAddressesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllWhitelistedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1996,8 +3164,7 @@ func (m *QueryAllWhitelistedAddressesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressPairs = append(m.AddressPairs, WhitelistedAddressPair{})
-			if err := m.AddressPairs[len(m.AddressPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4,13 +4,8 @@ import (
 	"cosmossdk.io/math"
 )
 
-// CheckExceedsQuota checks if new in/out flow is going to reach the max in/out or not
-func (q *Quota) CheckExceedsQuota(direction PacketDirection, amount, totalValue, minRateLimitAmount math.Int) bool {
-	// If there's no channel value (this should be almost impossible), it means there is no
-	// supply of the asset, so we shoudn't prevent inflows/outflows
-	if totalValue.IsZero() {
-		return false
-	}
+// GetThreshold returns the max amount that can flow in the given direction within a window
+func (q *Quota) GetThreshold(direction PacketDirection, totalValue, minRateLimitAmount math.Int) math.Int {
 	var threshold math.Int
 	if direction == PACKET_RECV {
 		threshold = totalValue.Mul(q.MaxPercentRecv).Quo(math.NewInt(100))
@@ -22,5 +17,59 @@ func (q *Quota) CheckExceedsQuota(direction PacketDirection, amount, totalValue,
 		threshold = minRateLimitAmount
 	}
 
-	return amount.GT(threshold)
+	return threshold
+}
+
+// CheckExceedsQuota checks if new in/out flow is going to reach the max in/out or not
+func (q *Quota) CheckExceedsQuota(direction PacketDirection, amount, totalValue, minRateLimitAmount math.Int) bool {
+	// If there's no channel value (this should be almost impossible), it means there is no
+	// supply of the asset, so we shoudn't prevent inflows/outflows
+	if totalValue.IsZero() {
+		return false
+	}
+
+	return amount.GT(q.GetThreshold(direction, totalValue, minRateLimitAmount))
+}
+
+// HasAddressQuota returns true if the flow of each sender/receiver is limited on top of the path quota
+func (q *Quota) HasAddressQuota() bool {
+	return isPositive(q.MaxPercentPerAddress) || isPositive(q.MaxAmountPerAddress)
+}
+
+// GetAddressThreshold returns the max amount that a single address can transfer in the given
+// direction within a window. If both a share of the path quota and an absolute amount are set,
+// the stricter one applies. Returns false if the address flow is not limited
+func (q *Quota) GetAddressThreshold(direction PacketDirection, totalValue, minRateLimitAmount math.Int) (threshold math.Int, limited bool) {
+	// The share of the path quota is only meaningful if the path itself is limited
+	if isPositive(q.MaxPercentPerAddress) && !totalValue.IsZero() {
+		threshold = q.GetThreshold(direction, totalValue, minRateLimitAmount).Mul(q.MaxPercentPerAddress).Quo(math.NewInt(100))
+		limited = true
+	}
+
+	if isPositive(q.MaxAmountPerAddress) && (!limited || q.MaxAmountPerAddress.LT(threshold)) {
+		threshold = q.MaxAmountPerAddress
+		limited = true
+	}
+
+	return threshold, limited
+}
+
+// CheckExceedsAddressQuota checks if the in/out flow of a single address is going to reach its max or not
+func (q *Quota) CheckExceedsAddressQuota(direction PacketDirection, amount, totalValue, minRateLimitAmount math.Int) bool {
+	threshold, limited := q.GetAddressThreshold(direction, totalValue, minRateLimitAmount)
+	return limited && amount.GT(threshold)
+}
+
+// Returns the identifier of the epoch that drives the quota window,
+// quotas created before epochs were configurable are driven by the hour epoch
+func (q Quota) EpochIdentifierOrDefault() string {
+	if q.EpochIdentifier == "" {
+		return HourEpoch
+	}
+	return q.EpochIdentifier
+}
+
+// quotas stored before per-address limits were introduced have nil amounts
+func isPositive(amount math.Int) bool {
+	return !amount.IsNil() && amount.IsPositive()
 }
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/notional-labs/composable/v6/x/ratelimit/types"
+)
+
+func TestGetAddressThreshold(t *testing.T) {
+	var (
+		channelValue = math.NewInt(10_000)
+		minRateLimit = math.NewInt(100)
+	)
+	testCases := map[string]struct {
+		quota types.Quota
+
+		expLimited   bool
+		expThreshold math.Int
+	}{
+		"no address quota": {
+			quota: types.Quota{MaxPercentSend: math.NewInt(10), MaxPercentRecv: math.NewInt(10)},
+		},
+		"share of the path quota": {
+			quota:        types.Quota{MaxPercentSend: math.NewInt(10), MaxPercentRecv: math.NewInt(10), MaxPercentPerAddress: math.NewInt(25)},
+			expLimited:   true,
+			expThreshold: math.NewInt(250),
+		},
+		"absolute amount": {
+			quota:        types.Quota{MaxPercentSend: math.NewInt(10), MaxPercentRecv: math.NewInt(10), MaxAmountPerAddress: math.NewInt(300)},
+			expLimited:   true,
+			expThreshold: math.NewInt(300),
+		},
+		"stricter of both": {
+			quota:        types.Quota{MaxPercentSend: math.NewInt(10), MaxPercentRecv: math.NewInt(10), MaxPercentPerAddress: math.NewInt(25), MaxAmountPerAddress: math.NewInt(200)},
+			expLimited:   true,
+			expThreshold: math.NewInt(200),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expLimited, tc.quota.HasAddressQuota())
+
+			threshold, limited := tc.quota.GetAddressThreshold(types.PACKET_SEND, channelValue, minRateLimit)
+			require.Equal(t, tc.expLimited, limited)
+			if tc.expLimited {
+				require.Equal(t, tc.expThreshold, threshold)
+				require.False(t, tc.quota.CheckExceedsAddressQuota(types.PACKET_SEND, threshold, channelValue, minRateLimit))
+				require.True(t, tc.quota.CheckExceedsAddressQuota(types.PACKET_SEND, threshold.AddRaw(1), channelValue, minRateLimit))
+			}
+		})
+	}
+}
@@ -104,7 +104,18 @@ func (m *Path) GetChannelID() string {
 type Quota struct {
 	MaxPercentSend github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_percent_send,json=maxPercentSend,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_percent_send"`
 	MaxPercentRecv github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_percent_recv,json=maxPercentRecv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_percent_recv"`
-	DurationHours  uint64                                 `protobuf:"varint,3,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	// number of epochs of epoch_identifier after which the flow is reset.
+	// Kept as duration_hours because the default epoch ticks every hour.
+	DurationHours uint64 `protobuf:"varint,3,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	// identifier of the x/ratelimit epoch that drives the quota window, defaults
+	// to the "hour" epoch when empty.
+	EpochIdentifier string `protobuf:"bytes,4,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// max share (in percent) of the path quota that a single sender (on send) or
+	// receiver (on recv) can use within a window, zero disables the limit.
+	MaxPercentPerAddress github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=max_percent_per_address,json=maxPercentPerAddress,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_percent_per_address"`
+	// max amount that a single sender (on send) or receiver (on recv) can
+	// transfer within a window, zero disables the limit.
+	MaxAmountPerAddress github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=max_amount_per_address,json=maxAmountPerAddress,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount_per_address"`
 }
 
 func (m *Quota) Reset()         { *m = Quota{} }
@@ -147,10 +158,20 @@ func (m *Quota) GetDurationHours() uint64 {
 	return 0
 }
 
+func (m *Quota) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
 type Flow struct {
 	Inflow       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow"`
 	Outflow      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow"`
 	ChannelValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=channel_value,json=channelValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"channel_value"`
+	// identifies the quota window of the flow, incremented each time the flow
+	// is reset.
+	WindowID uint64 `protobuf:"varint,4,opt,name=window_id,json=windowId,proto3" json:"window_id,omitempty"`
 }
 
 func (m *Flow) Reset()         { *m = Flow{} }
@@ -186,6 +207,13 @@ func (m *Flow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Flow proto.InternalMessageInfo
 
+func (m *Flow) GetWindowID() uint64 {
+	if m != nil {
+		return m.WindowID
+	}
+	return 0
+}
+
 type RateLimit struct {
 	Path               *Path                                  `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	Quota              *Quota                                 `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
@@ -299,6 +327,157 @@ func (m *WhitelistedAddressPair) GetReceiver() string {
 	return ""
 }
 
+// AddressFlow tracks the amount transferred by a single address on a path
+// within the current window.
+type AddressFlow struct {
+	Denom     string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelID string                                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Direction PacketDirection                        `protobuf:"varint,3,opt,name=direction,proto3,enum=composable.ratelimit.v1beta1.PacketDirection" json:"direction,omitempty"`
+	Address   string                                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *AddressFlow) Reset()         { *m = AddressFlow{} }
+func (m *AddressFlow) String() string { return proto.CompactTextString(m) }
+func (*AddressFlow) ProtoMessage()    {}
+func (*AddressFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0232bb247554c4df, []int{5}
+}
+func (m *AddressFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressFlow.Merge(m, src)
+}
+func (m *AddressFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressFlow proto.InternalMessageInfo
+
+func (m *AddressFlow) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AddressFlow) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *AddressFlow) GetDirection() PacketDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return PACKET_SEND
+}
+
+func (m *AddressFlow) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// PendingSendPacket is a packet sent on a rate limited path that has not been
+// acknowledged or timed out yet.
+type PendingSendPacket struct {
+	ChannelID string                                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom     string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Sequence  uint64                                 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Sender    string                                 `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// window of the path flow the amount was added to.
+	WindowID uint64 `protobuf:"varint,6,opt,name=window_id,json=windowId,proto3" json:"window_id,omitempty"`
+}
+
+func (m *PendingSendPacket) Reset()         { *m = PendingSendPacket{} }
+func (m *PendingSendPacket) String() string { return proto.CompactTextString(m) }
+func (*PendingSendPacket) ProtoMessage()    {}
+func (*PendingSendPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0232bb247554c4df, []int{6}
+}
+func (m *PendingSendPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSendPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSendPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSendPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSendPacket.Merge(m, src)
+}
+func (m *PendingSendPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSendPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSendPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSendPacket proto.InternalMessageInfo
+
+func (m *PendingSendPacket) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *PendingSendPacket) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PendingSendPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingSendPacket) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingSendPacket) GetWindowID() uint64 {
+	if m != nil {
+		return m.WindowID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("composable.ratelimit.v1beta1.PacketDirection", PacketDirection_name, PacketDirection_value)
 	proto.RegisterType((*Path)(nil), "composable.ratelimit.v1beta1.Path")
@@ -306,6 +485,8 @@ func init() {
 	proto.RegisterType((*Flow)(nil), "composable.ratelimit.v1beta1.Flow")
 	proto.RegisterType((*RateLimit)(nil), "composable.ratelimit.v1beta1.RateLimit")
 	proto.RegisterType((*WhitelistedAddressPair)(nil), "composable.ratelimit.v1beta1.WhitelistedAddressPair")
+	proto.RegisterType((*AddressFlow)(nil), "composable.ratelimit.v1beta1.AddressFlow")
+	proto.RegisterType((*PendingSendPacket)(nil), "composable.ratelimit.v1beta1.PendingSendPacket")
 }
 
 func init() {
@@ -313,42 +494,54 @@ func init() {
 }
 
 var fileDescriptor_0232bb247554c4df = []byte{
-	// 556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x4e, 0xb6, 0xb4, 0x50, 0x97, 0x6e, 0xc5, 0x1a, 0x53, 0x55, 0xa1, 0x74, 0x0a, 0x02, 0x4d,
-	0x30, 0x52, 0x6d, 0x48, 0x48, 0x3b, 0xf6, 0xd7, 0xb4, 0xc2, 0x84, 0x4a, 0x8a, 0x06, 0xe2, 0x12,
-	0xb9, 0x89, 0x69, 0xac, 0x25, 0x76, 0x71, 0x9c, 0xae, 0xfc, 0x07, 0x1c, 0xb9, 0x72, 0xe6, 0x9f,
-	0xd9, 0x71, 0x47, 0xc4, 0xa1, 0x42, 0xed, 0x99, 0xff, 0x01, 0xd9, 0x49, 0xd7, 0x89, 0xc3, 0x04,
-	0xe5, 0x94, 0xbc, 0xe7, 0xef, 0x7d, 0xf6, 0xf7, 0xde, 0x67, 0x83, 0x3d, 0x8f, 0x45, 0x23, 0x16,
-	0xa3, 0x41, 0x88, 0xeb, 0x1c, 0x09, 0x1c, 0x92, 0x88, 0x88, 0xfa, 0x78, 0x7f, 0x80, 0x05, 0xda,
-	0x5f, 0x66, 0xec, 0x11, 0x67, 0x82, 0xc1, 0xfb, 0x4b, 0xb4, 0xbd, 0x5c, 0xcb, 0xd0, 0xd5, 0xad,
-	0x21, 0x1b, 0x32, 0x05, 0xac, 0xcb, 0xbf, 0xb4, 0xc6, 0x7a, 0x01, 0x8c, 0x1e, 0x12, 0x01, 0xdc,
-	0x02, 0x39, 0x1f, 0x53, 0x16, 0x55, 0xf4, 0x1d, 0x7d, 0xb7, 0xe0, 0xa4, 0x01, 0xdc, 0x03, 0xc0,
-	0x0b, 0x10, 0xa5, 0x38, 0x74, 0x89, 0x5f, 0x59, 0x93, 0x4b, 0xcd, 0xd2, 0x6c, 0x5a, 0x2b, 0xb4,
-	0xd2, 0x6c, 0xb7, 0xed, 0x14, 0x32, 0x40, 0xd7, 0xb7, 0x66, 0x3a, 0xc8, 0xbd, 0x4e, 0x98, 0x40,
-	0xf0, 0x1d, 0x28, 0x47, 0x68, 0xe2, 0x8e, 0x30, 0xf7, 0x30, 0x15, 0x6e, 0x8c, 0xa9, 0x9f, 0x12,
-	0x37, 0xed, 0x8b, 0x69, 0x4d, 0xfb, 0x31, 0xad, 0x3d, 0x1a, 0x12, 0x11, 0x24, 0x03, 0xdb, 0x63,
-	0x51, 0xdd, 0x63, 0x71, 0xc4, 0xe2, 0xec, 0xf3, 0x34, 0xf6, 0xcf, 0xea, 0xe2, 0xd3, 0x08, 0xc7,
-	0x76, 0x97, 0x0a, 0x67, 0x23, 0x42, 0x93, 0x5e, 0x4a, 0xd3, 0xc7, 0xd4, 0xff, 0x93, 0x99, 0x63,
-	0x6f, 0x9c, 0x9d, 0xeb, 0x3f, 0x98, 0x1d, 0xec, 0x8d, 0xe1, 0x43, 0xb0, 0xe1, 0x27, 0x1c, 0x09,
-	0xc2, 0xa8, 0x1b, 0xb0, 0x84, 0xc7, 0x95, 0xf5, 0x1d, 0x7d, 0xd7, 0x70, 0x4a, 0x8b, 0xec, 0xb1,
-	0x4c, 0x5a, 0xbf, 0x74, 0x60, 0x1c, 0x85, 0xec, 0x1c, 0x1e, 0x81, 0x3c, 0xa1, 0x1f, 0x42, 0x76,
-	0xbe, 0xa2, 0xb2, 0xac, 0x1a, 0x1e, 0x83, 0x5b, 0x2c, 0x11, 0x8a, 0x68, 0x35, 0x21, 0x8b, 0x72,
-	0xd8, 0x07, 0xa5, 0xc5, 0xb4, 0xc6, 0x28, 0x4c, 0xb0, 0x12, 0xf0, 0xef, 0x7c, 0x77, 0x32, 0x92,
-	0x53, 0xc9, 0x61, 0x7d, 0x5d, 0x03, 0x05, 0x07, 0x09, 0x7c, 0x22, 0xcd, 0x04, 0x9f, 0x03, 0x63,
-	0x84, 0x44, 0xa0, 0x24, 0x17, 0x0f, 0x2c, 0xfb, 0x26, 0xc7, 0xd9, 0xd2, 0x58, 0x8e, 0xc2, 0xc3,
-	0x43, 0x90, 0xfb, 0x28, 0x9d, 0xa1, 0x24, 0x16, 0x0f, 0x1e, 0xdc, 0x5c, 0xa8, 0x4c, 0xe4, 0xa4,
-	0x15, 0x72, 0x4b, 0xd5, 0x9c, 0xf5, 0xbf, 0xd9, 0x52, 0x4e, 0xc6, 0x51, 0x78, 0x88, 0xc0, 0xbd,
-	0x88, 0x50, 0x57, 0x62, 0x5c, 0x05, 0x72, 0x51, 0xc4, 0x12, 0x2a, 0x2a, 0xc6, 0x4a, 0x5d, 0x81,
-	0x11, 0xa1, 0x57, 0x7d, 0x68, 0x28, 0x26, 0xeb, 0x04, 0x6c, 0xbf, 0x0d, 0x88, 0x3c, 0x43, 0x2c,
-	0xb0, 0xdf, 0xf0, 0x7d, 0x8e, 0xe3, 0xb8, 0x87, 0x08, 0x87, 0xdb, 0x20, 0x2f, 0x4d, 0x8f, 0x79,
-	0x76, 0x9f, 0xb2, 0x08, 0x56, 0xc1, 0x6d, 0x8e, 0x3d, 0x4c, 0xc6, 0x98, 0xa7, 0xd3, 0x76, 0xae,
-	0xe2, 0xc7, 0x87, 0x60, 0xb3, 0x87, 0xbc, 0x33, 0x2c, 0xda, 0x84, 0x63, 0x4f, 0x3a, 0x0e, 0x6e,
-	0x82, 0x62, 0xaf, 0xd1, 0x7a, 0xd9, 0x79, 0xe3, 0xf6, 0x3b, 0xaf, 0xda, 0x65, 0xed, 0x5a, 0xc2,
-	0xe9, 0xb4, 0x4e, 0xcb, 0x7a, 0xd5, 0xf8, 0xfc, 0xcd, 0xd4, 0x9a, 0x4f, 0x2e, 0x66, 0xa6, 0x7e,
-	0x39, 0x33, 0xf5, 0x9f, 0x33, 0x53, 0xff, 0x32, 0x37, 0xb5, 0xcb, 0xb9, 0xa9, 0x7d, 0x9f, 0x9b,
-	0xda, 0xfb, 0xbb, 0x93, 0x6b, 0x0f, 0x87, 0x52, 0x33, 0xc8, 0xab, 0x9b, 0xff, 0xec, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x60, 0xf4, 0xde, 0x6b, 0x5d, 0x04, 0x00, 0x00,
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x4f, 0x02, 0x47,
+	0x14, 0x66, 0x61, 0x41, 0x18, 0xfc, 0x81, 0x53, 0x6b, 0x37, 0xa4, 0x01, 0xb3, 0x4d, 0x1b, 0x6d,
+	0x15, 0xa2, 0x4d, 0x9a, 0x78, 0x14, 0xd1, 0x48, 0x35, 0x0d, 0x5d, 0x1b, 0x6d, 0x7a, 0xd9, 0x0c,
+	0xbb, 0x4f, 0x98, 0xc8, 0xce, 0xe0, 0xee, 0x2c, 0xd0, 0x5b, 0x8f, 0x3d, 0xf6, 0xd8, 0x9e, 0xfb,
+	0xcf, 0x78, 0xf4, 0xd8, 0xf6, 0x40, 0x1a, 0xfc, 0x3f, 0x9a, 0x66, 0x66, 0x97, 0x1f, 0x72, 0xd0,
+	0x86, 0x7a, 0x82, 0x79, 0xf3, 0xde, 0xf7, 0xde, 0x7c, 0xef, 0x7d, 0x6f, 0xd1, 0xbe, 0xc3, 0xbd,
+	0x1e, 0x0f, 0x48, 0xab, 0x0b, 0x55, 0x9f, 0x08, 0xe8, 0x52, 0x8f, 0x8a, 0x6a, 0xff, 0xb0, 0x05,
+	0x82, 0x1c, 0xce, 0x2c, 0x95, 0x9e, 0xcf, 0x05, 0xc7, 0x1f, 0xcf, 0xbc, 0x2b, 0xb3, 0xbb, 0xd8,
+	0xbb, 0xb8, 0xd5, 0xe6, 0x6d, 0xae, 0x1c, 0xab, 0xf2, 0x5f, 0x14, 0x63, 0x7e, 0x8d, 0xf4, 0x26,
+	0x11, 0x1d, 0xbc, 0x85, 0xd2, 0x2e, 0x30, 0xee, 0x19, 0xda, 0x8e, 0xb6, 0x9b, 0xb3, 0xa2, 0x03,
+	0xde, 0x47, 0xc8, 0xe9, 0x10, 0xc6, 0xa0, 0x6b, 0x53, 0xd7, 0x48, 0xca, 0xab, 0xda, 0xda, 0x78,
+	0x54, 0xce, 0x9d, 0x46, 0xd6, 0x46, 0xdd, 0xca, 0xc5, 0x0e, 0x0d, 0xd7, 0xfc, 0x33, 0x85, 0xd2,
+	0xdf, 0x86, 0x5c, 0x10, 0xfc, 0x3d, 0x2a, 0x78, 0x64, 0x68, 0xf7, 0xc0, 0x77, 0x80, 0x09, 0x3b,
+	0x00, 0xe6, 0x46, 0xc0, 0xb5, 0xca, 0xe3, 0xa8, 0x9c, 0xf8, 0x6b, 0x54, 0xfe, 0xac, 0x4d, 0x45,
+	0x27, 0x6c, 0x55, 0x1c, 0xee, 0x55, 0x1d, 0x1e, 0x78, 0x3c, 0x88, 0x7f, 0x0e, 0x02, 0xf7, 0xbe,
+	0x2a, 0x7e, 0xec, 0x41, 0x50, 0x69, 0x30, 0x61, 0xad, 0x7b, 0x64, 0xd8, 0x8c, 0x60, 0xae, 0x81,
+	0xb9, 0x8b, 0xc8, 0x3e, 0x38, 0x7d, 0x23, 0xf9, 0x7f, 0x91, 0x2d, 0x70, 0xfa, 0xf8, 0x53, 0xb4,
+	0xee, 0x86, 0x3e, 0x11, 0x94, 0x33, 0xbb, 0xc3, 0x43, 0x3f, 0x30, 0x52, 0x3b, 0xda, 0xae, 0x6e,
+	0xad, 0x4d, 0xac, 0x17, 0xd2, 0x88, 0xf7, 0x50, 0x01, 0x7a, 0xdc, 0xe9, 0xd8, 0xd4, 0x05, 0x26,
+	0xe8, 0x1d, 0x05, 0xdf, 0xd0, 0x15, 0x67, 0x1b, 0xca, 0xde, 0x98, 0x9a, 0x31, 0xa0, 0x8f, 0xe6,
+	0x6b, 0xed, 0x81, 0x6f, 0x13, 0xd7, 0xf5, 0x21, 0x08, 0x8c, 0xf4, 0x52, 0x25, 0x6f, 0xcd, 0x4a,
+	0x6e, 0x82, 0x7f, 0x12, 0x61, 0x61, 0x07, 0x6d, 0xcb, 0x34, 0xc4, 0xe3, 0xe1, 0x42, 0x96, 0xcc,
+	0x52, 0x59, 0x3e, 0xf0, 0xc8, 0xf0, 0x44, 0x81, 0xcd, 0x92, 0x98, 0xbf, 0x26, 0x91, 0x7e, 0xde,
+	0xe5, 0x03, 0x7c, 0x8e, 0x32, 0x94, 0xdd, 0x75, 0xf9, 0x60, 0xc9, 0x86, 0xc6, 0xd1, 0xf8, 0x02,
+	0xad, 0xf0, 0x50, 0x28, 0xa0, 0xe5, 0xfa, 0x37, 0x09, 0xc7, 0xd7, 0x68, 0x6d, 0x32, 0xa4, 0x7d,
+	0xd2, 0x0d, 0xc1, 0x48, 0x2d, 0x85, 0xb7, 0x1a, 0x83, 0xdc, 0x48, 0x0c, 0xbc, 0x87, 0x72, 0x03,
+	0xca, 0x5c, 0x3e, 0x90, 0x83, 0x2f, 0xfb, 0xab, 0xd7, 0x56, 0xc7, 0xa3, 0x72, 0xf6, 0x56, 0x19,
+	0x1b, 0x75, 0x2b, 0x1b, 0x5d, 0x37, 0x5c, 0xf3, 0xb7, 0x24, 0xca, 0x59, 0x44, 0xc0, 0x95, 0x94,
+	0x1b, 0xfe, 0x0a, 0xe9, 0x3d, 0x22, 0x3a, 0x8a, 0x9d, 0xfc, 0x91, 0x59, 0x79, 0x4d, 0x93, 0x15,
+	0x29, 0x3d, 0x4b, 0xf9, 0xe3, 0x63, 0x94, 0x7e, 0x90, 0xda, 0x51, 0x6c, 0xe4, 0x8f, 0x3e, 0x79,
+	0x3d, 0x50, 0xc9, 0xcc, 0x8a, 0x22, 0x64, 0x4a, 0xc5, 0x63, 0xea, 0xbf, 0xa4, 0x94, 0x4d, 0xb4,
+	0x94, 0x3f, 0x26, 0xe8, 0x43, 0x8f, 0x32, 0x5b, 0xfa, 0xd8, 0xca, 0x29, 0x9e, 0x21, 0x43, 0x5f,
+	0x8a, 0x40, 0xec, 0x51, 0x36, 0xe5, 0x21, 0x1a, 0x20, 0xf3, 0x0a, 0x6d, 0xdf, 0x76, 0xa8, 0xac,
+	0x21, 0x10, 0xe0, 0xc6, 0xc3, 0xd4, 0x24, 0xd4, 0xc7, 0xdb, 0x28, 0x23, 0xd7, 0x02, 0xf8, 0xf1,
+	0xc6, 0x89, 0x4f, 0xb8, 0x88, 0xb2, 0x3e, 0x38, 0x40, 0xfb, 0xe0, 0x47, 0x83, 0x61, 0x4d, 0xcf,
+	0xe6, 0x4f, 0x49, 0x94, 0x8f, 0x31, 0xd4, 0x2c, 0xbe, 0xc3, 0xd2, 0xc2, 0x97, 0x28, 0xe7, 0x52,
+	0x1f, 0x1c, 0xa9, 0x70, 0xc5, 0xe0, 0xfa, 0xd1, 0xc1, 0x5b, 0x4d, 0x73, 0xee, 0x41, 0xd4, 0x27,
+	0x41, 0xd6, 0x2c, 0x1e, 0x1b, 0x68, 0x65, 0xa2, 0xbd, 0x68, 0x27, 0x4c, 0x8e, 0x52, 0x36, 0x31,
+	0xb9, 0xcb, 0x49, 0x3f, 0x8e, 0x36, 0xff, 0xd1, 0xd0, 0x66, 0x13, 0x98, 0x4b, 0x59, 0x5b, 0xee,
+	0xc3, 0xa8, 0x96, 0x85, 0x27, 0x6b, 0x6f, 0x3c, 0x79, 0x4a, 0x5b, 0x72, 0x9e, 0xb6, 0x22, 0xca,
+	0x06, 0xf0, 0x10, 0x02, 0x73, 0x20, 0xde, 0x7c, 0xd3, 0xf3, 0x5c, 0xb3, 0xf4, 0x17, 0xcd, 0x7a,
+	0xa7, 0x57, 0xbd, 0x54, 0x5b, 0xe6, 0x35, 0xb5, 0x7d, 0x7e, 0x8c, 0x36, 0x16, 0x1a, 0x80, 0x37,
+	0x50, 0xbe, 0x79, 0x72, 0x7a, 0x79, 0xf6, 0x9d, 0x7d, 0x7d, 0xf6, 0x4d, 0xbd, 0x90, 0x98, 0x33,
+	0x58, 0x67, 0xa7, 0x37, 0x05, 0xad, 0xa8, 0xff, 0xfc, 0x7b, 0x29, 0x51, 0xfb, 0xe2, 0x71, 0x5c,
+	0xd2, 0x9e, 0xc6, 0x25, 0xed, 0xef, 0x71, 0x49, 0xfb, 0xe5, 0xb9, 0x94, 0x78, 0x7a, 0x2e, 0x25,
+	0xfe, 0x78, 0x2e, 0x25, 0x7e, 0xd8, 0x1c, 0xce, 0x7d, 0x5e, 0x55, 0x79, 0xad, 0x8c, 0xfa, 0x3e,
+	0x7e, 0xf9, 0xef, 0x00, 0xb4, 0x0c, 0x05, 0x2b, 0x83, 0x07, 0x00, 0x00,
 }
 
 func (m *Path) Marshal() (dAtA []byte, err error) {
@@ -408,6 +601,33 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxAmountPerAddress.Size()
+		i -= size
+		if _, err := m.MaxAmountPerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxPercentPerAddress.Size()
+		i -= size
+		if _, err := m.MaxPercentPerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.DurationHours != 0 {
 		i = encodeVarintRatelimit(dAtA, i, uint64(m.DurationHours))
 		i--
@@ -456,6 +676,11 @@ func (m *Flow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WindowID != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.WindowID))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.ChannelValue.Size()
 		i -= size
@@ -595,6 +820,129 @@ func (m *WhitelistedAddressPair) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *AddressFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Direction != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSendPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSendPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSendPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowID != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.WindowID))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRatelimit(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRatelimit(v)
 	base := offset
@@ -636,6 +984,14 @@ func (m *Quota) Size() (n int) {
 	if m.DurationHours != 0 {
 		n += 1 + sovRatelimit(uint64(m.DurationHours))
 	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = m.MaxPercentPerAddress.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	l = m.MaxAmountPerAddress.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
 	return n
 }
 
@@ -651,6 +1007,9 @@ func (m *Flow) Size() (n int) {
 	n += 1 + l + sovRatelimit(uint64(l))
 	l = m.ChannelValue.Size()
 	n += 1 + l + sovRatelimit(uint64(l))
+	if m.WindowID != 0 {
+		n += 1 + sovRatelimit(uint64(m.WindowID))
+	}
 	return n
 }
 
@@ -694,21 +1053,76 @@ func (m *WhitelistedAddressPair) Size() (n int) {
 	return n
 }
 
-func sovRatelimit(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRatelimit(x uint64) (n int) {
-	return sovRatelimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *AddressFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovRatelimit(uint64(m.Direction))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	return n
 }
-func (m *Path) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRatelimit
+
+func (m *PendingSendPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovRatelimit(uint64(m.Sequence))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	if m.WindowID != 0 {
+		n += 1 + sovRatelimit(uint64(m.WindowID))
+	}
+	return n
+}
+
+func sovRatelimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRatelimit(x uint64) (n int) {
+	return sovRatelimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Path) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -930,6 +1344,106 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentPerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentPerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountPerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountPerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRatelimit(dAtA[iNdEx:])
@@ -1082,6 +1596,25 @@ func (m *Flow) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowID", wireType)
+			}
+			m.WindowID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRatelimit(dAtA[iNdEx:])
@@ -1409,6 +1942,423 @@ func (m *WhitelistedAddressPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AddressFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= PacketDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingSendPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSendPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSendPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowID", wireType)
+			}
+			m.WindowID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRatelimit(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
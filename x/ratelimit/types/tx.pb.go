@@ -11,18 +11,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -46,6 +51,15 @@ type MsgAddRateLimit struct {
 	DurationHours uint64 `protobuf:"varint,6,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
 	// Min amount of rate limit (allow transfer max(min-amout, rate-limit))
 	MinRateLimitAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=min_rate_limit_amount,json=minRateLimitAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_rate_limit_amount"`
+	// Epoch that drives the rate limit window, duration_hours is then counted in
+	// epochs of this identifier. Defaults to the "hour" epoch.
+	EpochIdentifier string `protobuf:"bytes,8,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// Max share (in percent) of the path quota usable by a single address, zero
+	// disables the limit
+	MaxPercentPerAddress github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_percent_per_address,json=maxPercentPerAddress,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_percent_per_address"`
+	// Max amount transferable by a single address within a window, zero
+	// disables the limit
+	MaxAmountPerAddress github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=max_amount_per_address,json=maxAmountPerAddress,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount_per_address"`
 }
 
 func (m *MsgAddRateLimit) Reset()         { *m = MsgAddRateLimit{} }
@@ -109,6 +123,13 @@ func (m *MsgAddRateLimit) GetDurationHours() uint64 {
 	return 0
 }
 
+func (m *MsgAddRateLimit) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
 type MsgAddRateLimitResponse struct {
 }
 
@@ -155,6 +176,13 @@ type MsgUpdateRateLimit struct {
 	MaxPercentRecv     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=max_percent_recv,json=maxPercentRecv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_percent_recv"`
 	MinRateLimitAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_rate_limit_amount,json=minRateLimitAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_rate_limit_amount"`
 	DurationHours      uint64                                 `protobuf:"varint,7,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	EpochIdentifier    string                                 `protobuf:"bytes,8,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// Max share (in percent) of the path quota usable by a single address, zero
+	// disables the limit
+	MaxPercentPerAddress github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_percent_per_address,json=maxPercentPerAddress,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_percent_per_address"`
+	// Max amount transferable by a single address within a window, zero
+	// disables the limit
+	MaxAmountPerAddress github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=max_amount_per_address,json=maxAmountPerAddress,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount_per_address"`
 }
 
 func (m *MsgUpdateRateLimit) Reset()         { *m = MsgUpdateRateLimit{} }
@@ -218,6 +246,13 @@ func (m *MsgUpdateRateLimit) GetDurationHours() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateRateLimit) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
 type MsgUpdateRateLimitResponse struct {
 }
 
@@ -450,745 +485,3668 @@ func (m *MsgResetRateLimitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgResetRateLimitResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAddRateLimit)(nil), "composable.ratelimit.v1beta1.MsgAddRateLimit")
-	proto.RegisterType((*MsgAddRateLimitResponse)(nil), "composable.ratelimit.v1beta1.MsgAddRateLimitResponse")
-	proto.RegisterType((*MsgUpdateRateLimit)(nil), "composable.ratelimit.v1beta1.MsgUpdateRateLimit")
-	proto.RegisterType((*MsgUpdateRateLimitResponse)(nil), "composable.ratelimit.v1beta1.MsgUpdateRateLimitResponse")
-	proto.RegisterType((*MsgRemoveRateLimit)(nil), "composable.ratelimit.v1beta1.MsgRemoveRateLimit")
-	proto.RegisterType((*MsgRemoveRateLimitResponse)(nil), "composable.ratelimit.v1beta1.MsgRemoveRateLimitResponse")
-	proto.RegisterType((*MsgResetRateLimit)(nil), "composable.ratelimit.v1beta1.MsgResetRateLimit")
-	proto.RegisterType((*MsgResetRateLimitResponse)(nil), "composable.ratelimit.v1beta1.MsgResetRateLimitResponse")
+type MsgAddEpoch struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// identifier is a unique reference to the epoch.
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// start_time is the time at which the epoch first ticks, defaults to the
+	// block time when unset.
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// duration is the time in between epoch ticks.
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
 }
 
-func init() {
-	proto.RegisterFile("composable/ratelimit/v1beta1/tx.proto", fileDescriptor_7c4a582edd75a41c)
+func (m *MsgAddEpoch) Reset()         { *m = MsgAddEpoch{} }
+func (m *MsgAddEpoch) String() string { return proto.CompactTextString(m) }
+func (*MsgAddEpoch) ProtoMessage()    {}
+func (*MsgAddEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{8}
 }
-
-var fileDescriptor_7c4a582edd75a41c = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0xb3, 0xbf, 0xa4, 0x29, 0x19, 0x68, 0x7e, 0xed, 0x10, 0xcd, 0x76, 0x2d, 0x9b, 0x12,
-	0xa8, 0x14, 0xb5, 0xbb, 0xa6, 0x22, 0x4a, 0x6f, 0xa9, 0x1e, 0x0c, 0x18, 0x90, 0x55, 0x41, 0xbc,
-	0x2c, 0x93, 0x9d, 0x71, 0xb3, 0x98, 0x99, 0x59, 0x76, 0x26, 0x21, 0x01, 0x4f, 0x05, 0xef, 0x1e,
-	0x05, 0x7d, 0x11, 0xbe, 0x8c, 0x1e, 0x7b, 0x53, 0x7a, 0x08, 0x92, 0x1c, 0xbc, 0xfb, 0x0a, 0x64,
-	0x77, 0xf3, 0xa7, 0x66, 0x1b, 0x6d, 0xa2, 0xa0, 0x07, 0x4f, 0x93, 0xcc, 0xf3, 0x7d, 0xbe, 0x7c,
-	0x87, 0xcf, 0xb3, 0xb3, 0x0b, 0x76, 0x1c, 0x4e, 0x7d, 0x2e, 0x50, 0xa3, 0x45, 0xcc, 0x00, 0x49,
-	0xd2, 0xf2, 0xa8, 0x27, 0xcd, 0x4e, 0xa5, 0x41, 0x24, 0xaa, 0x98, 0xb2, 0x6b, 0xf8, 0x01, 0x97,
-	0x1c, 0x6e, 0x4d, 0x65, 0xc6, 0x44, 0x66, 0x8c, 0x64, 0x5a, 0xc1, 0xe5, 0x2e, 0x8f, 0x84, 0x66,
-	0xf8, 0x2b, 0xee, 0xd1, 0x8a, 0x0e, 0x17, 0x94, 0x0b, 0x93, 0x0a, 0xd7, 0xec, 0x54, 0xc2, 0x25,
-	0x2e, 0x94, 0x3f, 0xa6, 0xc1, 0xff, 0x75, 0xe1, 0x56, 0x31, 0xb6, 0x90, 0x24, 0x0f, 0x43, 0x2b,
-	0xb8, 0x0f, 0x72, 0xa8, 0x2d, 0x9b, 0x3c, 0xf0, 0x64, 0x4f, 0x55, 0xb6, 0x95, 0xdd, 0xdc, 0x61,
-	0xe1, 0x6b, 0xbf, 0xb4, 0xde, 0x43, 0xb4, 0x75, 0x50, 0x9e, 0x94, 0xca, 0xd6, 0x54, 0x06, 0x0b,
-	0x60, 0x05, 0x13, 0xc6, 0xa9, 0xfa, 0x5f, 0xa8, 0xb7, 0xe2, 0x3f, 0xf0, 0x06, 0x00, 0x4e, 0x13,
-	0x31, 0x46, 0x5a, 0xb6, 0x87, 0xd5, 0x74, 0x64, 0xb5, 0x36, 0xe8, 0x97, 0x72, 0xf7, 0xe2, 0xdd,
-	0xda, 0x7d, 0x2b, 0x37, 0x12, 0xd4, 0x30, 0x7c, 0x06, 0xd6, 0x29, 0xea, 0xda, 0x3e, 0x09, 0x1c,
-	0xc2, 0xa4, 0x2d, 0x08, 0xc3, 0x6a, 0x26, 0xea, 0x31, 0x8e, 0xfb, 0xa5, 0xd4, 0x69, 0xbf, 0x74,
-	0xd5, 0xf5, 0x64, 0xb3, 0xdd, 0x30, 0x1c, 0x4e, 0xcd, 0xd1, 0x89, 0xe2, 0x65, 0x4f, 0xe0, 0x97,
-	0xa6, 0xec, 0xf9, 0x44, 0x18, 0x35, 0x26, 0xad, 0x3c, 0x45, 0xdd, 0x47, 0xb1, 0xcd, 0x63, 0xc2,
-	0x12, 0xce, 0x01, 0x71, 0x3a, 0xea, 0xca, 0xaf, 0x3a, 0x5b, 0xc4, 0xe9, 0xc0, 0x1d, 0x90, 0xc7,
-	0xed, 0x00, 0x49, 0x8f, 0x33, 0xbb, 0xc9, 0xdb, 0x81, 0x50, 0xb3, 0xdb, 0xca, 0x6e, 0xc6, 0x5a,
-	0x1b, 0xef, 0x3e, 0x08, 0x37, 0x21, 0x02, 0x97, 0xa8, 0xc7, 0xec, 0x10, 0x97, 0x1d, 0xf1, 0xb2,
-	0x11, 0xe5, 0x6d, 0x26, 0xd5, 0xd5, 0xa5, 0x52, 0x40, 0xea, 0xb1, 0x09, 0xaf, 0x6a, 0xe4, 0x74,
-	0x90, 0x3f, 0xfa, 0xf2, 0xe1, 0xda, 0x94, 0x48, 0x79, 0x13, 0x14, 0x67, 0xc0, 0x5a, 0x44, 0xf8,
-	0x9c, 0x09, 0x52, 0x3e, 0x4d, 0x03, 0x58, 0x17, 0xee, 0x53, 0x1f, 0x23, 0x49, 0xfe, 0x71, 0x5f,
-	0x86, 0xfb, 0x5c, 0xa0, 0xd9, 0xdf, 0x05, 0xf4, 0x9c, 0xd1, 0x5a, 0x3d, 0x67, 0xb4, 0x12, 0xdc,
-	0xb7, 0x80, 0x96, 0x64, 0x3b, 0x41, 0xff, 0x5e, 0x89, 0xd0, 0x5b, 0x84, 0xf2, 0xce, 0x9f, 0x47,
-	0x3f, 0x27, 0xfc, 0x4c, 0xba, 0x49, 0xf8, 0x77, 0x0a, 0xd8, 0x88, 0xca, 0x82, 0xc8, 0xbf, 0x2f,
-	0xfb, 0x15, 0xb0, 0x99, 0x08, 0x37, 0x8e, 0xbe, 0xff, 0x36, 0x03, 0xd2, 0x75, 0xe1, 0xc2, 0x57,
-	0xa0, 0x50, 0xc5, 0xf8, 0x49, 0x80, 0x98, 0x78, 0x41, 0x82, 0xe9, 0x21, 0xf6, 0x8c, 0x1f, 0xdd,
-	0xea, 0xc6, 0xcc, 0x93, 0xac, 0xdd, 0x5e, 0x48, 0x3e, 0x4e, 0x01, 0x5f, 0x2b, 0xa0, 0x18, 0x4f,
-	0x46, 0x32, 0xc1, 0xcd, 0x9f, 0x5a, 0xce, 0xcc, 0x94, 0x76, 0x77, 0xd1, 0x8e, 0xef, 0x72, 0xc4,
-	0x90, 0x97, 0xc9, 0x31, 0x33, 0x1e, 0x17, 0xc8, 0x31, 0x67, 0xa0, 0xe0, 0x91, 0x02, 0x2e, 0x47,
-	0xc0, 0x92, 0x31, 0xcc, 0x0b, 0x98, 0x9e, 0x25, 0xad, 0xdd, 0x59, 0xb0, 0x61, 0x1c, 0xe2, 0xf0,
-	0xfa, 0xf1, 0x40, 0x57, 0x4e, 0x06, 0xba, 0xf2, 0x79, 0xa0, 0x2b, 0x6f, 0x86, 0x7a, 0xea, 0x64,
-	0xa8, 0xa7, 0x3e, 0x0d, 0xf5, 0xd4, 0xf3, 0x8d, 0xee, 0x99, 0xef, 0x80, 0xe8, 0xb2, 0x68, 0x64,
-	0xa3, 0xd7, 0xf6, 0xad, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x79, 0x45, 0x81, 0x07, 0x2c, 0x08,
-	0x00, 0x00,
+func (m *MsgAddEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	AddTransferRateLimit(ctx context.Context, in *MsgAddRateLimit, opts ...grpc.CallOption) (*MsgAddRateLimitResponse, error)
-	UpdateTransferRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error)
-	RemoveTransferRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
-	ResetTransferRateLimit(ctx context.Context, in *MsgResetRateLimit, opts ...grpc.CallOption) (*MsgResetRateLimitResponse, error)
+func (m *MsgAddEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgAddEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddEpoch.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAddEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddEpoch.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddTransferRateLimit(ctx context.Context, in *MsgAddRateLimit, opts ...grpc.CallOption) (*MsgAddRateLimitResponse, error) {
-	out := new(MsgAddRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/AddTransferRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAddEpoch proto.InternalMessageInfo
+
+func (m *MsgAddEpoch) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateTransferRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error) {
-	out := new(MsgUpdateRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/UpdateTransferRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddEpoch) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveTransferRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
-	out := new(MsgRemoveRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/RemoveTransferRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddEpoch) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *msgClient) ResetTransferRateLimit(ctx context.Context, in *MsgResetRateLimit, opts ...grpc.CallOption) (*MsgResetRateLimitResponse, error) {
-	out := new(MsgResetRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/ResetTransferRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddEpoch) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AddTransferRateLimit(context.Context, *MsgAddRateLimit) (*MsgAddRateLimitResponse, error)
-	UpdateTransferRateLimit(context.Context, *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error)
-	RemoveTransferRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
-	ResetTransferRateLimit(context.Context, *MsgResetRateLimit) (*MsgResetRateLimitResponse, error)
+type MsgAddEpochResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgAddEpochResponse) Reset()         { *m = MsgAddEpochResponse{} }
+func (m *MsgAddEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddEpochResponse) ProtoMessage()    {}
+func (*MsgAddEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{9}
 }
-
-func (*UnimplementedMsgServer) AddTransferRateLimit(ctx context.Context, req *MsgAddRateLimit) (*MsgAddRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddTransferRateLimit not implemented")
+func (m *MsgAddEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateTransferRateLimit(ctx context.Context, req *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransferRateLimit not implemented")
+func (m *MsgAddEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RemoveTransferRateLimit(ctx context.Context, req *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveTransferRateLimit not implemented")
+func (m *MsgAddEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddEpochResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ResetTransferRateLimit(ctx context.Context, req *MsgResetRateLimit) (*MsgResetRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetTransferRateLimit not implemented")
+func (m *MsgAddEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddEpochResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgAddEpochResponse proto.InternalMessageInfo
+
+type MsgUpdateEpoch struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// duration is the new time in between epoch ticks, it applies from the
+	// current epoch on.
+	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
 }
 
-func _Msg_AddTransferRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddRateLimit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddTransferRateLimit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/composable.ratelimit.v1beta1.Msg/AddTransferRateLimit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddTransferRateLimit(ctx, req.(*MsgAddRateLimit))
+func (m *MsgUpdateEpoch) Reset()         { *m = MsgUpdateEpoch{} }
+func (m *MsgUpdateEpoch) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEpoch) ProtoMessage()    {}
+func (*MsgUpdateEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{10}
+}
+func (m *MsgUpdateEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEpoch.Merge(m, src)
+}
+func (m *MsgUpdateEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEpoch.DiscardUnknown(m)
 }
 
-func _Msg_UpdateTransferRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRateLimit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateTransferRateLimit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/composable.ratelimit.v1beta1.Msg/UpdateTransferRateLimit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateTransferRateLimit(ctx, req.(*MsgUpdateRateLimit))
+var xxx_messageInfo_MsgUpdateEpoch proto.InternalMessageInfo
+
+func (m *MsgUpdateEpoch) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RemoveTransferRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveRateLimit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveTransferRateLimit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/composable.ratelimit.v1beta1.Msg/RemoveTransferRateLimit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveTransferRateLimit(ctx, req.(*MsgRemoveRateLimit))
+func (m *MsgUpdateEpoch) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ResetTransferRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgResetRateLimit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ResetTransferRateLimit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/composable.ratelimit.v1beta1.Msg/ResetTransferRateLimit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ResetTransferRateLimit(ctx, req.(*MsgResetRateLimit))
+func (m *MsgUpdateEpoch) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "composable.ratelimit.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddTransferRateLimit",
-			Handler:    _Msg_AddTransferRateLimit_Handler,
-		},
-		{
-			MethodName: "UpdateTransferRateLimit",
-			Handler:    _Msg_UpdateTransferRateLimit_Handler,
-		},
-		{
-			MethodName: "RemoveTransferRateLimit",
-			Handler:    _Msg_RemoveTransferRateLimit_Handler,
-		},
-		{
-			MethodName: "ResetTransferRateLimit",
-			Handler:    _Msg_ResetTransferRateLimit_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "composable/ratelimit/v1beta1/tx.proto",
+type MsgUpdateEpochResponse struct {
 }
 
-func (m *MsgAddRateLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateEpochResponse) Reset()         { *m = MsgUpdateEpochResponse{} }
+func (m *MsgUpdateEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEpochResponse) ProtoMessage()    {}
+func (*MsgUpdateEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{11}
+}
+func (m *MsgUpdateEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAddRateLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEpochResponse.Merge(m, src)
+}
+func (m *MsgUpdateEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEpochResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinRateLimitAmount.Size()
-		i -= size
-		if _, err := m.MinRateLimitAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.DurationHours != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DurationHours))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.MaxPercentRecv.Size()
-		i -= size
-		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MaxPercentSend.Size()
-		i -= size
-		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+var xxx_messageInfo_MsgUpdateEpochResponse proto.InternalMessageInfo
+
+type MsgRemoveEpoch struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *MsgRemoveEpoch) Reset()         { *m = MsgRemoveEpoch{} }
+func (m *MsgRemoveEpoch) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveEpoch) ProtoMessage()    {}
+func (*MsgRemoveEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{12}
+}
+func (m *MsgRemoveEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ChannelID) > 0 {
-		i -= len(m.ChannelID)
-		copy(dAtA[i:], m.ChannelID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveEpoch.Merge(m, src)
+}
+func (m *MsgRemoveEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveEpoch.DiscardUnknown(m)
 }
 
-func (m *MsgAddRateLimitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveEpoch proto.InternalMessageInfo
+
+func (m *MsgRemoveEpoch) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveEpoch) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
 }
 
-func (m *MsgAddRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgRemoveEpochResponse struct {
 }
 
-func (m *MsgUpdateRateLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveEpochResponse) Reset()         { *m = MsgRemoveEpochResponse{} }
+func (m *MsgRemoveEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveEpochResponse) ProtoMessage()    {}
+func (*MsgRemoveEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{13}
+}
+func (m *MsgRemoveEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveEpochResponse.Merge(m, src)
+}
+func (m *MsgRemoveEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveEpochResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRateLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveEpochResponse proto.InternalMessageInfo
+
+type MsgBlacklistDenom struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// denom whose IBC transfers are all denied
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgUpdateRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DurationHours != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DurationHours))
-		i--
-		dAtA[i] = 0x38
-	}
-	{
-		size := m.MinRateLimitAmount.Size()
-		i -= size
-		if _, err := m.MinRateLimitAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.MaxPercentRecv.Size()
-		i -= size
-		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MaxPercentSend.Size()
-		i -= size
-		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgBlacklistDenom) Reset()         { *m = MsgBlacklistDenom{} }
+func (m *MsgBlacklistDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgBlacklistDenom) ProtoMessage()    {}
+func (*MsgBlacklistDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{14}
+}
+func (m *MsgBlacklistDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBlacklistDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBlacklistDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ChannelID) > 0 {
-		i -= len(m.ChannelID)
-		copy(dAtA[i:], m.ChannelID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgBlacklistDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBlacklistDenom.Merge(m, src)
+}
+func (m *MsgBlacklistDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBlacklistDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBlacklistDenom.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRateLimitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBlacklistDenom proto.InternalMessageInfo
+
+func (m *MsgBlacklistDenom) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBlacklistDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *MsgUpdateRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgBlacklistDenomResponse struct {
 }
 
-func (m *MsgRemoveRateLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBlacklistDenomResponse) Reset()         { *m = MsgBlacklistDenomResponse{} }
+func (m *MsgBlacklistDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBlacklistDenomResponse) ProtoMessage()    {}
+func (*MsgBlacklistDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{15}
+}
+func (m *MsgBlacklistDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBlacklistDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBlacklistDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRemoveRateLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBlacklistDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBlacklistDenomResponse.Merge(m, src)
 }
-
-func (m *MsgRemoveRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelID) > 0 {
-		i -= len(m.ChannelID)
-		copy(dAtA[i:], m.ChannelID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgBlacklistDenomResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgRemoveRateLimitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgBlacklistDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBlacklistDenomResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgBlacklistDenomResponse proto.InternalMessageInfo
 
-func (m *MsgRemoveRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgRemoveBlacklistedDenom struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgResetRateLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveBlacklistedDenom) Reset()         { *m = MsgRemoveBlacklistedDenom{} }
+func (m *MsgRemoveBlacklistedDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBlacklistedDenom) ProtoMessage()    {}
+func (*MsgRemoveBlacklistedDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{16}
+}
+func (m *MsgRemoveBlacklistedDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBlacklistedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBlacklistedDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgResetRateLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveBlacklistedDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBlacklistedDenom.Merge(m, src)
+}
+func (m *MsgRemoveBlacklistedDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBlacklistedDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBlacklistedDenom.DiscardUnknown(m)
 }
 
-func (m *MsgResetRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelID) > 0 {
-		i -= len(m.ChannelID)
-		copy(dAtA[i:], m.ChannelID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgRemoveBlacklistedDenom proto.InternalMessageInfo
+
+func (m *MsgRemoveBlacklistedDenom) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgResetRateLimitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveBlacklistedDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgResetRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRemoveBlacklistedDenomResponse struct {
 }
 
-func (m *MsgResetRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveBlacklistedDenomResponse) Reset()         { *m = MsgRemoveBlacklistedDenomResponse{} }
+func (m *MsgRemoveBlacklistedDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBlacklistedDenomResponse) ProtoMessage()    {}
+func (*MsgRemoveBlacklistedDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{17}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgRemoveBlacklistedDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgAddRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.MaxPercentSend.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxPercentRecv.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DurationHours != 0 {
-		n += 1 + sovTx(uint64(m.DurationHours))
+func (m *MsgRemoveBlacklistedDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBlacklistedDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.MinRateLimitAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgRemoveBlacklistedDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBlacklistedDenomResponse.Merge(m, src)
+}
+func (m *MsgRemoveBlacklistedDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBlacklistedDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBlacklistedDenomResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgRemoveBlacklistedDenomResponse proto.InternalMessageInfo
+
+type MsgPausePath struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// denom whose IBC transfers on the channel are denied regardless of the quota
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelID string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (m *MsgUpdateRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.MaxPercentSend.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxPercentRecv.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinRateLimitAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DurationHours != 0 {
-		n += 1 + sovTx(uint64(m.DurationHours))
+func (m *MsgPausePath) Reset()         { *m = MsgPausePath{} }
+func (m *MsgPausePath) String() string { return proto.CompactTextString(m) }
+func (*MsgPausePath) ProtoMessage()    {}
+func (*MsgPausePath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{18}
+}
+func (m *MsgPausePath) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPausePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPausePath.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgPausePath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPausePath.Merge(m, src)
+}
+func (m *MsgPausePath) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPausePath) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPausePath.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgPausePath proto.InternalMessageInfo
+
+func (m *MsgPausePath) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgRemoveRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPausePath) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgPausePath) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+type MsgPausePathResponse struct {
+}
+
+func (m *MsgPausePathResponse) Reset()         { *m = MsgPausePathResponse{} }
+func (m *MsgPausePathResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPausePathResponse) ProtoMessage()    {}
+func (*MsgPausePathResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{19}
+}
+func (m *MsgPausePathResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPausePathResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPausePathResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgPausePathResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPausePathResponse.Merge(m, src)
+}
+func (m *MsgPausePathResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPausePathResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPausePathResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPausePathResponse proto.InternalMessageInfo
+
+type MsgUnpausePath struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelID string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgUnpausePath) Reset()         { *m = MsgUnpausePath{} }
+func (m *MsgUnpausePath) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpausePath) ProtoMessage()    {}
+func (*MsgUnpausePath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{20}
+}
+func (m *MsgUnpausePath) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpausePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpausePath.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUnpausePath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpausePath.Merge(m, src)
+}
+func (m *MsgUnpausePath) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpausePath) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpausePath.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUnpausePath proto.InternalMessageInfo
+
+func (m *MsgUnpausePath) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgResetRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnpausePath) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgUnpausePath) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+type MsgUnpausePathResponse struct {
+}
+
+func (m *MsgUnpausePathResponse) Reset()         { *m = MsgUnpausePathResponse{} }
+func (m *MsgUnpausePathResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpausePathResponse) ProtoMessage()    {}
+func (*MsgUnpausePathResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4a582edd75a41c, []int{21}
+}
+func (m *MsgUnpausePathResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpausePathResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpausePathResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUnpausePathResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpausePathResponse.Merge(m, src)
+}
+func (m *MsgUnpausePathResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpausePathResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpausePathResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpausePathResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddRateLimit)(nil), "composable.ratelimit.v1beta1.MsgAddRateLimit")
+	proto.RegisterType((*MsgAddRateLimitResponse)(nil), "composable.ratelimit.v1beta1.MsgAddRateLimitResponse")
+	proto.RegisterType((*MsgUpdateRateLimit)(nil), "composable.ratelimit.v1beta1.MsgUpdateRateLimit")
+	proto.RegisterType((*MsgUpdateRateLimitResponse)(nil), "composable.ratelimit.v1beta1.MsgUpdateRateLimitResponse")
+	proto.RegisterType((*MsgRemoveRateLimit)(nil), "composable.ratelimit.v1beta1.MsgRemoveRateLimit")
+	proto.RegisterType((*MsgRemoveRateLimitResponse)(nil), "composable.ratelimit.v1beta1.MsgRemoveRateLimitResponse")
+	proto.RegisterType((*MsgResetRateLimit)(nil), "composable.ratelimit.v1beta1.MsgResetRateLimit")
+	proto.RegisterType((*MsgResetRateLimitResponse)(nil), "composable.ratelimit.v1beta1.MsgResetRateLimitResponse")
+	proto.RegisterType((*MsgAddEpoch)(nil), "composable.ratelimit.v1beta1.MsgAddEpoch")
+	proto.RegisterType((*MsgAddEpochResponse)(nil), "composable.ratelimit.v1beta1.MsgAddEpochResponse")
+	proto.RegisterType((*MsgUpdateEpoch)(nil), "composable.ratelimit.v1beta1.MsgUpdateEpoch")
+	proto.RegisterType((*MsgUpdateEpochResponse)(nil), "composable.ratelimit.v1beta1.MsgUpdateEpochResponse")
+	proto.RegisterType((*MsgRemoveEpoch)(nil), "composable.ratelimit.v1beta1.MsgRemoveEpoch")
+	proto.RegisterType((*MsgRemoveEpochResponse)(nil), "composable.ratelimit.v1beta1.MsgRemoveEpochResponse")
+	proto.RegisterType((*MsgBlacklistDenom)(nil), "composable.ratelimit.v1beta1.MsgBlacklistDenom")
+	proto.RegisterType((*MsgBlacklistDenomResponse)(nil), "composable.ratelimit.v1beta1.MsgBlacklistDenomResponse")
+	proto.RegisterType((*MsgRemoveBlacklistedDenom)(nil), "composable.ratelimit.v1beta1.MsgRemoveBlacklistedDenom")
+	proto.RegisterType((*MsgRemoveBlacklistedDenomResponse)(nil), "composable.ratelimit.v1beta1.MsgRemoveBlacklistedDenomResponse")
+	proto.RegisterType((*MsgPausePath)(nil), "composable.ratelimit.v1beta1.MsgPausePath")
+	proto.RegisterType((*MsgPausePathResponse)(nil), "composable.ratelimit.v1beta1.MsgPausePathResponse")
+	proto.RegisterType((*MsgUnpausePath)(nil), "composable.ratelimit.v1beta1.MsgUnpausePath")
+	proto.RegisterType((*MsgUnpausePathResponse)(nil), "composable.ratelimit.v1beta1.MsgUnpausePathResponse")
+}
+
+func init() {
+	proto.RegisterFile("composable/ratelimit/v1beta1/tx.proto", fileDescriptor_7c4a582edd75a41c)
+}
+
+var fileDescriptor_7c4a582edd75a41c = []byte{
+	// 1059 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x36, 0x4d, 0x1a, 0xbf, 0x50, 0x27, 0xd9, 0xb8, 0xc9, 0x66, 0x29, 0x76, 0x31, 0x2a,
+	0x6a, 0x4b, 0xba, 0x4b, 0x42, 0x51, 0xaa, 0x5e, 0x50, 0x42, 0x90, 0x88, 0x44, 0xa4, 0x68, 0x29,
+	0x52, 0xc5, 0x65, 0x35, 0xde, 0x9d, 0xac, 0x57, 0xf5, 0xec, 0x2c, 0x3b, 0x63, 0xcb, 0x46, 0x9c,
+	0x2a, 0x71, 0xaf, 0x38, 0xa0, 0x4a, 0xf0, 0x23, 0xb8, 0xf0, 0x1f, 0x7a, 0x42, 0xe5, 0x86, 0x38,
+	0x18, 0x94, 0x1c, 0x10, 0x1c, 0xf3, 0x0b, 0xd0, 0xce, 0x7a, 0xc7, 0x6b, 0x3b, 0x26, 0xb6, 0x69,
+	0x20, 0x87, 0x9e, 0x9c, 0x9d, 0x79, 0xef, 0x7d, 0xdf, 0xcc, 0xfb, 0xde, 0x7b, 0xbb, 0x81, 0x9b,
+	0x0e, 0x25, 0x21, 0x65, 0xa8, 0x52, 0xc3, 0x66, 0x84, 0x38, 0xae, 0xf9, 0xc4, 0xe7, 0x66, 0x63,
+	0xa3, 0x82, 0x39, 0xda, 0x30, 0x79, 0xd3, 0x08, 0x23, 0xca, 0xa9, 0x7a, 0xbd, 0x6b, 0x66, 0x48,
+	0x33, 0xa3, 0x63, 0xa6, 0x17, 0x3c, 0xea, 0x51, 0x61, 0x68, 0xc6, 0x7f, 0x25, 0x3e, 0xfa, 0xaa,
+	0x43, 0x19, 0xa1, 0xcc, 0x24, 0xcc, 0x33, 0x1b, 0x1b, 0xf1, 0x4f, 0x67, 0xa3, 0xe8, 0x51, 0xea,
+	0xd5, 0xb0, 0x29, 0x9e, 0x2a, 0xf5, 0x43, 0xd3, 0xad, 0x47, 0x88, 0xfb, 0x34, 0xe8, 0xec, 0x97,
+	0xfa, 0xf7, 0xb9, 0x4f, 0x30, 0xe3, 0x88, 0x84, 0x89, 0x41, 0xf9, 0xa7, 0x19, 0x58, 0xd8, 0x67,
+	0xde, 0xb6, 0xeb, 0x5a, 0x88, 0xe3, 0x4f, 0x62, 0x2e, 0xea, 0x26, 0xe4, 0x50, 0x9d, 0x57, 0x69,
+	0xe4, 0xf3, 0x96, 0xa6, 0xdc, 0x50, 0x6e, 0xe5, 0x76, 0x0a, 0x27, 0xed, 0xd2, 0x62, 0x0b, 0x91,
+	0xda, 0x83, 0xb2, 0xdc, 0x2a, 0x5b, 0x5d, 0x33, 0xb5, 0x00, 0x33, 0x2e, 0x0e, 0x28, 0xd1, 0x2e,
+	0xc5, 0xf6, 0x56, 0xf2, 0xa0, 0xae, 0x03, 0x38, 0x55, 0x14, 0x04, 0xb8, 0x66, 0xfb, 0xae, 0x36,
+	0x2d, 0x42, 0x5d, 0x3d, 0x6a, 0x97, 0x72, 0x1f, 0x26, 0xab, 0x7b, 0xbb, 0x56, 0xae, 0x63, 0xb0,
+	0xe7, 0xaa, 0x8f, 0x60, 0x91, 0xa0, 0xa6, 0x1d, 0xe2, 0xc8, 0xc1, 0x01, 0xb7, 0x19, 0x0e, 0x5c,
+	0xed, 0xb2, 0xf0, 0x31, 0x9e, 0xb7, 0x4b, 0x53, 0xbf, 0xb6, 0x4b, 0x6f, 0x7b, 0x3e, 0xaf, 0xd6,
+	0x2b, 0x86, 0x43, 0x89, 0xd9, 0xb9, 0x92, 0xe4, 0xe7, 0x2e, 0x73, 0x1f, 0x9b, 0xbc, 0x15, 0x62,
+	0x66, 0xec, 0x05, 0xdc, 0xca, 0x13, 0xd4, 0x3c, 0x48, 0xc2, 0x7c, 0x8a, 0x83, 0x81, 0xc8, 0x11,
+	0x76, 0x1a, 0xda, 0xcc, 0xbf, 0x8d, 0x6c, 0x61, 0xa7, 0xa1, 0xde, 0x84, 0x7c, 0x7a, 0xe5, 0x76,
+	0x95, 0xd6, 0x23, 0xa6, 0xcd, 0xde, 0x50, 0x6e, 0x5d, 0xb6, 0xae, 0xa6, 0xab, 0x1f, 0xc7, 0x8b,
+	0x2a, 0x82, 0x6b, 0xc4, 0x0f, 0xec, 0x38, 0xdf, 0xb6, 0x48, 0xb8, 0x8d, 0x08, 0xad, 0x07, 0x5c,
+	0xbb, 0x32, 0x11, 0x0b, 0x95, 0xf8, 0x81, 0xcc, 0xd7, 0xb6, 0x88, 0xa4, 0xde, 0x86, 0x45, 0x1c,
+	0x52, 0xa7, 0x6a, 0xfb, 0x2e, 0x0e, 0xb8, 0x7f, 0xe8, 0xe3, 0x48, 0x9b, 0x13, 0xc9, 0x58, 0x10,
+	0xeb, 0x7b, 0x72, 0x59, 0xc5, 0xb0, 0x9a, 0xbd, 0x8e, 0x10, 0x47, 0x36, 0x72, 0xdd, 0x08, 0x33,
+	0xa6, 0xe5, 0x26, 0xe2, 0x53, 0xe8, 0xde, 0xca, 0x01, 0x8e, 0xb6, 0x93, 0x58, 0xaa, 0x03, 0x2b,
+	0x31, 0x4c, 0x72, 0xd2, 0x1e, 0x14, 0x98, 0x08, 0x65, 0x99, 0xa0, 0x66, 0x72, 0xd8, 0x2e, 0xc8,
+	0x83, 0xfc, 0x93, 0x3f, 0x7e, 0xb8, 0xd3, 0x15, 0x62, 0x79, 0x0d, 0x56, 0xfb, 0xf4, 0x6c, 0x61,
+	0x16, 0xd2, 0x80, 0xe1, 0xf2, 0xcf, 0x33, 0xa0, 0xee, 0x33, 0xef, 0xb3, 0xd0, 0x45, 0x1c, 0xbf,
+	0x92, 0xfb, 0x24, 0x72, 0x1f, 0xaa, 0xe3, 0xd9, 0x97, 0xa6, 0xe3, 0xc1, 0x8a, 0xba, 0x72, 0x5a,
+	0x45, 0xbd, 0x92, 0xbb, 0x10, 0xe5, 0x75, 0xd0, 0x07, 0x25, 0x2d, 0x15, 0xff, 0xbd, 0x22, 0x14,
+	0x6f, 0x61, 0x42, 0x1b, 0xff, 0xbf, 0xe2, 0x87, 0x90, 0xef, 0x63, 0x27, 0xc9, 0x7f, 0xa7, 0xc0,
+	0x92, 0xd8, 0x66, 0x98, 0x5f, 0x3c, 0xee, 0xaf, 0xc3, 0xda, 0x00, 0x39, 0x49, 0xfd, 0xc7, 0x4b,
+	0x30, 0x9f, 0x74, 0xa1, 0x8f, 0x62, 0x2d, 0x4e, 0x44, 0xba, 0x08, 0x90, 0x91, 0x76, 0xc2, 0x3c,
+	0xb3, 0xa2, 0x3e, 0x02, 0x60, 0x1c, 0x45, 0xdc, 0x8e, 0x47, 0xba, 0xa0, 0x3f, 0xbf, 0xa9, 0x1b,
+	0xc9, 0xbc, 0x37, 0xd2, 0x79, 0x6f, 0x3c, 0x4c, 0xe7, 0xfd, 0xce, 0x1b, 0xb1, 0xfc, 0x4e, 0xda,
+	0xa5, 0xa5, 0x04, 0xb4, 0xeb, 0x5b, 0x7e, 0xfa, 0x5b, 0x49, 0xb1, 0x72, 0x62, 0x21, 0x36, 0x57,
+	0xab, 0x30, 0x97, 0xd6, 0x9a, 0x68, 0x48, 0xf3, 0x9b, 0x6b, 0x03, 0x71, 0x77, 0x3b, 0x06, 0x3b,
+	0x1b, 0x71, 0xd8, 0xbf, 0xda, 0x25, 0x35, 0x75, 0x59, 0xa7, 0xc4, 0xe7, 0x98, 0x84, 0xbc, 0x75,
+	0xd2, 0x2e, 0x2d, 0x24, 0x60, 0xe9, 0x5e, 0xf9, 0x59, 0x0c, 0x25, 0xa3, 0x0f, 0x5c, 0xea, 0x35,
+	0x58, 0xce, 0x5c, 0x9b, 0xbc, 0xce, 0xb6, 0x02, 0x79, 0xa9, 0xf2, 0xf3, 0xbb, 0xd1, 0xec, 0xb9,
+	0xa7, 0xff, 0xd3, 0x73, 0x6b, 0xb0, 0xd2, 0x7b, 0x3e, 0x79, 0x74, 0x0e, 0x79, 0x59, 0x22, 0xe7,
+	0x76, 0xf2, 0x21, 0x7c, 0x32, 0xa8, 0x92, 0x0f, 0x11, 0x35, 0xb9, 0x53, 0x43, 0xce, 0xe3, 0x9a,
+	0xcf, 0xf8, 0xae, 0xa8, 0xa4, 0x97, 0x56, 0x93, 0x43, 0xaa, 0xac, 0x17, 0x4e, 0x72, 0xa9, 0xc3,
+	0x9a, 0x64, 0x29, 0x4d, 0xb0, 0x7b, 0xde, 0x9c, 0xde, 0x82, 0x37, 0x87, 0xc2, 0x4a, 0x6e, 0xdf,
+	0x2a, 0xf0, 0xda, 0x3e, 0xf3, 0x0e, 0x50, 0x9d, 0xe1, 0x03, 0xc4, 0xab, 0x17, 0xa6, 0x6f, 0xad,
+	0x40, 0x21, 0xcb, 0x4b, 0x12, 0x7e, 0xd6, 0xa9, 0xb1, 0x20, 0xbc, 0x70, 0x94, 0x3b, 0xd5, 0x11,
+	0x84, 0xfd, 0xa4, 0x37, 0xff, 0x04, 0x98, 0xde, 0x67, 0x9e, 0xfa, 0x15, 0x14, 0xb6, 0x5d, 0xf7,
+	0x61, 0x84, 0x02, 0x76, 0x88, 0xa3, 0xee, 0xb0, 0xb8, 0x6b, 0xfc, 0xd3, 0xc7, 0x96, 0xd1, 0xf7,
+	0xa2, 0xa8, 0xbf, 0x3f, 0x96, 0x79, 0xca, 0x42, 0xfd, 0x5a, 0x81, 0xd5, 0xa4, 0x76, 0x07, 0x19,
+	0xbc, 0x7b, 0x66, 0xc8, 0xbe, 0xd9, 0xad, 0xdf, 0x1f, 0xd7, 0xa3, 0x87, 0x47, 0x22, 0xcb, 0x49,
+	0x78, 0xf4, 0x8d, 0x61, 0xfd, 0xfe, 0xb8, 0x1e, 0x92, 0xc7, 0x13, 0x05, 0x56, 0xc4, 0x60, 0x1c,
+	0xa4, 0x61, 0x8e, 0x10, 0x34, 0x3b, 0x51, 0xf5, 0xad, 0x31, 0x1d, 0x24, 0x89, 0x2a, 0xcc, 0xc9,
+	0xf1, 0x7b, 0x7b, 0x94, 0xbc, 0x0a, 0x53, 0x7d, 0x63, 0x64, 0x53, 0x89, 0xf4, 0x05, 0xcc, 0x67,
+	0x27, 0xd3, 0xfa, 0x88, 0xf9, 0x4b, 0xf0, 0xee, 0x8d, 0x63, 0x9d, 0x85, 0xcc, 0x8e, 0x84, 0xf5,
+	0x11, 0x53, 0x35, 0x2a, 0xe4, 0x29, 0x8d, 0x5f, 0xfd, 0x12, 0xf2, 0x7d, 0x5d, 0xff, 0xec, 0x5c,
+	0xf6, 0x3a, 0xe8, 0x5b, 0x63, 0x3a, 0x48, 0xec, 0x6f, 0x84, 0xa0, 0x4e, 0x6d, 0xf3, 0x5b, 0x23,
+	0x1e, 0xa6, 0xdf, 0x51, 0xff, 0x60, 0x42, 0x47, 0x49, 0x8a, 0xc1, 0x92, 0xe8, 0xa2, 0xa9, 0xc8,
+	0x45, 0xcb, 0xbc, 0x73, 0x66, 0x54, 0xd9, 0x79, 0xf5, 0xcd, 0xd1, 0x6d, 0x25, 0x68, 0x0b, 0x96,
+	0x3b, 0x7d, 0xb0, 0x07, 0x76, 0x04, 0xcd, 0x75, 0xbb, 0xa7, 0x7e, 0x6f, 0x1c, 0xeb, 0x14, 0x7a,
+	0xe7, 0x9d, 0xe7, 0x47, 0x45, 0xe5, 0xc5, 0x51, 0x51, 0xf9, 0xfd, 0xa8, 0xa8, 0x3c, 0x3d, 0x2e,
+	0x4e, 0xbd, 0x38, 0x2e, 0x4e, 0xfd, 0x72, 0x5c, 0x9c, 0xfa, 0x7c, 0xa9, 0x99, 0xf9, 0x7f, 0x97,
+	0xf8, 0x7e, 0xa9, 0xcc, 0x8a, 0x17, 0xa6, 0xf7, 0xfe, 0x1e, 0x00, 0x43, 0x1e, 0xaf, 0xeb, 0x14,
+	0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	AddTransferRateLimit(ctx context.Context, in *MsgAddRateLimit, opts ...grpc.CallOption) (*MsgAddRateLimitResponse, error)
+	UpdateTransferRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error)
+	RemoveTransferRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
+	ResetTransferRateLimit(ctx context.Context, in *MsgResetRateLimit, opts ...grpc.CallOption) (*MsgResetRateLimitResponse, error)
+	AddEpoch(ctx context.Context, in *MsgAddEpoch, opts ...grpc.CallOption) (*MsgAddEpochResponse, error)
+	UpdateEpoch(ctx context.Context, in *MsgUpdateEpoch, opts ...grpc.CallOption) (*MsgUpdateEpochResponse, error)
+	RemoveEpoch(ctx context.Context, in *MsgRemoveEpoch, opts ...grpc.CallOption) (*MsgRemoveEpochResponse, error)
+	BlacklistDenom(ctx context.Context, in *MsgBlacklistDenom, opts ...grpc.CallOption) (*MsgBlacklistDenomResponse, error)
+	RemoveBlacklistedDenom(ctx context.Context, in *MsgRemoveBlacklistedDenom, opts ...grpc.CallOption) (*MsgRemoveBlacklistedDenomResponse, error)
+	PauseTransferPath(ctx context.Context, in *MsgPausePath, opts ...grpc.CallOption) (*MsgPausePathResponse, error)
+	UnpauseTransferPath(ctx context.Context, in *MsgUnpausePath, opts ...grpc.CallOption) (*MsgUnpausePathResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddTransferRateLimit(ctx context.Context, in *MsgAddRateLimit, opts ...grpc.CallOption) (*MsgAddRateLimitResponse, error) {
+	out := new(MsgAddRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/AddTransferRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgResetRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateTransferRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error) {
+	out := new(MsgUpdateRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/UpdateTransferRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (c *msgClient) RemoveTransferRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
+	out := new(MsgRemoveRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/RemoveTransferRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (c *msgClient) ResetTransferRateLimit(ctx context.Context, in *MsgResetRateLimit, opts ...grpc.CallOption) (*MsgResetRateLimitResponse, error) {
+	out := new(MsgResetRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/ResetTransferRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddEpoch(ctx context.Context, in *MsgAddEpoch, opts ...grpc.CallOption) (*MsgAddEpochResponse, error) {
+	out := new(MsgAddEpochResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/AddEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateEpoch(ctx context.Context, in *MsgUpdateEpoch, opts ...grpc.CallOption) (*MsgUpdateEpochResponse, error) {
+	out := new(MsgUpdateEpochResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/UpdateEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveEpoch(ctx context.Context, in *MsgRemoveEpoch, opts ...grpc.CallOption) (*MsgRemoveEpochResponse, error) {
+	out := new(MsgRemoveEpochResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/RemoveEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BlacklistDenom(ctx context.Context, in *MsgBlacklistDenom, opts ...grpc.CallOption) (*MsgBlacklistDenomResponse, error) {
+	out := new(MsgBlacklistDenomResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/BlacklistDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveBlacklistedDenom(ctx context.Context, in *MsgRemoveBlacklistedDenom, opts ...grpc.CallOption) (*MsgRemoveBlacklistedDenomResponse, error) {
+	out := new(MsgRemoveBlacklistedDenomResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/RemoveBlacklistedDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseTransferPath(ctx context.Context, in *MsgPausePath, opts ...grpc.CallOption) (*MsgPausePathResponse, error) {
+	out := new(MsgPausePathResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/PauseTransferPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseTransferPath(ctx context.Context, in *MsgUnpausePath, opts ...grpc.CallOption) (*MsgUnpausePathResponse, error) {
+	out := new(MsgUnpausePathResponse)
+	err := c.cc.Invoke(ctx, "/composable.ratelimit.v1beta1.Msg/UnpauseTransferPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	AddTransferRateLimit(context.Context, *MsgAddRateLimit) (*MsgAddRateLimitResponse, error)
+	UpdateTransferRateLimit(context.Context, *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error)
+	RemoveTransferRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
+	ResetTransferRateLimit(context.Context, *MsgResetRateLimit) (*MsgResetRateLimitResponse, error)
+	AddEpoch(context.Context, *MsgAddEpoch) (*MsgAddEpochResponse, error)
+	UpdateEpoch(context.Context, *MsgUpdateEpoch) (*MsgUpdateEpochResponse, error)
+	RemoveEpoch(context.Context, *MsgRemoveEpoch) (*MsgRemoveEpochResponse, error)
+	BlacklistDenom(context.Context, *MsgBlacklistDenom) (*MsgBlacklistDenomResponse, error)
+	RemoveBlacklistedDenom(context.Context, *MsgRemoveBlacklistedDenom) (*MsgRemoveBlacklistedDenomResponse, error)
+	PauseTransferPath(context.Context, *MsgPausePath) (*MsgPausePathResponse, error)
+	UnpauseTransferPath(context.Context, *MsgUnpausePath) (*MsgUnpausePathResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddTransferRateLimit(ctx context.Context, req *MsgAddRateLimit) (*MsgAddRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransferRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTransferRateLimit(ctx context.Context, req *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransferRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveTransferRateLimit(ctx context.Context, req *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTransferRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) ResetTransferRateLimit(ctx context.Context, req *MsgResetRateLimit) (*MsgResetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetTransferRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) AddEpoch(ctx context.Context, req *MsgAddEpoch) (*MsgAddEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEpoch not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEpoch(ctx context.Context, req *MsgUpdateEpoch) (*MsgUpdateEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEpoch not implemented")
+}
+func (*UnimplementedMsgServer) RemoveEpoch(ctx context.Context, req *MsgRemoveEpoch) (*MsgRemoveEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEpoch not implemented")
+}
+func (*UnimplementedMsgServer) BlacklistDenom(ctx context.Context, req *MsgBlacklistDenom) (*MsgBlacklistDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlacklistDenom not implemented")
+}
+func (*UnimplementedMsgServer) RemoveBlacklistedDenom(ctx context.Context, req *MsgRemoveBlacklistedDenom) (*MsgRemoveBlacklistedDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlacklistedDenom not implemented")
+}
+func (*UnimplementedMsgServer) PauseTransferPath(ctx context.Context, req *MsgPausePath) (*MsgPausePathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTransferPath not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseTransferPath(ctx context.Context, req *MsgUnpausePath) (*MsgUnpausePathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseTransferPath not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddTransferRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddTransferRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Msg/AddTransferRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddTransferRateLimit(ctx, req.(*MsgAddRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTransferRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTransferRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Msg/UpdateTransferRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTransferRateLimit(ctx, req.(*MsgUpdateRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveTransferRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveTransferRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Msg/RemoveTransferRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveTransferRateLimit(ctx, req.(*MsgRemoveRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetTransferRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetTransferRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Msg/ResetTransferRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetTransferRateLimit(ctx, req.(*MsgResetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Msg/AddEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddEpoch(ctx, req.(*MsgAddEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Msg/UpdateEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEpoch(ctx, req.(*MsgUpdateEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Msg/RemoveEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveEpoch(ctx, req.(*MsgRemoveEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BlacklistDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBlacklistDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BlacklistDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Msg/BlacklistDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BlacklistDenom(ctx, req.(*MsgBlacklistDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBlacklistedDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBlacklistedDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBlacklistedDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Msg/RemoveBlacklistedDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBlacklistedDenom(ctx, req.(*MsgRemoveBlacklistedDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseTransferPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPausePath)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseTransferPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Msg/PauseTransferPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseTransferPath(ctx, req.(*MsgPausePath))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseTransferPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpausePath)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseTransferPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.ratelimit.v1beta1.Msg/UnpauseTransferPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseTransferPath(ctx, req.(*MsgUnpausePath))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "composable.ratelimit.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTransferRateLimit",
+			Handler:    _Msg_AddTransferRateLimit_Handler,
+		},
+		{
+			MethodName: "UpdateTransferRateLimit",
+			Handler:    _Msg_UpdateTransferRateLimit_Handler,
+		},
+		{
+			MethodName: "RemoveTransferRateLimit",
+			Handler:    _Msg_RemoveTransferRateLimit_Handler,
+		},
+		{
+			MethodName: "ResetTransferRateLimit",
+			Handler:    _Msg_ResetTransferRateLimit_Handler,
+		},
+		{
+			MethodName: "AddEpoch",
+			Handler:    _Msg_AddEpoch_Handler,
+		},
+		{
+			MethodName: "UpdateEpoch",
+			Handler:    _Msg_UpdateEpoch_Handler,
+		},
+		{
+			MethodName: "RemoveEpoch",
+			Handler:    _Msg_RemoveEpoch_Handler,
+		},
+		{
+			MethodName: "BlacklistDenom",
+			Handler:    _Msg_BlacklistDenom_Handler,
+		},
+		{
+			MethodName: "RemoveBlacklistedDenom",
+			Handler:    _Msg_RemoveBlacklistedDenom_Handler,
+		},
+		{
+			MethodName: "PauseTransferPath",
+			Handler:    _Msg_PauseTransferPath_Handler,
+		},
+		{
+			MethodName: "UnpauseTransferPath",
+			Handler:    _Msg_UnpauseTransferPath_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "composable/ratelimit/v1beta1/tx.proto",
+}
+
+func (m *MsgAddRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmountPerAddress.Size()
+		i -= size
+		if _, err := m.MaxAmountPerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MaxPercentPerAddress.Size()
+		i -= size
+		if _, err := m.MaxPercentPerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.MinRateLimitAmount.Size()
+		i -= size
+		if _, err := m.MinRateLimitAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.DurationHours != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DurationHours))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxPercentRecv.Size()
+		i -= size
+		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPercentSend.Size()
+		i -= size
+		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmountPerAddress.Size()
+		i -= size
+		if _, err := m.MaxAmountPerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MaxPercentPerAddress.Size()
+		i -= size
+		if _, err := m.MaxPercentPerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DurationHours != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DurationHours))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MinRateLimitAmount.Size()
+		i -= size
+		if _, err := m.MinRateLimitAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxPercentRecv.Size()
+		i -= size
+		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPercentSend.Size()
+		i -= size
+		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBlacklistDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBlacklistDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBlacklistDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBlacklistDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBlacklistDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBlacklistDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBlacklistedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBlacklistedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBlacklistedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBlacklistedDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBlacklistedDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBlacklistedDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPausePath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPausePath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPausePath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPausePathResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPausePathResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPausePathResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpausePath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpausePath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpausePath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpausePathResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpausePathResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpausePathResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPercentSend.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPercentRecv.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DurationHours != 0 {
+		n += 1 + sovTx(uint64(m.DurationHours))
+	}
+	l = m.MinRateLimitAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPercentPerAddress.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxAmountPerAddress.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPercentSend.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPercentRecv.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinRateLimitAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DurationHours != 0 {
+		n += 1 + sovTx(uint64(m.DurationHours))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPercentPerAddress.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxAmountPerAddress.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResetRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBlacklistDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBlacklistDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveBlacklistedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveBlacklistedDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPausePath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPausePathResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpausePath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpausePathResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+			}
+			m.DurationHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRateLimitAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRateLimitAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentPerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentPerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountPerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountPerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRateLimitAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRateLimitAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+			}
+			m.DurationHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentPerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentPerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountPerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountPerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgAddEpoch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1211,10 +4169,10 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddEpoch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1251,7 +4209,7 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1279,11 +4237,177 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Identifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1311,11 +4435,11 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1340,16 +4464,147 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1377,32 +4632,11 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
-			}
-			m.DurationHours = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DurationHours |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinRateLimitAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1430,9 +4664,7 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinRateLimitAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1455,7 +4687,7 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveEpochResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1478,10 +4710,10 @@ func (m *MsgAddRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveEpochResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1505,7 +4737,7 @@ func (m *MsgAddRateLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgBlacklistDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1528,10 +4760,10 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBlacklistDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBlacklistDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1598,75 +4830,109 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBlacklistDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBlacklistDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBlacklistDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBlacklistedDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBlacklistedDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBlacklistedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1694,13 +4960,11 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinRateLimitAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1728,29 +4992,8 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinRateLimitAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
-			}
-			m.DurationHours = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DurationHours |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1772,7 +5015,7 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveBlacklistedDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1795,10 +5038,10 @@ func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveBlacklistedDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveBlacklistedDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1822,7 +5065,7 @@ func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgPausePath) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1845,10 +5088,10 @@ func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPausePath: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPausePath: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1968,7 +5211,7 @@ func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPausePathResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1991,10 +5234,10 @@ func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPausePathResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPausePathResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2018,7 +5261,7 @@ func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResetRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpausePath) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2041,10 +5284,10 @@ func (m *MsgResetRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResetRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpausePath: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResetRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpausePath: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2164,7 +5407,7 @@ func (m *MsgResetRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResetRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpausePathResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2187,10 +5430,10 @@ func (m *MsgResetRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResetRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpausePathResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResetRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpausePathResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -22,8 +22,13 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdParaTokenInfo(),
+		GetCmdAllParaTokenInfos(),
+		GetCmdParaTokenInfoByAssetID(),
+		GetCmdParaTokenInfoByIBCDenom(),
 		GetEscowAddress(),
 		GetRelayerAccount(),
+		GetPendingRemovals(),
+		GetParity(),
 	)
 
 	return queryCmd
@@ -58,6 +63,95 @@ func GetCmdParaTokenInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdAllParaTokenInfos returns the command handler for transfer-middleware para-token-infos querying.
+func GetCmdAllParaTokenInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "para-token-infos",
+		Short:   "Query the token infos of all the parachain channels",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query transfermiddleware para-token-infos", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllParaTokenInfos(cmd.Context(), &types.QueryAllParaTokenInfosRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "para-token-infos")
+
+	return cmd
+}
+
+// GetCmdParaTokenInfoByAssetID returns the command handler for transfer-middleware para-token-info-by-asset-id querying.
+func GetCmdParaTokenInfoByAssetID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "para-token-info-by-asset-id [asset_id]",
+		Short:   "Query the token info of a parachain asset id",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query transfermiddleware para-token-info-by-asset-id 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ParaTokenInfoByAssetID(cmd.Context(), &types.QueryParaTokenInfoByAssetIDRequest{
+				AssetId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdParaTokenInfoByIBCDenom returns the command handler for transfer-middleware para-token-info-by-ibc-denom querying.
+func GetCmdParaTokenInfoByIBCDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "para-token-info-by-ibc-denom [ibc_denom]",
+		Short:   "Query the token info of an IBC denom",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query transfermiddleware para-token-info-by-ibc-denom ibc/C053D637CCA2A2BA030E2C5EE1B28A16F71CCB0E45E8BE52766DC1B241B77878", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ParaTokenInfoByIBCDenom(cmd.Context(), &types.QueryParaTokenInfoByIBCDenomRequest{
+				IbcDenom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetEscowAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "escrow-address [channel-id]",
@@ -108,6 +202,71 @@ func GetRelayerAccount() *cobra.Command {
 	return cmd
 }
 
+func GetPendingRemovals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-removals",
+		Short:   "Query the parachain token infos scheduled for removal",
+		Args:    cobra.ExactArgs(0),
+		Example: fmt.Sprintf("%s query transfermiddleware pending-removals", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingRemovals(cmd.Context(), &types.QueryPendingRemovalsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-removals")
+
+	return cmd
+}
+
+func GetParity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "parity [native_denom]",
+		Short:   "Query the escrowed vouchers and native supply minted against them",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query transfermiddleware parity ppica --%s=channel-0", version.AppName, FlagChannelID),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			channelID, err := cmd.Flags().GetString(FlagChannelID)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Parity(cmd.Context(), &types.QueryParityRequest{
+				NativeDenom: args[0],
+				ChannelID:   channelID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagChannelID, "", "channel id of the parachain token info, primary channel if empty")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewTxCmd returns the transaction commands for router
 func NewTxCmd() *cobra.Command {
 	return nil
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -25,7 +27,13 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		RegistryDotSamaChain(),
 		RemoveDotSamaChain(),
+		CancelRemoveDotSamaChain(),
+		Reconcile(),
+		SetPrimaryChannel(),
+		RegisterBridgeToken(),
+		UpdateBridgeToken(),
 		AddRlyAddress(),
+		RemoveRlyAddress(),
 	)
 
 	return txCmd
@@ -38,7 +46,7 @@ func RegistryDotSamaChain() *cobra.Command {
 		Short:   "registry dotsama chain information",
 		Long:    "registry dotsama chain information",
 		Args:    cobra.MatchAll(cobra.ExactArgs(4), cobra.OnlyValidArgs),
-		Example: fmt.Sprintf("%s tx transfermiddleware registry [ibc_denom] [native_denom] [asset_id] [channel_id]", version.AppName),
+		Example: fmt.Sprintf("%s tx transfermiddleware registry [ibc_denom] [native_denom] [asset_id] [channel_id] --%s=pica --%s=12 --%s=PICA", version.AppName, FlagDisplay, FlagExponent, FlagSymbol),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ibcDenom := args[0]
 			nativeDenom := args[1]
@@ -60,6 +68,16 @@ func RegistryDotSamaChain() *cobra.Command {
 				channelID,
 			)
 
+			if msg.Display, err = cmd.Flags().GetString(FlagDisplay); err != nil {
+				return err
+			}
+			if msg.Exponent, err = cmd.Flags().GetUint32(FlagExponent); err != nil {
+				return err
+			}
+			if msg.Symbol, err = cmd.Flags().GetString(FlagSymbol); err != nil {
+				return err
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -68,6 +86,9 @@ func RegistryDotSamaChain() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDisplay, "", "display denom of the bank metadata, no metadata is registered if empty")
+	cmd.Flags().Uint32(FlagExponent, 0, "decimals of the display denom")
+	cmd.Flags().String(FlagSymbol, "", "symbol of the bank metadata, the upper case display denom if empty")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -78,7 +99,7 @@ func RemoveDotSamaChain() *cobra.Command {
 		Use:     "remove",
 		Short:   "remove dotsama chain information",
 		Args:    cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
-		Example: fmt.Sprintf("%s tx transfermiddleware remove [native_denom]", version.AppName),
+		Example: fmt.Sprintf("%s tx transfermiddleware remove [native_denom] --%s=channel-0", version.AppName, FlagChannelID),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			nativeDenom := args[0]
 
@@ -89,9 +110,54 @@ func RemoveDotSamaChain() *cobra.Command {
 
 			fromAddress := clientCtx.GetFromAddress().String()
 
+			channelID, err := cmd.Flags().GetString(FlagChannelID)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgRemoveParachainIBCTokenInfo(
 				fromAddress,
 				nativeDenom,
+				channelID,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagChannelID, "", "channel id of the parachain token info, all channels if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CancelRemoveDotSamaChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-remove",
+		Short:   "cancel a pending removal of dotsama chain information",
+		Args:    cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		Example: fmt.Sprintf("%s tx transfermiddleware cancel-remove [native_denom] --%s=channel-0", version.AppName, FlagChannelID),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nativeDenom := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+
+			channelID, err := cmd.Flags().GetString(FlagChannelID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelRemoveParachainIBCTokenInfo(
+				fromAddress,
+				nativeDenom,
+				channelID,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -100,17 +166,224 @@ func RemoveDotSamaChain() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(FlagChannelID, "", "channel id of the pending removal, the removal of all channels if empty")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+func Reconcile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reconcile",
+		Short:   "set the native supply minted against escrow to the escrowed vouchers",
+		Args:    cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		Example: fmt.Sprintf("%s tx transfermiddleware reconcile [native_denom] --%s=channel-0", version.AppName, FlagChannelID),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nativeDenom := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+
+			channelID, err := cmd.Flags().GetString(FlagChannelID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReconcile(
+				fromAddress,
+				nativeDenom,
+				channelID,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagChannelID, "", "channel id of the parachain token info, primary channel if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func SetPrimaryChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-primary",
+		Short:   "set the channel used by default for the native denom",
+		Args:    cobra.MatchAll(cobra.ExactArgs(2), cobra.OnlyValidArgs),
+		Example: fmt.Sprintf("%s tx transfermiddleware set-primary [native_denom] [channel_id]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nativeDenom := args[0]
+			channelID := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+
+			msg := types.NewMsgSetPrimaryChannel(
+				fromAddress,
+				nativeDenom,
+				channelID,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func RegisterBridgeToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-bridge-token",
+		Short:   "mint a native denom in exchange for the vouchers of a token received on a channel",
+		Args:    cobra.MatchAll(cobra.ExactArgs(3), cobra.OnlyValidArgs),
+		Example: fmt.Sprintf("%s tx transfermiddleware register-bridge-token [channel_id] [remote_denom] [native_denom] --%s=1000000000000 --%s=usdc --%s=6", version.AppName, FlagSupplyCap, FlagDisplay, FlagExponent),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			channelID := args[0]
+			remoteDenom := args[1]
+			nativeDenom := args[2]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+
+			supplyCap, err := getSupplyCap(cmd)
+			if err != nil {
+				return err
+			}
+			display, err := cmd.Flags().GetString(FlagDisplay)
+			if err != nil {
+				return err
+			}
+			exponent, err := cmd.Flags().GetUint32(FlagExponent)
+			if err != nil {
+				return err
+			}
+			symbol, err := cmd.Flags().GetString(FlagSymbol)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterBridgeToken(
+				fromAddress,
+				channelID,
+				remoteDenom,
+				nativeDenom,
+				supplyCap,
+				display,
+				exponent,
+				symbol,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagSupplyCap, "0", "maximum native supply minted on the channel, no cap if zero")
+	cmd.Flags().String(FlagDisplay, "", "display denom of the bank metadata, no metadata is registered if empty")
+	cmd.Flags().Uint32(FlagExponent, 0, "decimals of the display denom")
+	cmd.Flags().String(FlagSymbol, "", "symbol of the bank metadata, the upper case display denom if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func UpdateBridgeToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-bridge-token",
+		Short:   "set the supply cap and the pause of a bridge token",
+		Args:    cobra.MatchAll(cobra.ExactArgs(2), cobra.OnlyValidArgs),
+		Example: fmt.Sprintf("%s tx transfermiddleware update-bridge-token [native_denom] [channel_id] --%s=1000000000000 --%s", version.AppName, FlagSupplyCap, FlagPaused),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nativeDenom := args[0]
+			channelID := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+
+			supplyCap, err := getSupplyCap(cmd)
+			if err != nil {
+				return err
+			}
+			paused, err := cmd.Flags().GetBool(FlagPaused)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateBridgeToken(
+				fromAddress,
+				nativeDenom,
+				channelID,
+				supplyCap,
+				paused,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagSupplyCap, "0", "maximum native supply minted on the channel, no cap if zero")
+	cmd.Flags().Bool(FlagPaused, false, "stop minting on receive and burning on send")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getSupplyCap(cmd *cobra.Command) (math.Int, error) {
+	flag, err := cmd.Flags().GetString(FlagSupplyCap)
+	if err != nil {
+		return math.Int{}, err
+	}
+	supplyCap, ok := math.NewIntFromString(flag)
+	if !ok {
+		return math.Int{}, fmt.Errorf("invalid supply cap %s", flag)
+	}
+	return supplyCap, nil
+}
+
+const (
+	FlagChannelID    = "channel-id"
+	FlagOperatorName = "operator-name"
+	FlagExpiry       = "expiry"
+	FlagClientIDs    = "client-ids"
+	FlagClientTypes  = "client-types"
+	FlagSupplyCap    = "supply-cap"
+	FlagPaused       = "paused"
+	FlagDisplay      = "display"
+	FlagExponent     = "exponent"
+	FlagSymbol       = "symbol"
+)
+
 func AddRlyAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "add-rly [addr ]",
 		Short:   "add address to whitelist relayer",
 		Args:    cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
-		Example: fmt.Sprintf("%s tx transfermiddleware add-rly [allowed_addr]", version.AppName),
+		Example: fmt.Sprintf("%s tx transfermiddleware add-rly [allowed_addr] --%s=operator --%s=2024-01-01T00:00:00Z --%s=08-wasm-0 --%s=08-wasm", version.AppName, FlagOperatorName, FlagExpiry, FlagClientIDs, FlagClientTypes),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			allowedAddress := args[0]
 
@@ -125,6 +398,62 @@ func AddRlyAddress() *cobra.Command {
 				fromAddress,
 				allowedAddress,
 			)
+
+			if msg.OperatorName, err = cmd.Flags().GetString(FlagOperatorName); err != nil {
+				return err
+			}
+			if msg.ClientIDs, err = cmd.Flags().GetStringSlice(FlagClientIDs); err != nil {
+				return err
+			}
+			if msg.ClientTypes, err = cmd.Flags().GetStringSlice(FlagClientTypes); err != nil {
+				return err
+			}
+			expiry, err := cmd.Flags().GetString(FlagExpiry)
+			if err != nil {
+				return err
+			}
+			if expiry != "" {
+				if msg.Expiry, err = time.Parse(time.RFC3339, expiry); err != nil {
+					return err
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagOperatorName, "", "name of the relayer operator")
+	cmd.Flags().String(FlagExpiry, "", "time (RFC3339) after which the relayer is no longer allowed, never expires if empty")
+	cmd.Flags().StringSlice(FlagClientIDs, []string{}, "comma separated client ids the relayer can update, all clients if empty")
+	cmd.Flags().StringSlice(FlagClientTypes, []string{}, "comma separated light client types the relayer can update, all types if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func RemoveRlyAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-rly [addr]",
+		Short:   "remove address from whitelist relayer",
+		Args:    cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		Example: fmt.Sprintf("%s tx transfermiddleware remove-rly [allowed_addr]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			allowedAddress := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+
+			msg := types.NewMsgRemoveRlyAddress(
+				fromAddress,
+				allowedAddress,
+			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
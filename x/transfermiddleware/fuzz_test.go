@@ -0,0 +1,123 @@
+package transfermiddleware_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	"github.com/stretchr/testify/require"
+
+	customibctesting "github.com/notional-labs/composable/v6/app/ibctesting"
+)
+
+const (
+	fuzzChannelID   = "channel-0"
+	fuzzAssetID     = "1"
+	fuzzNativeDenom = "ppica"
+)
+
+// setupFuzzChain returns a chain with ppica backed by the parachain channel
+// and the transfer stack of its IBC router
+func setupFuzzChain(t *testing.T) (*customibctesting.TestChain, porttypes.IBCModule) {
+	t.Helper()
+	coordinator := customibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(customibctesting.GetChainID(0))
+
+	voucher := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, fuzzChannelID, fuzzAssetID))
+	err := chain.TransferMiddleware().AddParachainIBCInfo(chain.GetContext(), voucher.IBCDenom(), fuzzChannelID, fuzzNativeDenom, fuzzAssetID)
+	require.NoError(t, err)
+
+	transferStack, ok := chain.App.GetIBCKeeper().Router.GetRoute(ibctransfertypes.ModuleName)
+	require.True(t, ok)
+
+	return chain, transferStack
+}
+
+func addFuzzPacketDataSeeds(f *testing.F) {
+	sender := sdk.AccAddress([]byte("sender")).String()
+	receiver := sdk.AccAddress([]byte("receiver")).String()
+
+	f.Add(fuzzAssetID, "1000", sender, receiver, "")
+	f.Add(fuzzAssetID, "-1000", sender, receiver, "")
+	f.Add(fuzzAssetID, "0", sender, receiver, "")
+	f.Add(fuzzAssetID, "1e6", sender, receiver, "")
+	f.Add(fuzzAssetID, "115792089237316195423570985008687907853269984665640564039457584007913129639936", sender, receiver, "")
+	f.Add("", "1000", sender, receiver, "")
+	f.Add("transfer/channel-0/"+fuzzAssetID, "1000", sender, receiver, "")
+	f.Add(fuzzAssetID, "1000", "", receiver, "")
+	f.Add(fuzzAssetID, "1000", sender, "not-an-address", "")
+	f.Add(fuzzAssetID, "1000", sender, receiver, `{"wasm":{}}`)
+}
+
+func FuzzOnRecvPacket(f *testing.F) {
+	addFuzzPacketDataSeeds(f)
+
+	f.Fuzz(func(t *testing.T, denom, amount, sender, receiver, memo string) {
+		chain, transferStack := setupFuzzChain(t)
+
+		data := ibctransfertypes.NewFungibleTokenPacketData(denom, amount, sender, receiver, memo)
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, ibctransfertypes.PortID, fuzzChannelID, ibctransfertypes.PortID, fuzzChannelID, clienttypes.NewHeight(1, 100), 0)
+
+		// the transfer app panics when unescrowing a denom which isn't a valid coin denom,
+		// tokens returning to the chain are out of the scope of the middleware
+		if ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+			t.Skip()
+		}
+
+		require.NotPanics(t, func() {
+			ack := transferStack.OnRecvPacket(chain.GetContext(), packet, chain.SenderAccount.GetAddress())
+			if data.ValidateBasic() != nil {
+				require.False(t, ack.Success())
+			}
+		})
+	})
+}
+
+func FuzzOnTimeoutAndAcknowledgementPacket(f *testing.F) {
+	addFuzzPacketDataSeeds(f)
+
+	f.Fuzz(func(t *testing.T, denom, amount, sender, receiver, memo string) {
+		// core IBC only delivers timeouts and acknowledgements of packets sent by the chain,
+		// which validated the packet data
+		data := ibctransfertypes.NewFungibleTokenPacketData(ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, fuzzChannelID, denom), amount, sender, receiver, memo)
+		if data.ValidateBasic() != nil {
+			t.Skip()
+		}
+
+		chain, transferStack := setupFuzzChain(t)
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, ibctransfertypes.PortID, fuzzChannelID, ibctransfertypes.PortID, fuzzChannelID, clienttypes.NewHeight(1, 100), 0)
+		errorAck := channeltypes.NewErrorAcknowledgement(ibctransfertypes.ErrInvalidAmount)
+
+		require.NotPanics(t, func() {
+			ctx, _ := chain.GetContext().CacheContext()
+			_ = transferStack.OnTimeoutPacket(ctx, packet, chain.SenderAccount.GetAddress())
+
+			ctx, _ = chain.GetContext().CacheContext()
+			_ = transferStack.OnAcknowledgementPacket(ctx, packet, errorAck.Acknowledgement(), chain.SenderAccount.GetAddress())
+		})
+	})
+}
+
+func FuzzSendPacket(f *testing.F) {
+	sender := sdk.AccAddress([]byte("sender")).String()
+	for _, amount := range []string{"1000", "-1000", "0", "abc"} {
+		f.Add(ibctransfertypes.NewFungibleTokenPacketData(fuzzNativeDenom, amount, sender, "receiver", "").GetBytes())
+	}
+	f.Add(ibctransfertypes.NewFungibleTokenPacketData(fuzzNativeDenom, "1000", "not-an-address", "receiver", "").GetBytes())
+	f.Add([]byte(`{"denom":"ppica"}`))
+	f.Add([]byte("{}"))
+	f.Add([]byte("not json"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		chain, _ := setupFuzzChain(t)
+
+		require.NotPanics(t, func() {
+			_, err := chain.TransferMiddleware().SendPacket(chain.GetContext(), nil, ibctransfertypes.PortID, fuzzChannelID, clienttypes.NewHeight(1, 100), 0, data)
+			// there is no channel to send the packet on
+			require.Error(t, err)
+		})
+	})
+}
@@ -8,12 +8,35 @@ import (
 
 // BeginBlocker of epochs module.
 func (k Keeper) BeginBlocker(ctx sdk.Context) {
-	// Iterate over remove list
+	// Collect the removals that passed the duration, the store can't be
+	// written while iterating over the remove list
+	var dueRemovals []types.RemoveParachainIBCTokenInfo
 	k.IterateRemoveListInfo(ctx, func(removeList types.RemoveParachainIBCTokenInfo) (stop bool) {
-		// If pass the duration, remove parachain token info
 		if ctx.BlockTime().After(removeList.RemoveTime) {
-			k.RemoveParachainIBCInfo(ctx, removeList.NativeDenom)
+			dueRemovals = append(dueRemovals, removeList)
 		}
 		return false
 	})
+
+	for _, removeList := range dueRemovals {
+		if k.hasOutstandingMintedSupply(ctx, removeList) {
+			continue
+		}
+		if err := k.RemoveParachainIBCInfo(ctx, removeList.NativeDenom, removeList.ChannelID); err != nil {
+			k.Logger(ctx).Error("failed to remove parachain token info", "native_denom", removeList.NativeDenom, "channel_id", removeList.ChannelID, "error", err)
+		}
+		k.DeleteParachainIBCInfoFromRemoveList(ctx, removeList.NativeDenom, removeList.ChannelID)
+	}
+}
+
+// Tokens refunded during the removal window were minted again, the removal
+// is postponed until they are sent back to the parachain
+func (k Keeper) hasOutstandingMintedSupply(ctx sdk.Context, removeList types.RemoveParachainIBCTokenInfo) bool {
+	for _, info := range k.getTokenInfosForRemoval(ctx, removeList.NativeDenom, removeList.ChannelID) {
+		if mintedSupply := k.GetMintedSupply(ctx, info.NativeDenom, info.ChannelID); mintedSupply.IsPositive() {
+			k.Logger(ctx).Info("postponing removal of parachain token info with outstanding minted supply", "native_denom", info.NativeDenom, "channel_id", info.ChannelID, "minted_supply", mintedSupply)
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/keeper"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+func TestRegisterBridgeToken(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+
+	msgServer := keeper.NewMsgServerImpl(app.TransferMiddlewareKeeper)
+	authority := "pica10556m38z4x6pqalr9rl5ytf3cff8q46nf36090" // gov module account
+
+	msg := types.NewMsgRegisterBridgeToken(authority, "channel-5", "uusdc", "uusdc.noble", math.NewInt(1000), "usdc", 6, "USDC")
+
+	_, err := msgServer.RegisterBridgeToken(sdk.WrapSDKContext(ctx), types.NewMsgRegisterBridgeToken("invalid", "channel-5", "uusdc", "uusdc.noble", math.ZeroInt(), "", 0, ""))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	res, err := msgServer.RegisterBridgeToken(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, types.GetBridgeTokenIBCDenom("channel-5", "uusdc"), res.IbcDenom)
+
+	info, found := app.TransferMiddlewareKeeper.GetParachainIBCTokenInfoByIBCDenom(ctx, res.IbcDenom)
+	require.True(t, found)
+	require.Equal(t, types.ParachainIBCTokenInfo{
+		IbcDenom:    res.IbcDenom,
+		ChannelID:   "channel-5",
+		NativeDenom: "uusdc.noble",
+		RemoteDenom: "uusdc",
+		SupplyCap:   math.NewInt(1000),
+		Primary:     true,
+	}, info)
+	require.True(t, app.TransferMiddlewareKeeper.GetMintedSupply(ctx, "uusdc.noble", "channel-5").IsZero())
+
+	// the native denom is described in bank
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, "uusdc.noble")
+	require.True(t, found)
+	require.Equal(t, "usdc", metadata.Display)
+	require.Equal(t, "USDC", metadata.Symbol)
+	require.Equal(t, uint32(6), metadata.DenomUnits[1].Exponent)
+
+	// a remote denom is registered once per channel, bridge tokens have no asset id to collide on
+	_, err = msgServer.RegisterBridgeToken(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrMultipleMapping)
+	_, err = app.TransferMiddlewareKeeper.AddBridgeTokenInfo(ctx, "channel-6", "uusdc", "uusdc.axelar", math.ZeroInt())
+	require.NoError(t, err)
+
+	_, err = msgServer.UpdateBridgeToken(sdk.WrapSDKContext(ctx), types.NewMsgUpdateBridgeToken(authority, "uusdc.noble", "channel-5", math.NewInt(2000), true))
+	require.NoError(t, err)
+	info, _ = app.TransferMiddlewareKeeper.GetParachainIBCTokenInfo(ctx, "uusdc.noble", "channel-5")
+	require.Equal(t, math.NewInt(2000), info.SupplyCap)
+	require.True(t, info.Paused)
+
+	_, err = msgServer.UpdateBridgeToken(sdk.WrapSDKContext(ctx), types.NewMsgUpdateBridgeToken(authority, "uusdc.noble", "channel-7", math.ZeroInt(), false))
+	require.ErrorIs(t, err, types.NotRegisteredNativeDenom)
+
+	// bridge tokens are exported with the parachain token infos
+	require.NoError(t, app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, "ibc-test", "channel-0", "ppica", "1"))
+	genState := app.TransferMiddlewareKeeper.ExportGenesis(ctx)
+	require.Len(t, genState.TokenInfos, 3)
+	require.NoError(t, types.ValidateGenesis(*genState))
+}
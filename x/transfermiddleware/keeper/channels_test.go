@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/keeper"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+func TestMultipleChannelsPerNativeDenom(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+
+	msgServer := keeper.NewMsgServerImpl(app.TransferMiddlewareKeeper)
+	authority := "pica10556m38z4x6pqalr9rl5ytf3cff8q46nf36090" // gov module account
+
+	err := app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, "ibc-test", "channel-0", "pica", "1")
+	require.NoError(t, err)
+	err = app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, "ibc-test2", "channel-1", "pica", "2")
+	require.NoError(t, err)
+	err = app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, "ibc-test3", "channel-1", "pica", "3")
+	require.ErrorIs(t, err, types.ErrMultipleMapping)
+
+	// the first channel registered is the primary channel
+	require.Len(t, app.TransferMiddlewareKeeper.GetParachainIBCTokenInfosByNativeDenom(ctx, "pica"), 2)
+	require.Equal(t, "channel-0", app.TransferMiddlewareKeeper.GetParachainIBCTokenInfoByNativeDenom(ctx, "pica").ChannelID)
+	info, found := app.TransferMiddlewareKeeper.GetParachainIBCTokenInfo(ctx, "pica", "channel-1")
+	require.True(t, found)
+	require.Equal(t, "ibc-test2", info.IbcDenom)
+	require.False(t, info.Primary)
+
+	res, err := app.TransferMiddlewareKeeper.ParaTokenInfo(sdk.WrapSDKContext(ctx), &types.QueryParaTokenInfoRequest{NativeDenom: "pica"})
+	require.NoError(t, err)
+	require.Equal(t, "channel-0", res.ChannelID)
+	require.Len(t, res.Infos, 2)
+
+	// the primary channel can't be removed on its own while other channels remain
+	_, err = msgServer.RemoveParachainIBCTokenInfo(sdk.WrapSDKContext(ctx), types.NewMsgRemoveParachainIBCTokenInfo(authority, "pica", "channel-0"))
+	require.ErrorIs(t, err, types.ErrPrimaryChannel)
+
+	_, err = msgServer.SetPrimaryChannel(sdk.WrapSDKContext(ctx), types.NewMsgSetPrimaryChannel(authority, "pica", "channel-1"))
+	require.NoError(t, err)
+	require.Equal(t, "channel-1", app.TransferMiddlewareKeeper.GetParachainIBCTokenInfoByNativeDenom(ctx, "pica").ChannelID)
+	info, _ = app.TransferMiddlewareKeeper.GetParachainIBCTokenInfo(ctx, "pica", "channel-0")
+	require.False(t, info.Primary)
+
+	// the single token info fields of the query follow the primary channel
+	res, err = app.TransferMiddlewareKeeper.ParaTokenInfo(sdk.WrapSDKContext(ctx), &types.QueryParaTokenInfoRequest{NativeDenom: "pica"})
+	require.NoError(t, err)
+	require.Equal(t, "channel-1", res.ChannelID)
+	require.Equal(t, "ibc-test2", res.IbcDenom)
+	require.Equal(t, "2", res.AssetId)
+
+	_, err = msgServer.SetPrimaryChannel(sdk.WrapSDKContext(ctx), types.NewMsgSetPrimaryChannel(authority, "pica", "channel-7"))
+	require.ErrorIs(t, err, types.NotRegisteredNativeDenom)
+
+	// removing a channel keeps the other channels of the native denom
+	_, err = msgServer.RemoveParachainIBCTokenInfo(sdk.WrapSDKContext(ctx), types.NewMsgRemoveParachainIBCTokenInfo(authority, "pica", "channel-0"))
+	require.NoError(t, err)
+	err = app.TransferMiddlewareKeeper.RemoveParachainIBCInfo(ctx, "pica", "channel-0")
+	require.NoError(t, err)
+
+	_, found = app.TransferMiddlewareKeeper.GetParachainIBCTokenInfo(ctx, "pica", "channel-0")
+	require.False(t, found)
+	require.False(t, app.TransferMiddlewareKeeper.HasParachainIBCTokenInfoByAssetID(ctx, "1"))
+	require.Empty(t, app.TransferMiddlewareKeeper.GetNativeDenomByIBCDenomSecondaryIndex(ctx, "ibc-test"))
+	require.Equal(t, "channel-1", app.TransferMiddlewareKeeper.GetParachainIBCTokenInfoByNativeDenom(ctx, "pica").ChannelID)
+
+	// the remaining channel becomes primary when the primary channel is removed
+	err = app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, "ibc-test", "channel-0", "pica", "1")
+	require.NoError(t, err)
+	err = app.TransferMiddlewareKeeper.RemoveParachainIBCInfo(ctx, "pica", "channel-1")
+	require.NoError(t, err)
+	info = app.TransferMiddlewareKeeper.GetParachainIBCTokenInfoByNativeDenom(ctx, "pica")
+	require.Equal(t, "channel-0", info.ChannelID)
+	require.True(t, info.Primary)
+}
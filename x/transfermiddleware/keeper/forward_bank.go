@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+// ForwardBankKeeper is the bank keeper of the packet forward middleware. When a forwarded
+// packet fails, the middleware mints or burns the native tokens of a token info along with
+// the vouchers locked in the escrow of its channel, ForwardBankKeeper keeps the minted supply
+// in line with the vouchers it moves in or out of escrow.
+type ForwardBankKeeper struct {
+	types.BankKeeper
+
+	keeper Keeper
+}
+
+// NewForwardBankKeeper returns a bank keeper tracking the escrow of the packet forward middleware.
+func NewForwardBankKeeper(keeper Keeper, bankKeeper types.BankKeeper) ForwardBankKeeper {
+	return ForwardBankKeeper{
+		BankKeeper: bankKeeper,
+		keeper:     keeper,
+	}
+}
+
+// SendCoinsFromModuleToAccount increases the minted supply of the vouchers sent to escrow.
+func (fbk ForwardBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := fbk.BankKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
+		return err
+	}
+
+	fbk.keeper.iterateEscrowedVouchers(ctx, recipientAddr, amt, func(info types.ParachainIBCTokenInfo, amount math.Int) {
+		fbk.keeper.increaseMintedSupply(ctx, info.NativeDenom, info.ChannelID, amount)
+	})
+	return nil
+}
+
+// SendCoinsFromAccountToModule decreases the minted supply of the vouchers taken from escrow.
+func (fbk ForwardBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := fbk.BankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt); err != nil {
+		return err
+	}
+
+	fbk.keeper.iterateEscrowedVouchers(ctx, senderAddr, amt, func(info types.ParachainIBCTokenInfo, amount math.Int) {
+		fbk.keeper.decreaseMintedSupply(ctx, info.NativeDenom, info.ChannelID, amount)
+	})
+	return nil
+}
@@ -10,7 +10,23 @@ import (
 // InitGenesis initializes the transfermiddleware module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, tokenInfo := range genState.TokenInfos {
-		k.AddParachainIBCInfo(ctx, tokenInfo.IbcDenom, tokenInfo.ChannelID, tokenInfo.NativeDenom, tokenInfo.AssetId)
+		if err := k.addParachainIBCTokenInfo(ctx, tokenInfo); err != nil {
+			panic(err)
+		}
+	}
+	for _, tokenInfo := range genState.TokenInfos {
+		if tokenInfo.Primary {
+			if err := k.SetParachainIBCPrimaryChannel(ctx, tokenInfo.NativeDenom, tokenInfo.ChannelID); err != nil {
+				panic(err)
+			}
+		}
+	}
+	// the vouchers escrowed on a channel back its minted supply unless it is exported
+	for _, mintedSupply := range genState.MintedSupplies {
+		k.setMintedSupply(ctx, mintedSupply.NativeDenom, mintedSupply.ChannelID, mintedSupply.Amount)
+	}
+	for _, removal := range genState.PendingRemovals {
+		k.setRemoveListInfo(ctx, removal)
 	}
 	k.SetParams(ctx, genState.Params)
 }
@@ -19,7 +35,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 func (k Keeper) IterateParaTokenInfos(ctx sdk.Context, fn func(index int64, info types.ParachainIBCTokenInfo) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 
-	iterator := sdk.KVStorePrefixIterator(store, types.KeyParachainIBCTokenInfoByAssetID)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyParachainIBCTokenInfoByNativeDenom)
 	defer iterator.Close()
 
 	i := int64(0)
@@ -42,13 +58,27 @@ func (k Keeper) IterateParaTokenInfos(ctx sdk.Context, fn func(index int64, info
 // ExportGenesis returns the x/transfermiddleware module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	infos := []types.ParachainIBCTokenInfo{}
+	mintedSupplies := []types.MintedSupply{}
 	k.IterateParaTokenInfos(ctx, func(index int64, info types.ParachainIBCTokenInfo) (stop bool) {
 		infos = append(infos, info)
+		mintedSupplies = append(mintedSupplies, types.MintedSupply{
+			NativeDenom: info.NativeDenom,
+			ChannelID:   info.ChannelID,
+			Amount:      k.GetMintedSupply(ctx, info.NativeDenom, info.ChannelID),
+		})
+		return false
+	})
+
+	removals := []types.RemoveParachainIBCTokenInfo{}
+	k.IterateRemoveListInfo(ctx, func(removeInfo types.RemoveParachainIBCTokenInfo) (stop bool) {
+		removals = append(removals, removeInfo)
 		return false
 	})
 
 	return &types.GenesisState{
-		TokenInfos: infos,
-		Params:     k.GetParams(ctx),
+		TokenInfos:      infos,
+		Params:          k.GetParams(ctx),
+		MintedSupplies:  mintedSupplies,
+		PendingRemovals: removals,
 	}
 }
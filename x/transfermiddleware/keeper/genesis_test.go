@@ -2,7 +2,12 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"github.com/stretchr/testify/require"
 
 	helpers "github.com/notional-labs/composable/v6/app/helpers"
@@ -31,6 +36,13 @@ func TestTFMInitGenesis(t *testing.T) {
 	require.Equal(t, "pica", info.NativeDenom)
 	require.Equal(t, "ibc-test", info.IbcDenom)
 	require.Equal(t, "channel-0", info.ChannelID)
+
+	// a token info which can't be added panics
+	require.Panics(t, func() {
+		app.TransferMiddlewareKeeper.InitGenesis(ctx, types.GenesisState{
+			TokenInfos: []types.ParachainIBCTokenInfo{{IbcDenom: "ibc-test", ChannelID: "channel-1", NativeDenom: "poke", AssetId: "2"}},
+		})
+	})
 }
 
 func TestTFMExportGenesis(t *testing.T) {
@@ -80,3 +92,41 @@ func TestIterateParaTokenInfos(t *testing.T) {
 	require.Equal(t, "channel-1", infos[1].ChannelID)
 	require.Equal(t, "ibc-test2", infos[1].IbcDenom)
 }
+
+func TestTFMGenesisMintedSupplyAndRemovals(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+
+	// vouchers in escrow don't override the exported minted supply
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ibc-test", 1000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, escrowAddress, coins))
+
+	removeTime := ctx.BlockTime().Add(time.Hour).UTC()
+	genState := types.GenesisState{
+		TokenInfos: []types.ParachainIBCTokenInfo{
+			{IbcDenom: "ibc-test", ChannelID: "channel-0", NativeDenom: "pica", AssetId: "1"},
+			{IbcDenom: "ibc-test2", ChannelID: "channel-1", NativeDenom: "poke", AssetId: "2"},
+		},
+		Params: types.DefaultParams(),
+		MintedSupplies: []types.MintedSupply{
+			{NativeDenom: "pica", ChannelID: "channel-0", Amount: math.NewInt(400)},
+			{NativeDenom: "poke", ChannelID: "channel-1", Amount: math.ZeroInt()},
+		},
+		PendingRemovals: []types.RemoveParachainIBCTokenInfo{
+			{NativeDenom: "poke", ChannelID: "channel-1", RemoveTime: removeTime},
+		},
+	}
+	require.NoError(t, types.ValidateGenesis(genState))
+	app.TransferMiddlewareKeeper.InitGenesis(ctx, genState)
+
+	require.Equal(t, math.NewInt(400), app.TransferMiddlewareKeeper.GetMintedSupply(ctx, "pica", "channel-0"))
+	removal, found := app.TransferMiddlewareKeeper.GetRemoveListInfo(ctx, "poke", "channel-1")
+	require.True(t, found)
+	require.Equal(t, removeTime, removal.RemoveTime)
+
+	exported := app.TransferMiddlewareKeeper.ExportGenesis(ctx)
+	require.Equal(t, genState.MintedSupplies, exported.MintedSupplies)
+	require.Equal(t, genState.PendingRemovals, exported.PendingRemovals)
+}
@@ -3,25 +3,109 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
 )
 
 func (k Keeper) ParaTokenInfo(c context.Context, req *types.QueryParaTokenInfoRequest) (*types.QueryParaTokenInfoResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	info := k.GetParachainIBCTokenInfoByNativeDenom(ctx, req.NativeDenom)
+	infos := k.GetParachainIBCTokenInfosByNativeDenom(ctx, req.NativeDenom)
+
+	// the single token info fields describe the primary channel
+	var primary types.ParachainIBCTokenInfo
+	for _, info := range infos {
+		if info.Primary {
+			primary = info
+			break
+		}
+	}
 
 	return &types.QueryParaTokenInfoResponse{
-		IbcDenom:    info.IbcDenom,
-		NativeDenom: info.NativeDenom,
-		ChannelID:   info.ChannelID,
-		AssetId:     info.AssetId,
+		IbcDenom:    primary.IbcDenom,
+		NativeDenom: primary.NativeDenom,
+		ChannelID:   primary.ChannelID,
+		AssetId:     primary.AssetId,
+		Infos:       infos,
 	}, nil
 }
 
+func (k Keeper) AllParaTokenInfos(c context.Context, req *types.QueryAllParaTokenInfosRequest) (*types.QueryAllParaTokenInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var infos []types.ParaTokenInfoEntry
+
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyParachainIBCTokenInfoByNativeDenom)
+
+	pageRes, err := sdkquery.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var info types.ParachainIBCTokenInfo
+		if err := k.cdc.Unmarshal(value, &info); err != nil {
+			return err
+		}
+		infos = append(infos, k.getParaTokenInfoEntry(ctx, info))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllParaTokenInfosResponse{
+		Infos:      infos,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) ParaTokenInfoByAssetID(c context.Context, req *types.QueryParaTokenInfoByAssetIDRequest) (*types.QueryParaTokenInfoByAssetIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasParachainIBCTokenInfoByAssetID(ctx, req.AssetId) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "asset id %s", req.AssetId)
+	}
+
+	return &types.QueryParaTokenInfoByAssetIDResponse{
+		Info: k.getParaTokenInfoEntry(ctx, k.GetParachainIBCTokenInfoByAssetID(ctx, req.AssetId)),
+	}, nil
+}
+
+func (k Keeper) ParaTokenInfoByIBCDenom(c context.Context, req *types.QueryParaTokenInfoByIBCDenomRequest) (*types.QueryParaTokenInfoByIBCDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	info, found := k.GetParachainIBCTokenInfoByIBCDenom(ctx, req.IbcDenom)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "ibc denom %s", req.IbcDenom)
+	}
+
+	return &types.QueryParaTokenInfoByIBCDenomResponse{
+		Info: k.getParaTokenInfoEntry(ctx, info),
+	}, nil
+}
+
+func (k Keeper) getParaTokenInfoEntry(ctx sdk.Context, info types.ParachainIBCTokenInfo) types.ParaTokenInfoEntry {
+	return types.ParaTokenInfoEntry{
+		Info:             info,
+		EscrowedVouchers: k.GetEscrowedVouchers(ctx, info),
+		MintedSupply:     sdk.NewCoin(info.NativeDenom, k.GetMintedSupply(ctx, info.NativeDenom, info.ChannelID)),
+	}
+}
+
 func (k Keeper) EscrowAddress(_ context.Context, req *types.QueryEscrowAddressRequest) (*types.QueryEscrowAddressResponse, error) {
 	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, req.ChannelID)
 
@@ -33,17 +117,18 @@ func (k Keeper) EscrowAddress(_ context.Context, req *types.QueryEscrowAddressRe
 func (k Keeper) RelayerAccount(c context.Context, req *types.QueryIBCWhiteListRequest) (*types.QueryIBCWhiteListResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	var whiteList []string
+	var (
+		whiteList []string
+		relayers  []types.AllowedRelayer
+	)
 
 	store := ctx.KVStore(k.storeKey)
 	prefixStore := prefix.NewStore(store, types.KeyRlyAddress)
 
-	iter := prefixStore.Iterator(nil, nil)
-	defer iter.Close()
-
-	pageRes, err := sdkquery.FilteredPaginate(prefixStore, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+	pageRes, err := sdkquery.FilteredPaginate(prefixStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
 		if accumulate {
 			whiteList = append(whiteList, string(key))
+			relayers = append(relayers, k.unmarshalAllowedRelayer(string(key), value))
 		}
 		return true, nil
 	})
@@ -53,6 +138,53 @@ func (k Keeper) RelayerAccount(c context.Context, req *types.QueryIBCWhiteListRe
 
 	return &types.QueryIBCWhiteListResponse{
 		WhiteList:  whiteList,
+		Relayers:   relayers,
 		Pagination: pageRes,
 	}, nil
 }
+
+func (k Keeper) PendingRemovals(c context.Context, req *types.QueryPendingRemovalsRequest) (*types.QueryPendingRemovalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pendingRemovals []types.RemoveParachainIBCTokenInfo
+
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyParachainIBCTokenRemoveListByNativeDenom)
+
+	pageRes, err := sdkquery.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var removeInfo types.RemoveParachainIBCTokenInfo
+		if err := k.cdc.Unmarshal(value, &removeInfo); err != nil {
+			return err
+		}
+		pendingRemovals = append(pendingRemovals, removeInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingRemovalsResponse{
+		PendingRemovals: pendingRemovals,
+		Pagination:      pageRes,
+	}, nil
+}
+
+func (k Keeper) Parity(c context.Context, req *types.QueryParityRequest) (*types.QueryParityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	info, found := k.resolveParachainIBCTokenInfo(ctx, req.NativeDenom, req.ChannelID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.NotRegisteredNativeDenom, "%s", req.NativeDenom)
+	}
+
+	return &types.QueryParityResponse{
+		Parity: k.GetEscrowParity(ctx, info),
+	}, nil
+}
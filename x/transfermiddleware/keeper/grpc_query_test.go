@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+func TestQueryParaTokenInfos(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// vouchers escrowed before the registration back the existing native supply
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ibc-test2", 1000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-1"), coins))
+
+	err := app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, "ibc-test", "channel-0", "pica", "1")
+	require.NoError(t, err)
+	err = app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, "ibc-test2", "channel-1", "pica", "2")
+	require.NoError(t, err)
+	err = app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, "ibc-test3", "channel-2", "poke", "3")
+	require.NoError(t, err)
+
+	res, err := app.TransferMiddlewareKeeper.AllParaTokenInfos(goCtx, &types.QueryAllParaTokenInfosRequest{
+		Pagination: &sdkquery.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Infos, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.Equal(t, "1", res.Infos[0].Info.AssetId)
+	require.Equal(t, sdk.NewInt64Coin("ibc-test2", 1000), res.Infos[1].EscrowedVouchers)
+	require.Equal(t, sdk.NewInt64Coin("pica", 1000), res.Infos[1].MintedSupply)
+
+	res, err = app.TransferMiddlewareKeeper.AllParaTokenInfos(goCtx, &types.QueryAllParaTokenInfosRequest{
+		Pagination: &sdkquery.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Infos, 1)
+	require.Equal(t, "poke", res.Infos[0].Info.NativeDenom)
+
+	byAssetID, err := app.TransferMiddlewareKeeper.ParaTokenInfoByAssetID(goCtx, &types.QueryParaTokenInfoByAssetIDRequest{AssetId: "2"})
+	require.NoError(t, err)
+	require.Equal(t, "channel-1", byAssetID.Info.Info.ChannelID)
+	require.Equal(t, sdk.NewInt64Coin("pica", 1000), byAssetID.Info.MintedSupply)
+
+	_, err = app.TransferMiddlewareKeeper.ParaTokenInfoByAssetID(goCtx, &types.QueryParaTokenInfoByAssetIDRequest{AssetId: "4"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	byIBCDenom, err := app.TransferMiddlewareKeeper.ParaTokenInfoByIBCDenom(goCtx, &types.QueryParaTokenInfoByIBCDenomRequest{IbcDenom: "ibc-test2"})
+	require.NoError(t, err)
+	require.Equal(t, byAssetID.Info, byIBCDenom.Info)
+
+	_, err = app.TransferMiddlewareKeeper.ParaTokenInfoByIBCDenom(goCtx, &types.QueryParaTokenInfoByIBCDenomRequest{IbcDenom: "ibc-test4"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = app.TransferMiddlewareKeeper.AllParaTokenInfos(goCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = app.TransferMiddlewareKeeper.ParaTokenInfoByAssetID(goCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = app.TransferMiddlewareKeeper.ParaTokenInfoByIBCDenom(goCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = app.TransferMiddlewareKeeper.PendingRemovals(goCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = app.TransferMiddlewareKeeper.Parity(goCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -17,7 +17,10 @@ import (
 
 func (keeper Keeper) hasParachainIBCTokenInfo(ctx sdk.Context, nativeDenom string) bool {
 	store := ctx.KVStore(keeper.storeKey)
-	return store.Has(types.GetKeyParachainIBCTokenInfoByNativeDenom(nativeDenom))
+	iterator := sdk.KVStorePrefixIterator(store, types.GetKeyParachainIBCTokenInfoByNativeDenomPrefix(nativeDenom))
+	defer iterator.Close()
+
+	return iterator.Valid()
 }
 
 func (keeper Keeper) handleOverrideSendPacketTransferLogic(
@@ -26,12 +29,10 @@ func (keeper Keeper) handleOverrideSendPacketTransferLogic(
 	sourcePort, sourceChannel string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
-	data []byte,
+	fungibleTokenPacketData transfertypes.FungibleTokenPacketData,
+	parachainInfo types.ParachainIBCTokenInfo,
 ) (sequence uint64, err error) {
-	var fungibleTokenPacketData transfertypes.FungibleTokenPacketData
-
-	err = transfertypes.ModuleCdc.UnmarshalJSON(data, &fungibleTokenPacketData)
-	if err != nil {
+	if err := fungibleTokenPacketData.ValidateBasic(); err != nil {
 		return 0, err
 	}
 	sender, err := sdk.AccAddressFromBech32(fungibleTokenPacketData.Sender)
@@ -39,17 +40,12 @@ func (keeper Keeper) handleOverrideSendPacketTransferLogic(
 		return 0, err
 	}
 
-	// check if denom in fungibleTokenPacketData is native denom in parachain info and
-	parachainInfo := keeper.GetParachainIBCTokenInfoByNativeDenom(ctx, fungibleTokenPacketData.Denom)
-
 	// burn native token in escrow address
 	transferAmount, ok := sdk.NewIntFromString(fungibleTokenPacketData.Amount)
-
-	// TODO: remove this panic and replace by err hanlde
 	if !ok {
-		panic("cannot parse string amount")
+		return 0, errors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", fungibleTokenPacketData.Amount)
 	}
-	nativeTransferToken := sdk.NewCoin(fungibleTokenPacketData.Denom, transferAmount)
+	nativeTransferToken := sdk.NewCoin(parachainInfo.NativeDenom, transferAmount)
 	ibcTransferToken := sdk.NewCoin(parachainInfo.IbcDenom, transferAmount)
 
 	escrowAddress := transfertypes.GetEscrowAddress(sourcePort, sourceChannel)
@@ -59,7 +55,11 @@ func (keeper Keeper) handleOverrideSendPacketTransferLogic(
 	}
 	// burn native token
 	// Get Coin from excrow address
-	keeper.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(nativeTransferToken))
+	err = keeper.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(nativeTransferToken))
+	if err != nil {
+		return 0, err
+	}
+	keeper.decreaseMintedSupply(ctx, nativeTransferToken.Denom, sourceChannel, transferAmount)
 
 	// release lock IBC token and send it to sender
 	// TODO: should we use a module address for this ?
@@ -111,13 +111,17 @@ func (keeper Keeper) SendPacket(
 	}
 
 	// check if denom in fungibleTokenPacketData is native denom in parachain info and
-	parachainInfo := keeper.GetParachainIBCTokenInfoByNativeDenom(ctx, fungibleTokenPacketData.Denom)
-
-	if parachainInfo.ChannelID != sourceChannel || parachainInfo.NativeDenom != fungibleTokenPacketData.Denom {
+	// the packet is sent on one of the channels backing it, sends on other channels are
+	// not routed through the primary channel and transfer the native denom itself
+	parachainInfo, found := keeper.GetParachainIBCTokenInfo(ctx, fungibleTokenPacketData.Denom, sourceChannel)
+	if !found {
 		return keeper.ICS4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
+	if parachainInfo.Paused {
+		return 0, errors.Wrapf(types.ErrBridgeTokenPaused, "%s on %s", parachainInfo.NativeDenom, sourceChannel)
+	}
 
-	return keeper.handleOverrideSendPacketTransferLogic(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	return keeper.handleOverrideSendPacketTransferLogic(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, fungibleTokenPacketData, parachainInfo)
 }
 
 // WriteAcknowledgement wraps IBC ICS4Wrapper WriteAcknowledgement function.
@@ -161,6 +165,10 @@ func (keeper *Keeper) OnAcknowledgementPacket(
 }
 
 func (keeper Keeper) refundToken(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
 	// parse the denomination from the full denom path
 	trace := transfertypes.ParseDenomTrace(data.Denom)
 	// parse the transfer amount
@@ -181,28 +189,29 @@ func (keeper Keeper) refundToken(ctx sdk.Context, packet channeltypes.Packet, da
 		return nil
 	}
 	nativeDenom := keeper.GetNativeDenomByIBCDenomSecondaryIndex(ctx, trace.IBCDenom())
-	paraTokenInfo := keeper.GetParachainIBCTokenInfoByNativeDenom(ctx, nativeDenom)
 
 	// only trigger if source channel is from parachain.
-	if !keeper.hasParachainIBCTokenInfo(ctx, nativeDenom) {
+	paraTokenInfo, found := keeper.GetParachainIBCTokenInfo(ctx, nativeDenom, packet.GetSourceChannel())
+	if !found {
 		return nil
 	}
 
-	if packet.GetSourceChannel() == paraTokenInfo.ChannelID {
+	if paraTokenInfo.IbcDenom == trace.IBCDenom() {
 		nativeToken := sdk.NewCoin(paraTokenInfo.NativeDenom, transferAmount)
 		// send IBC token to escrow address ibc token
 		escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, paraTokenInfo.ChannelID)
 		if err := keeper.bankKeeper.SendCoins(ctx, sender, escrowAddress, sdk.NewCoins(token)); err != nil {
-			panic(fmt.Sprintf("unable to send coins from account to module despite previously minting coins to module account: %v", err))
+			return errors.Wrapf(err, "unable to send refunded coins from %s to escrow", sender)
 		}
 
 		// mint native token and send back to sender
 		if err := keeper.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(nativeToken)); err != nil {
-			return err
+			return errors.Wrap(err, "failed to mint refunded native tokens")
 		}
+		keeper.increaseMintedSupply(ctx, nativeToken.Denom, paraTokenInfo.ChannelID, transferAmount)
 
 		if err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(nativeToken)); err != nil {
-			panic(fmt.Sprintf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
+			return errors.Wrapf(err, "unable to send refunded native tokens to %s", sender)
 		}
 	}
 
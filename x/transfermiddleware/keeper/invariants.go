@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+// RegisterInvariants registers the transfermiddleware module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-mint-parity", EscrowMintParityInvariant(k))
+}
+
+// AllInvariants runs all invariants of the transfermiddleware module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return EscrowMintParityInvariant(k)(ctx)
+	}
+}
+
+// EscrowMintParityInvariant checks that the native tokens minted for every registered
+// token info are exactly the IBC vouchers locked in escrow and exist in the bank supply.
+// The bank keeper rejects vouchers sent directly to the escrow of their channel, so the
+// escrow only changes with the native tokens minted and burned by the middleware.
+func EscrowMintParityInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateParaTokenInfos(ctx, func(_ int64, info types.ParachainIBCTokenInfo) (stop bool) {
+			parity := k.GetEscrowParity(ctx, info)
+			if !parity.Delta.IsZero() {
+				count++
+				msg += fmt.Sprintf("\t%s native supply doesn't match the escrowed vouchers - Native supply: %v, Escrowed: %v\n",
+					info.NativeDenom, parity.NativeSupply, parity.EscrowedVouchers)
+			}
+			if parity.NativeSupply.Amount.GT(parity.TotalNativeSupply.Amount) {
+				count++
+				msg += fmt.Sprintf("\t%s native supply exceeds the bank supply - Native supply: %v, Bank supply: %v\n",
+					info.NativeDenom, parity.NativeSupply, parity.TotalNativeSupply)
+			}
+			return false
+		})
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "escrow mint parity",
+			fmt.Sprintf("found %d escrow parity violations\n%s", count, msg)), broken
+	}
+}
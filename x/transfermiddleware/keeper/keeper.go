@@ -1,15 +1,18 @@
 package keeper
 
 import (
+	"bytes"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
@@ -60,64 +63,177 @@ func NewKeeper(
 	}
 }
 
-// TODO: testing
 // AddParachainIBCTokenInfo add new parachain token information token to chain state.
+// A native denom can be backed by several channels, the first channel registered is the primary channel.
 func (keeper Keeper) AddParachainIBCInfo(ctx sdk.Context, ibcDenom, channelID, nativeDenom, assetID string) error {
+	return keeper.addParachainIBCTokenInfo(ctx, types.ParachainIBCTokenInfo{
+		IbcDenom:    ibcDenom,
+		ChannelID:   channelID,
+		NativeDenom: nativeDenom,
+		AssetId:     assetID,
+		RemoteDenom: assetID,
+	})
+}
+
+// AddBridgeTokenInfo adds a bridge token minting the native denom in exchange for the vouchers of the
+// remote denom received on the channel, the native supply minted on the channel is capped by supplyCap
+// unless it is zero.
+func (keeper Keeper) AddBridgeTokenInfo(ctx sdk.Context, channelID, remoteDenom, nativeDenom string, supplyCap math.Int) (types.ParachainIBCTokenInfo, error) {
+	info := types.ParachainIBCTokenInfo{
+		IbcDenom:    types.GetBridgeTokenIBCDenom(channelID, remoteDenom),
+		ChannelID:   channelID,
+		NativeDenom: nativeDenom,
+		RemoteDenom: remoteDenom,
+		SupplyCap:   supplyCap,
+	}
+	if err := keeper.addParachainIBCTokenInfo(ctx, info); err != nil {
+		return info, err
+	}
+
+	info, _ = keeper.GetParachainIBCTokenInfo(ctx, nativeDenom, channelID)
+	return info, nil
+}
+
+func (keeper Keeper) addParachainIBCTokenInfo(ctx sdk.Context, info types.ParachainIBCTokenInfo) error {
 	store := ctx.KVStore(keeper.storeKey)
-	if store.Has(types.GetKeyParachainIBCTokenInfoByAssetID(assetID)) {
+	if info.AssetId != "" && store.Has(types.GetKeyParachainIBCTokenInfoByAssetID(info.AssetId)) {
 		return errorsmod.Wrapf(types.ErrMultipleMapping, "duplicate assetID")
 	}
-	if store.Has(types.GetKeyNativeDenomAndIbcSecondaryIndex(ibcDenom)) {
+	if store.Has(types.GetKeyNativeDenomAndIbcSecondaryIndex(info.IbcDenom)) {
 		return errorsmod.Wrapf(types.ErrMultipleMapping, "duplicate IBC denom")
 	}
-	if store.Has(types.GetKeyParachainIBCTokenInfoByNativeDenom(nativeDenom)) {
-		return errorsmod.Wrapf(types.ErrMultipleMapping, "duplicate native denom")
+	if store.Has(types.GetKeyParachainIBCTokenInfoByNativeDenom(info.NativeDenom, info.ChannelID)) {
+		return errorsmod.Wrapf(types.ErrMultipleMapping, "duplicate native denom on channel %s", info.ChannelID)
 	}
 
-	info := types.ParachainIBCTokenInfo{
-		IbcDenom:    ibcDenom,
-		ChannelID:   channelID,
-		NativeDenom: nativeDenom,
-		AssetId:     assetID,
+	if info.RemoteDenom == "" {
+		info.RemoteDenom = info.AssetId
+	}
+	if info.SupplyCap.IsNil() {
+		info.SupplyCap = math.ZeroInt()
 	}
+	info.Primary = !keeper.hasParachainIBCTokenInfo(ctx, info.NativeDenom)
 
-	bz, err := keeper.cdc.Marshal(&info)
-	if err != nil {
-		return err
+	keeper.setParachainIBCTokenInfo(ctx, info)
+	store.Set(types.GetKeyNativeDenomAndIbcSecondaryIndex(info.IbcDenom), []byte(info.NativeDenom))
+	keeper.initMintedSupply(ctx, info)
+	return nil
+}
+
+// UpdateBridgeTokenInfo changes the supply cap and the pause of the token info of a native denom on a channel.
+func (keeper Keeper) UpdateBridgeTokenInfo(ctx sdk.Context, nativeDenom, channelID string, supplyCap math.Int, paused bool) error {
+	info, found := keeper.GetParachainIBCTokenInfo(ctx, nativeDenom, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.NotRegisteredNativeDenom, "%s on %s", nativeDenom, channelID)
 	}
 
-	store.Set(types.GetKeyParachainIBCTokenInfoByNativeDenom(nativeDenom), bz)
-	store.Set(types.GetKeyParachainIBCTokenInfoByAssetID(assetID), bz)
-	store.Set(types.GetKeyNativeDenomAndIbcSecondaryIndex(ibcDenom), []byte(nativeDenom))
+	info.SupplyCap = supplyCap
+	info.Paused = paused
+	keeper.setParachainIBCTokenInfo(ctx, info)
 	return nil
 }
 
-// TODO: testing
+// RegisterDenomMetadata sets the bank metadata of a native denom unless it already has one.
+func (keeper Keeper) RegisterDenomMetadata(ctx sdk.Context, metadata banktypes.Metadata) bool {
+	if _, found := keeper.bankKeeper.GetDenomMetaData(ctx, metadata.Base); found {
+		return false
+	}
+	keeper.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return true
+}
+
+func (keeper Keeper) setParachainIBCTokenInfo(ctx sdk.Context, info types.ParachainIBCTokenInfo) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&info)
+
+	store.Set(types.GetKeyParachainIBCTokenInfoByNativeDenom(info.NativeDenom, info.ChannelID), bz)
+	if info.AssetId != "" {
+		store.Set(types.GetKeyParachainIBCTokenInfoByAssetID(info.AssetId), bz)
+	}
+}
+
+func (keeper Keeper) deleteParachainIBCTokenInfo(ctx sdk.Context, info types.ParachainIBCTokenInfo) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetKeyParachainIBCTokenInfoByNativeDenom(info.NativeDenom, info.ChannelID))
+	if info.AssetId != "" {
+		store.Delete(types.GetKeyParachainIBCTokenInfoByAssetID(info.AssetId))
+	}
+	store.Delete(types.GetKeyNativeDenomAndIbcSecondaryIndex(info.IbcDenom))
+	store.Delete(types.GetKeyMintedSupplyByNativeDenom(info.NativeDenom, info.ChannelID))
+}
+
+// SetParachainIBCPrimaryChannel makes a channel the primary channel of a native denom.
+func (keeper Keeper) SetParachainIBCPrimaryChannel(ctx sdk.Context, nativeDenom, channelID string) error {
+	info, found := keeper.GetParachainIBCTokenInfo(ctx, nativeDenom, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.NotRegisteredNativeDenom, "%s on %s", nativeDenom, channelID)
+	}
+	if keeper.isPendingRemoval(ctx, nativeDenom, channelID) {
+		return errorsmod.Wrapf(types.ErrTokenPendingRemoval, "%s on %s", nativeDenom, channelID)
+	}
+
+	for _, other := range keeper.GetParachainIBCTokenInfosByNativeDenom(ctx, nativeDenom) {
+		if other.Primary && other.ChannelID != channelID {
+			other.Primary = false
+			keeper.setParachainIBCTokenInfo(ctx, other)
+		}
+	}
+
+	info.Primary = true
+	keeper.setParachainIBCTokenInfo(ctx, info)
+	return nil
+}
+
+// getTokenInfosForRemoval returns the token info of the channel, or the token infos
+// of all the channels of the native denom if the channel is empty.
+func (keeper Keeper) getTokenInfosForRemoval(ctx sdk.Context, nativeDenom, channelID string) []types.ParachainIBCTokenInfo {
+	if channelID == "" {
+		return keeper.GetParachainIBCTokenInfosByNativeDenom(ctx, nativeDenom)
+	}
+
+	info, found := keeper.GetParachainIBCTokenInfo(ctx, nativeDenom, channelID)
+	if !found {
+		return nil
+	}
+	return []types.ParachainIBCTokenInfo{info}
+}
+
 // AddParachainIBCInfoToRemoveList add parachain token information token to remove list.
-func (keeper Keeper) AddParachainIBCInfoToRemoveList(ctx sdk.Context, nativeDenom string) (time.Time, error) {
+// All the channels of the native denom are removed if the channel is empty.
+func (keeper Keeper) AddParachainIBCInfoToRemoveList(ctx sdk.Context, nativeDenom, channelID string) (time.Time, error) {
 	params := keeper.GetParams(ctx)
-	store := ctx.KVStore(keeper.storeKey)
-	if !store.Has(types.GetKeyParachainIBCTokenInfoByNativeDenom(nativeDenom)) {
+
+	infos := keeper.getTokenInfosForRemoval(ctx, nativeDenom, channelID)
+	if len(infos) == 0 {
 		return time.Time{}, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "Token %v info not found", nativeDenom)
 	}
+	for _, info := range infos {
+		// The escrowed vouchers would be orphaned if the native tokens minted against them
+		// were still in circulation when the token info is removed
+		if mintedSupply := keeper.GetMintedSupply(ctx, info.NativeDenom, info.ChannelID); mintedSupply.IsPositive() {
+			return time.Time{}, errorsmod.Wrapf(types.ErrOutstandingMintedSupply, "%v%s on %s must be sent back to the parachain first", mintedSupply, nativeDenom, info.ChannelID)
+		}
+	}
+	if channelID != "" && infos[0].Primary && len(keeper.GetParachainIBCTokenInfosByNativeDenom(ctx, nativeDenom)) > 1 {
+		return time.Time{}, errorsmod.Wrapf(types.ErrPrimaryChannel, "set another primary channel for %s before removing %s", nativeDenom, channelID)
+	}
 
 	// Add to remove list
 	removeTime := ctx.BlockTime().Add(params.Duration)
-	removeToken := types.RemoveParachainIBCTokenInfo{
+	keeper.setRemoveListInfo(ctx, types.RemoveParachainIBCTokenInfo{
 		NativeDenom: nativeDenom,
 		RemoveTime:  removeTime,
-	}
-
-	bz, err := keeper.cdc.Marshal(&removeToken)
-	if err != nil {
-		return time.Time{}, err
-	}
-
-	store.Set(types.GetKeyParachainIBCTokenRemoveListByNativeDenom(nativeDenom), bz)
+		ChannelID:   channelID,
+	})
 	return removeTime, nil
 }
 
-// TODO: testing
+func (keeper Keeper) setRemoveListInfo(ctx sdk.Context, removeInfo types.RemoveParachainIBCTokenInfo) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&removeInfo)
+	store.Set(types.GetKeyParachainIBCTokenRemoveListByNativeDenom(removeInfo.NativeDenom, removeInfo.ChannelID), bz)
+}
+
 // IterateRemoveListInfo iterate all parachain token in remove list.
 func (keeper Keeper) IterateRemoveListInfo(ctx sdk.Context, cb func(removeInfo types.RemoveParachainIBCTokenInfo) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -133,29 +249,89 @@ func (keeper Keeper) IterateRemoveListInfo(ctx sdk.Context, cb func(removeInfo t
 	}
 }
 
-// TODO: testing
+// GetRemoveListInfo returns the pending removal of a native denom on a channel, if any.
+// The channel is empty for the removal of all the channels of the native denom.
+func (keeper Keeper) GetRemoveListInfo(ctx sdk.Context, nativeDenom, channelID string) (types.RemoveParachainIBCTokenInfo, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetKeyParachainIBCTokenRemoveListByNativeDenom(nativeDenom, channelID))
+	if bz == nil {
+		return types.RemoveParachainIBCTokenInfo{}, false
+	}
+
+	var removeInfo types.RemoveParachainIBCTokenInfo
+	keeper.cdc.MustUnmarshal(bz, &removeInfo)
+	return removeInfo, true
+}
+
+// isPendingRemoval returns true if the token info of the channel is in the remove list,
+// on its own or with all the channels of the native denom
+func (keeper Keeper) isPendingRemoval(ctx sdk.Context, nativeDenom, channelID string) bool {
+	if _, found := keeper.GetRemoveListInfo(ctx, nativeDenom, channelID); found {
+		return true
+	}
+	_, found := keeper.GetRemoveListInfo(ctx, nativeDenom, "")
+	return found
+}
+
+// DeleteParachainIBCInfoFromRemoveList removes a native denom from the remove list.
+func (keeper Keeper) DeleteParachainIBCInfoFromRemoveList(ctx sdk.Context, nativeDenom, channelID string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetKeyParachainIBCTokenRemoveListByNativeDenom(nativeDenom, channelID))
+}
+
+// CancelParachainIBCInfoRemoval cancels a pending removal of parachain token information.
+func (keeper Keeper) CancelParachainIBCInfoRemoval(ctx sdk.Context, nativeDenom, channelID string) error {
+	if _, found := keeper.GetRemoveListInfo(ctx, nativeDenom, channelID); !found {
+		return errorsmod.Wrapf(types.ErrNotInRemoveList, "token %v", nativeDenom)
+	}
+
+	keeper.DeleteParachainIBCInfoFromRemoveList(ctx, nativeDenom, channelID)
+	return nil
+}
+
 // RemoveParachainIBCTokenInfo remove parachain token information from chain state.
-func (keeper Keeper) RemoveParachainIBCInfo(ctx sdk.Context, nativeDenom string) error {
-	if !keeper.hasParachainIBCTokenInfo(ctx, nativeDenom) {
+// All the channels of the native denom are removed if the channel is empty.
+func (keeper Keeper) RemoveParachainIBCInfo(ctx sdk.Context, nativeDenom, channelID string) error {
+	infos := keeper.getTokenInfosForRemoval(ctx, nativeDenom, channelID)
+	if len(infos) == 0 {
 		return types.NotRegisteredNativeDenom
 	}
 
-	// get the IBCdenom
-	tokenInfo := keeper.GetParachainIBCTokenInfoByNativeDenom(ctx, nativeDenom)
-	ibcDenom := tokenInfo.IbcDenom
-	assetID := tokenInfo.AssetId
+	for _, info := range infos {
+		keeper.deleteParachainIBCTokenInfo(ctx, info)
+	}
 
-	store := ctx.KVStore(keeper.storeKey)
-	store.Delete(types.GetKeyParachainIBCTokenInfoByNativeDenom(nativeDenom))
-	store.Delete(types.GetKeyParachainIBCTokenInfoByAssetID(assetID))
-	store.Delete(types.GetKeyNativeDenomAndIbcSecondaryIndex(ibcDenom))
+	// Another channel becomes primary if the primary channel was removed
+	remaining := keeper.GetParachainIBCTokenInfosByNativeDenom(ctx, nativeDenom)
+	if len(remaining) != 0 && !keeper.GetParachainIBCTokenInfoByNativeDenom(ctx, nativeDenom).Primary {
+		remaining[0].Primary = true
+		keeper.setParachainIBCTokenInfo(ctx, remaining[0])
+	}
 
 	return nil
 }
 
+// SetAllowRlyAddress allows an address to submit client updates for all clients without expiry
 func (keeper Keeper) SetAllowRlyAddress(ctx sdk.Context, rlyAddress string) {
+	keeper.SetAllowedRelayer(ctx, types.AllowedRelayer{
+		Address:     rlyAddress,
+		AddedHeight: ctx.BlockHeight(),
+	})
+}
+
+func (keeper Keeper) SetAllowedRelayer(ctx sdk.Context, relayer types.AllowedRelayer) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetKeyByRlyAddress(relayer.Address), keeper.cdc.MustMarshal(&relayer))
+}
+
+func (keeper Keeper) GetAllowedRelayer(ctx sdk.Context, rlyAddress string) (types.AllowedRelayer, bool) {
 	store := ctx.KVStore(keeper.storeKey)
-	store.Set(types.GetKeyByRlyAddress(rlyAddress), []byte{1})
+	bz := store.Get(types.GetKeyByRlyAddress(rlyAddress))
+	if bz == nil {
+		return types.AllowedRelayer{}, false
+	}
+
+	return keeper.unmarshalAllowedRelayer(rlyAddress, bz), true
 }
 
 func (keeper Keeper) DeleteAllowRlyAddress(ctx sdk.Context, rlyAddress string) {
@@ -170,25 +346,51 @@ func (keeper Keeper) HasAllowRlyAddress(ctx sdk.Context, rlyAddress string) bool
 	return store.Has(key)
 }
 
+// IsRelayerAllowed returns true if the address is in the allow list, has not expired
+// and its scope includes the client
+func (keeper Keeper) IsRelayerAllowed(ctx sdk.Context, rlyAddress, clientID string) bool {
+	relayer, found := keeper.GetAllowedRelayer(ctx, rlyAddress)
+	if !found {
+		return false
+	}
+
+	return !relayer.IsExpired(ctx.BlockTime()) && relayer.AllowsClient(clientID)
+}
+
 func (keeper Keeper) IterateAllowRlyAddress(ctx sdk.Context, cb func(rlyAddress string) (stop bool)) {
+	keeper.IterateAllowedRelayers(ctx, func(relayer types.AllowedRelayer) (stop bool) {
+		return cb(relayer.Address)
+	})
+}
+
+func (keeper Keeper) IterateAllowedRelayers(ctx sdk.Context, cb func(relayer types.AllowedRelayer) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
 	prefixStore := prefix.NewStore(store, types.KeyRlyAddress)
 	iterator := sdk.KVStorePrefixIterator(prefixStore, nil)
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		rlyAddress := string(iterator.Key())
-		if cb(rlyAddress) {
+		relayer := keeper.unmarshalAllowedRelayer(string(iterator.Key()), iterator.Value())
+		if cb(relayer) {
 			break
 		}
 	}
 }
 
-func (keeper Keeper) HasParachainIBCTokenInfoByNativeDenom(ctx sdk.Context, nativeDenom string) bool {
-	store := ctx.KVStore(keeper.storeKey)
-	key := types.GetKeyParachainIBCTokenInfoByNativeDenom(nativeDenom)
+// addresses allowed before relayers carried metadata are stored with a marker value,
+// they are allowed to update all clients without expiry
+func (keeper Keeper) unmarshalAllowedRelayer(rlyAddress string, bz []byte) types.AllowedRelayer {
+	if bytes.Equal(bz, types.LegacyRlyAddressValue) {
+		return types.AllowedRelayer{Address: rlyAddress}
+	}
 
-	return store.Has(key)
+	relayer := types.AllowedRelayer{}
+	keeper.cdc.MustUnmarshal(bz, &relayer)
+	return relayer
+}
+
+func (keeper Keeper) HasParachainIBCTokenInfoByNativeDenom(ctx sdk.Context, nativeDenom string) bool {
+	return keeper.hasParachainIBCTokenInfo(ctx, nativeDenom)
 }
 
 func (keeper Keeper) HasParachainIBCTokenInfoByAssetID(ctx sdk.Context, assetID string) bool {
@@ -198,15 +400,43 @@ func (keeper Keeper) HasParachainIBCTokenInfoByAssetID(ctx sdk.Context, assetID
 	return store.Has(key)
 }
 
-// TODO: testing
-// GetParachainIBCTokenInfo add new information about parachain token to chain state.
+// GetParachainIBCTokenInfoByNativeDenom returns the token info of the primary channel of a native denom.
 func (keeper Keeper) GetParachainIBCTokenInfoByNativeDenom(ctx sdk.Context, nativeDenom string) (info types.ParachainIBCTokenInfo) {
+	for _, channelInfo := range keeper.GetParachainIBCTokenInfosByNativeDenom(ctx, nativeDenom) {
+		if channelInfo.Primary {
+			return channelInfo
+		}
+	}
+
+	return info
+}
+
+// GetParachainIBCTokenInfo returns the token info of a native denom on a channel.
+func (keeper Keeper) GetParachainIBCTokenInfo(ctx sdk.Context, nativeDenom, channelID string) (info types.ParachainIBCTokenInfo, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetKeyParachainIBCTokenInfoByNativeDenom(nativeDenom, channelID))
+	if bz == nil {
+		return info, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+// GetParachainIBCTokenInfosByNativeDenom returns the token infos of all the channels backing a native denom.
+func (keeper Keeper) GetParachainIBCTokenInfosByNativeDenom(ctx sdk.Context, nativeDenom string) []types.ParachainIBCTokenInfo {
 	store := ctx.KVStore(keeper.storeKey)
-	bz := store.Get(types.GetKeyParachainIBCTokenInfoByNativeDenom(nativeDenom))
+	iterator := sdk.KVStorePrefixIterator(store, types.GetKeyParachainIBCTokenInfoByNativeDenomPrefix(nativeDenom))
+	defer iterator.Close()
 
-	keeper.cdc.Unmarshal(bz, &info)
+	infos := []types.ParachainIBCTokenInfo{}
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.ParachainIBCTokenInfo
+		keeper.cdc.MustUnmarshal(iterator.Value(), &info)
+		infos = append(infos, info)
+	}
 
-	return info
+	return infos
 }
 
 func (keeper Keeper) GetParachainIBCTokenInfoByAssetID(ctx sdk.Context, assetID string) (info types.ParachainIBCTokenInfo) {
@@ -218,6 +448,22 @@ func (keeper Keeper) GetParachainIBCTokenInfoByAssetID(ctx sdk.Context, assetID
 	return info
 }
 
+// GetParachainIBCTokenInfoByIBCDenom returns the token info of an IBC denom, using the secondary index.
+func (keeper Keeper) GetParachainIBCTokenInfoByIBCDenom(ctx sdk.Context, ibcDenom string) (info types.ParachainIBCTokenInfo, found bool) {
+	nativeDenom := keeper.GetNativeDenomByIBCDenomSecondaryIndex(ctx, ibcDenom)
+	if nativeDenom == "" {
+		return info, false
+	}
+
+	for _, channelInfo := range keeper.GetParachainIBCTokenInfosByNativeDenom(ctx, nativeDenom) {
+		if channelInfo.IbcDenom == ibcDenom {
+			return channelInfo, true
+		}
+	}
+
+	return info, false
+}
+
 func (keeper Keeper) GetNativeDenomByIBCDenomSecondaryIndex(ctx sdk.Context, ibcDenom string) string {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := store.Get(types.GetKeyNativeDenomAndIbcSecondaryIndex(ibcDenom))
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/keeper"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+func TestAddParachainIBCTokenInfoDenomMetadata(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+
+	msgServer := keeper.NewMsgServerImpl(app.TransferMiddlewareKeeper)
+	authority := "pica10556m38z4x6pqalr9rl5ytf3cff8q46nf36090" // gov module account
+	ibcDenom := func(assetID string) string {
+		return ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, "channel-0", assetID)).IBCDenom()
+	}
+
+	msg := types.NewMsgAddParachainIBCTokenInfo(authority, ibcDenom("1"), "ppica", "1", "channel-0")
+	msg.Display = "pica"
+	msg.Exponent = 12
+	_, err := msgServer.AddParachainIBCTokenInfo(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, "ppica")
+	require.True(t, found)
+	require.Equal(t, types.NewDenomMetadata("ppica", "pica", 12, "PICA"), metadata)
+	require.NoError(t, metadata.Validate())
+
+	// without a display the bank metadata is left as is
+	_, err = msgServer.AddParachainIBCTokenInfo(sdk.WrapSDKContext(ctx), types.NewMsgAddParachainIBCTokenInfo(authority, ibcDenom("2"), "pdemo", "2", "channel-0"))
+	require.NoError(t, err)
+	_, found = app.BankKeeper.GetDenomMetaData(ctx, "pdemo")
+	require.False(t, found)
+}
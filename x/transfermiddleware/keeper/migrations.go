@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v3 "github.com/notional-labs/composable/v6/x/transfermiddleware/migrations/v3"
+	v4 "github.com/notional-labs/composable/v6/x/transfermiddleware/migrations/v4"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 starts tracking the native supply minted against escrow for the registered token infos.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.IterateParaTokenInfos(ctx, func(_ int64, info types.ParachainIBCTokenInfo) (stop bool) {
+		m.keeper.initMintedSupply(ctx, info)
+		return false
+	})
+	return nil
+}
+
+// Migrate2to3 keys the token infos, minted supplies and pending removals by native denom and channel.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate3to4 turns the token infos into entries of the bridge-token registry.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -38,6 +39,11 @@ func (ms msgServer) AddParachainIBCTokenInfo(goCtx context.Context, req *types.M
 		return nil, err
 	}
 
+	// the metadata is written in the same transaction as the mapping
+	if req.Display != "" {
+		ms.RegisterDenomMetadata(ctx, types.NewDenomMetadata(req.NativeDenom, req.Display, req.Exponent, req.Symbol))
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventAddParachainIBCTokenInfo,
@@ -56,7 +62,7 @@ func (ms msgServer) RemoveParachainIBCTokenInfo(goCtx context.Context, req *type
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
 	}
 
-	removeTime, err := ms.AddParachainIBCInfoToRemoveList(ctx, req.NativeDenom)
+	removeTime, err := ms.AddParachainIBCInfoToRemoveList(ctx, req.NativeDenom, req.ChannelID)
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +71,7 @@ func (ms msgServer) RemoveParachainIBCTokenInfo(goCtx context.Context, req *type
 		sdk.NewEvent(
 			types.EventRemoveParachainIBCTokenInfo,
 			sdk.NewAttribute(types.AttributeKeyNativeDenom, req.NativeDenom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, req.ChannelID),
 			sdk.NewAttribute(types.AttributeKeyRemoveTime, removeTime.String()),
 		),
 	})
@@ -72,6 +79,130 @@ func (ms msgServer) RemoveParachainIBCTokenInfo(goCtx context.Context, req *type
 	return &types.MsgRemoveParachainIBCTokenInfoResponse{}, nil
 }
 
+func (ms msgServer) CancelRemoveParachainIBCTokenInfo(goCtx context.Context, req *types.MsgCancelRemoveParachainIBCTokenInfo) (*types.MsgCancelRemoveParachainIBCTokenInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	if err := ms.CancelParachainIBCInfoRemoval(ctx, req.NativeDenom, req.ChannelID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventCancelRemoveParachainIBCTokenInfo,
+			sdk.NewAttribute(types.AttributeKeyNativeDenom, req.NativeDenom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, req.ChannelID),
+		),
+	})
+
+	return &types.MsgCancelRemoveParachainIBCTokenInfoResponse{}, nil
+}
+
+func (ms msgServer) Reconcile(goCtx context.Context, req *types.MsgReconcile) (*types.MsgReconcileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	parity, err := ms.ReconcileMintedSupply(ctx, req.NativeDenom, req.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ms.Logger(ctx).Info("reconciled escrow parity", "native_denom", req.NativeDenom, "delta", parity.Delta)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventReconcileEscrowParity,
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+			sdk.NewAttribute(types.AttributeKeyNativeDenom, parity.NativeDenom),
+			sdk.NewAttribute(types.AttributeKeyIbcDenom, parity.IbcDenom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, parity.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyEscrowed, parity.EscrowedVouchers.String()),
+			sdk.NewAttribute(types.AttributeKeyPrevSupply, parity.NativeSupply.String()),
+			sdk.NewAttribute(types.AttributeKeyNewSupply, sdk.NewCoin(parity.NativeDenom, parity.EscrowedVouchers.Amount).String()),
+			sdk.NewAttribute(types.AttributeKeyDelta, parity.Delta.String()),
+		),
+	})
+
+	return &types.MsgReconcileResponse{Parity: parity}, nil
+}
+
+func (ms msgServer) SetPrimaryChannel(goCtx context.Context, req *types.MsgSetPrimaryChannel) (*types.MsgSetPrimaryChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	if err := ms.SetParachainIBCPrimaryChannel(ctx, req.NativeDenom, req.ChannelID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventSetPrimaryChannel,
+			sdk.NewAttribute(types.AttributeKeyNativeDenom, req.NativeDenom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, req.ChannelID),
+		),
+	})
+
+	return &types.MsgSetPrimaryChannelResponse{}, nil
+}
+
+func (ms msgServer) RegisterBridgeToken(goCtx context.Context, req *types.MsgRegisterBridgeToken) (*types.MsgRegisterBridgeTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	info, err := ms.AddBridgeTokenInfo(ctx, req.ChannelID, req.RemoteDenom, req.NativeDenom, req.SupplyCap)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Display != "" {
+		ms.RegisterDenomMetadata(ctx, types.NewDenomMetadata(req.NativeDenom, req.Display, req.Exponent, req.Symbol))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventRegisterBridgeToken,
+			sdk.NewAttribute(types.AttributeKeyNativeDenom, info.NativeDenom),
+			sdk.NewAttribute(types.AttributeKeyIbcDenom, info.IbcDenom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, info.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyRemoteDenom, info.RemoteDenom),
+			sdk.NewAttribute(types.AttributeKeySupplyCap, info.SupplyCap.String()),
+		),
+	})
+
+	return &types.MsgRegisterBridgeTokenResponse{IbcDenom: info.IbcDenom}, nil
+}
+
+func (ms msgServer) UpdateBridgeToken(goCtx context.Context, req *types.MsgUpdateBridgeToken) (*types.MsgUpdateBridgeTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	if err := ms.UpdateBridgeTokenInfo(ctx, req.NativeDenom, req.ChannelID, req.SupplyCap, req.Paused); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventUpdateBridgeToken,
+			sdk.NewAttribute(types.AttributeKeyNativeDenom, req.NativeDenom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, req.ChannelID),
+			sdk.NewAttribute(types.AttributeKeySupplyCap, req.SupplyCap.String()),
+			sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(req.Paused)),
+		),
+	})
+
+	return &types.MsgUpdateBridgeTokenResponse{}, nil
+}
+
 func (ms msgServer) AddRlyAddress(goCtx context.Context, req *types.MsgAddRlyAddress) (*types.MsgAddRlyAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if ms.authority != req.Authority {
@@ -83,14 +214,46 @@ func (ms msgServer) AddRlyAddress(goCtx context.Context, req *types.MsgAddRlyAdd
 		return nil, fmt.Errorf("address %v already registry in allow list", req.RlyAddress)
 	}
 
-	ms.SetAllowRlyAddress(ctx, req.RlyAddress)
+	ms.SetAllowedRelayer(ctx, types.AllowedRelayer{
+		Address:      req.RlyAddress,
+		OperatorName: req.OperatorName,
+		AddedHeight:  ctx.BlockHeight(),
+		Expiry:       req.Expiry,
+		ClientIDs:    req.ClientIDs,
+		ClientTypes:  req.ClientTypes,
+	})
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventAddRlyToAllowList,
 			sdk.NewAttribute(types.AttributeKeyRlyAdress, req.RlyAddress),
+			sdk.NewAttribute(types.AttributeKeyOperator, req.OperatorName),
+			sdk.NewAttribute(types.AttributeKeyExpiry, req.Expiry.String()),
 		),
 	})
 
 	return &types.MsgAddRlyAddressResponse{}, nil
 }
+
+func (ms msgServer) RemoveRlyAddress(goCtx context.Context, req *types.MsgRemoveRlyAddress) (*types.MsgRemoveRlyAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	found := ms.HasAllowRlyAddress(ctx, req.RlyAddress)
+	if !found {
+		return nil, errors.Wrapf(types.ErrRlyAddressNotFound, "address %v", req.RlyAddress)
+	}
+
+	ms.DeleteAllowRlyAddress(ctx, req.RlyAddress)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventRemoveRlyFromAllowList,
+			sdk.NewAttribute(types.AttributeKeyRlyAdress, req.RlyAddress),
+		),
+	})
+
+	return &types.MsgRemoveRlyAddressResponse{}, nil
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/keeper"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+func TestEscrowParityAndReconcile(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+
+	msgServer := keeper.NewMsgServerImpl(app.TransferMiddlewareKeeper)
+	authority := "pica10556m38z4x6pqalr9rl5ytf3cff8q46nf36090" // gov module account
+	ibcDenom := "ibc/C053D637CCA2A2BA030E2C5EE1B28A16F71CCB0E45E8BE52766DC1B241B77878"
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+
+	sendVouchersToEscrow := func(amount int64) {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, amount))
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, escrowAddress, coins))
+	}
+	queryParity := func() types.EscrowParity {
+		res, err := app.TransferMiddlewareKeeper.Parity(sdk.WrapSDKContext(ctx), &types.QueryParityRequest{NativeDenom: sdk.DefaultBondDenom})
+		require.NoError(t, err)
+		return res.Parity
+	}
+
+	// vouchers escrowed before the registration back the existing native supply
+	sendVouchersToEscrow(1000)
+	err := app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, ibcDenom, "channel-0", sdk.DefaultBondDenom, "1")
+	require.NoError(t, err)
+
+	parity := queryParity()
+	require.Equal(t, sdk.NewInt64Coin(ibcDenom, 1000), parity.EscrowedVouchers)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), parity.NativeSupply)
+	require.Equal(t, app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom), parity.TotalNativeSupply)
+	require.True(t, parity.Delta.IsZero())
+
+	_, err = msgServer.Reconcile(sdk.WrapSDKContext(ctx), types.NewMsgReconcile(authority, sdk.DefaultBondDenom, ""))
+	require.ErrorIs(t, err, types.ErrNoParityDrift)
+
+	// vouchers can't be sent directly to the escrow of their channel
+	sender := sdk.AccAddress([]byte("sender"))
+	vouchers := sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 500))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, vouchers))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, vouchers))
+	bankMsgServer := bankkeeper.NewMsgServerImpl(app.BankKeeper)
+	_, err = bankMsgServer.Send(sdk.WrapSDKContext(ctx), banktypes.NewMsgSend(sender, escrowAddress, vouchers))
+	require.ErrorIs(t, err, types.ErrEscrowDeposit)
+	_, err = bankMsgServer.MultiSend(sdk.WrapSDKContext(ctx), banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(sender, vouchers)},
+		[]banktypes.Output{banktypes.NewOutput(escrowAddress, vouchers)},
+	))
+	require.ErrorIs(t, err, types.ErrEscrowDeposit)
+	require.True(t, queryParity().Delta.IsZero())
+
+	// a surplus in escrow breaks the invariant
+	sendVouchersToEscrow(500)
+	require.Equal(t, sdk.NewInt(500), queryParity().Delta)
+	_, broken := keeper.AllInvariants(app.TransferMiddlewareKeeper)(ctx)
+	require.True(t, broken)
+
+	// only the authority can reconcile
+	_, err = msgServer.Reconcile(sdk.WrapSDKContext(ctx), types.NewMsgReconcile(sdk.AccAddress([]byte("random")).String(), sdk.DefaultBondDenom, ""))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	res, err := msgServer.Reconcile(sdk.WrapSDKContext(ctx), types.NewMsgReconcile(authority, sdk.DefaultBondDenom, ""))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(500), res.Parity.Delta)
+	require.Equal(t, sdk.NewInt(1500), app.TransferMiddlewareKeeper.GetMintedSupply(ctx, sdk.DefaultBondDenom, "channel-0"))
+	require.True(t, queryParity().Delta.IsZero())
+	_, broken = keeper.AllInvariants(app.TransferMiddlewareKeeper)(ctx)
+	require.False(t, broken)
+
+	// vouchers leaving the escrow without burning native tokens break the invariant
+	err = app.BankKeeper.SendCoins(ctx, escrowAddress, sdk.AccAddress([]byte("random")), sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 100)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(-100), queryParity().Delta)
+	_, broken = keeper.AllInvariants(app.TransferMiddlewareKeeper)(ctx)
+	require.True(t, broken)
+
+	_, err = app.TransferMiddlewareKeeper.Parity(sdk.WrapSDKContext(ctx), &types.QueryParityRequest{NativeDenom: "unknown"})
+	require.ErrorIs(t, err, types.NotRegisteredNativeDenom)
+}
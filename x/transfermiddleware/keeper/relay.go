@@ -10,6 +10,10 @@ import (
 )
 
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
 	// decode the receiver address
 	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
 	if err != nil {
@@ -43,14 +47,28 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	voucherDenom := denomTrace.IBCDenom()
 	voucher := sdk.NewCoin(voucherDenom, transferAmount)
 
-	paraTokenInfo := k.GetParachainIBCTokenInfoByAssetID(ctx, data.Denom)
-
-	if k.GetNativeDenomByIBCDenomSecondaryIndex(ctx, denomTrace.IBCDenom()) != paraTokenInfo.NativeDenom {
+	// the voucher denom identifies the bridge token of the remote denom on the channel
+	paraTokenInfo, found := k.GetParachainIBCTokenInfoByIBCDenom(ctx, voucherDenom)
+	if !found {
 		return nil
 	}
 
 	// lock ibc token if dstChannel is paraChannel
 	if packet.GetDestChannel() == paraTokenInfo.ChannelID {
+		// no new tokens are minted against escrow during the removal window
+		if k.isPendingRemoval(ctx, paraTokenInfo.NativeDenom, paraTokenInfo.ChannelID) {
+			return errorsmod.Wrapf(types.ErrTokenPendingRemoval, "%s", paraTokenInfo.NativeDenom)
+		}
+		if paraTokenInfo.Paused {
+			return errorsmod.Wrapf(types.ErrBridgeTokenPaused, "%s on %s", paraTokenInfo.NativeDenom, paraTokenInfo.ChannelID)
+		}
+		if paraTokenInfo.HasSupplyCap() {
+			mintedSupply := k.GetMintedSupply(ctx, paraTokenInfo.NativeDenom, paraTokenInfo.ChannelID)
+			if mintedSupply.Add(transferAmount).GT(paraTokenInfo.SupplyCap) {
+				return errorsmod.Wrapf(types.ErrSupplyCapExceeded, "minting %s%s over the cap %s", transferAmount, paraTokenInfo.NativeDenom, paraTokenInfo.SupplyCap)
+			}
+		}
+
 		// escrow ibc token
 		escrowAddress := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
 
@@ -69,6 +87,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		); err != nil {
 			return errorsmod.Wrap(err, "failed to mint IBC tokens")
 		}
+		k.increaseMintedSupply(ctx, denom, paraTokenInfo.ChannelID, transferAmount)
 
 		// send to receiver
 		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/keeper"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+func TestLegacyAllowRlyAddress(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+
+	rlyAddress := sdk.AccAddress([]byte("legacy-relayer")).String()
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Set(types.GetKeyByRlyAddress(rlyAddress), types.LegacyRlyAddressValue)
+
+	relayer, found := app.TransferMiddlewareKeeper.GetAllowedRelayer(ctx, rlyAddress)
+	require.True(t, found)
+	require.Equal(t, types.AllowedRelayer{Address: rlyAddress}, relayer)
+	require.True(t, app.TransferMiddlewareKeeper.HasAllowRlyAddress(ctx, rlyAddress))
+	require.True(t, app.TransferMiddlewareKeeper.IsRelayerAllowed(ctx, rlyAddress, "08-wasm-0"))
+}
+
+func TestIsRelayerAllowed(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+
+	rlyAddress := sdk.AccAddress([]byte("relayer")).String()
+	require.False(t, app.TransferMiddlewareKeeper.IsRelayerAllowed(ctx, rlyAddress, "08-wasm-0"))
+
+	app.TransferMiddlewareKeeper.SetAllowedRelayer(ctx, types.AllowedRelayer{
+		Address:     rlyAddress,
+		Expiry:      ctx.BlockTime().Add(time.Hour),
+		ClientTypes: []string{"08-wasm"},
+	})
+
+	require.True(t, app.TransferMiddlewareKeeper.IsRelayerAllowed(ctx, rlyAddress, "08-wasm-0"))
+	require.False(t, app.TransferMiddlewareKeeper.IsRelayerAllowed(ctx, rlyAddress, "07-tendermint-0"))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.False(t, app.TransferMiddlewareKeeper.IsRelayerAllowed(ctx, rlyAddress, "08-wasm-0"))
+}
+
+func TestAddAndRemoveRlyAddress(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	ctx = ctx.WithBlockHeight(10)
+
+	msgServer := keeper.NewMsgServerImpl(app.TransferMiddlewareKeeper)
+	authority := "pica10556m38z4x6pqalr9rl5ytf3cff8q46nf36090" // gov module account
+	rlyAddress := sdk.AccAddress([]byte("relayer")).String()
+
+	addMsg := types.NewMsgAddRlyAddress(authority, rlyAddress)
+	addMsg.OperatorName = "operator"
+	addMsg.ClientIDs = []string{"08-wasm-0"}
+	_, err := msgServer.AddRlyAddress(sdk.WrapSDKContext(ctx), addMsg)
+	require.NoError(t, err)
+
+	relayer, found := app.TransferMiddlewareKeeper.GetAllowedRelayer(ctx, rlyAddress)
+	require.True(t, found)
+	require.Equal(t, "operator", relayer.OperatorName)
+	require.Equal(t, int64(10), relayer.AddedHeight)
+	require.Equal(t, []string{"08-wasm-0"}, relayer.ClientIDs)
+
+	// Only the authority can remove a relayer
+	_, err = msgServer.RemoveRlyAddress(sdk.WrapSDKContext(ctx), types.NewMsgRemoveRlyAddress(rlyAddress, rlyAddress))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.RemoveRlyAddress(sdk.WrapSDKContext(ctx), types.NewMsgRemoveRlyAddress(authority, rlyAddress))
+	require.NoError(t, err)
+	require.False(t, app.TransferMiddlewareKeeper.HasAllowRlyAddress(ctx, rlyAddress))
+
+	_, err = msgServer.RemoveRlyAddress(sdk.WrapSDKContext(ctx), types.NewMsgRemoveRlyAddress(authority, rlyAddress))
+	require.ErrorIs(t, err, types.ErrRlyAddressNotFound)
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/keeper"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+func TestCancelRemoveParachainIBCTokenInfo(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+
+	msgServer := keeper.NewMsgServerImpl(app.TransferMiddlewareKeeper)
+	authority := "pica10556m38z4x6pqalr9rl5ytf3cff8q46nf36090" // gov module account
+
+	err := app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, "ibc-test", "channel-0", "pica", "1")
+	require.NoError(t, err)
+
+	_, err = msgServer.CancelRemoveParachainIBCTokenInfo(sdk.WrapSDKContext(ctx), types.NewMsgCancelRemoveParachainIBCTokenInfo(authority, "pica", ""))
+	require.ErrorIs(t, err, types.ErrNotInRemoveList)
+
+	_, err = msgServer.RemoveParachainIBCTokenInfo(sdk.WrapSDKContext(ctx), types.NewMsgRemoveParachainIBCTokenInfo(authority, "pica", ""))
+	require.NoError(t, err)
+
+	res, err := app.TransferMiddlewareKeeper.PendingRemovals(sdk.WrapSDKContext(ctx), &types.QueryPendingRemovalsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.PendingRemovals, 1)
+	require.Equal(t, "pica", res.PendingRemovals[0].NativeDenom)
+
+	// Only the authority can cancel a removal
+	_, err = msgServer.CancelRemoveParachainIBCTokenInfo(sdk.WrapSDKContext(ctx), types.NewMsgCancelRemoveParachainIBCTokenInfo(sdk.AccAddress([]byte("random")).String(), "pica", ""))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.CancelRemoveParachainIBCTokenInfo(sdk.WrapSDKContext(ctx), types.NewMsgCancelRemoveParachainIBCTokenInfo(authority, "pica", ""))
+	require.NoError(t, err)
+
+	res, err = app.TransferMiddlewareKeeper.PendingRemovals(sdk.WrapSDKContext(ctx), &types.QueryPendingRemovalsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.PendingRemovals)
+
+	// The token info is kept once the duration passes
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.TransferMiddlewareKeeper.GetParams(ctx).Duration + time.Second))
+	app.TransferMiddlewareKeeper.BeginBlocker(ctx)
+	require.Equal(t, "1", app.TransferMiddlewareKeeper.GetParachainIBCTokenInfoByNativeDenom(ctx, "pica").AssetId)
+}
+
+func TestBeginBlockerCleansRemoveList(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+
+	err := app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, "ibc-test", "channel-0", "pica", "1")
+	require.NoError(t, err)
+	err = app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, "ibc-test2", "channel-1", "poke", "2")
+	require.NoError(t, err)
+
+	_, err = app.TransferMiddlewareKeeper.AddParachainIBCInfoToRemoveList(ctx, "pica", "")
+	require.NoError(t, err)
+
+	// A removal scheduled later stays pending
+	duration := app.TransferMiddlewareKeeper.GetParams(ctx).Duration
+	laterCtx := ctx.WithBlockTime(ctx.BlockTime().Add(duration))
+	_, err = app.TransferMiddlewareKeeper.AddParachainIBCInfoToRemoveList(laterCtx, "poke", "")
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(duration + time.Second))
+	app.TransferMiddlewareKeeper.BeginBlocker(ctx)
+
+	_, found := app.TransferMiddlewareKeeper.GetRemoveListInfo(ctx, "pica", "")
+	require.False(t, found)
+	require.Empty(t, app.TransferMiddlewareKeeper.GetParachainIBCTokenInfoByNativeDenom(ctx, "pica").AssetId)
+
+	_, found = app.TransferMiddlewareKeeper.GetRemoveListInfo(ctx, "poke", "")
+	require.True(t, found)
+	require.Equal(t, "2", app.TransferMiddlewareKeeper.GetParachainIBCTokenInfoByNativeDenom(ctx, "poke").AssetId)
+
+	// Re-adding the removed token isn't affected by the executed removal
+	err = app.TransferMiddlewareKeeper.AddParachainIBCInfo(ctx, "ibc-test", "channel-0", "pica", "1")
+	require.NoError(t, err)
+	app.TransferMiddlewareKeeper.BeginBlocker(ctx)
+	require.Equal(t, "1", app.TransferMiddlewareKeeper.GetParachainIBCTokenInfoByNativeDenom(ctx, "pica").AssetId)
+}
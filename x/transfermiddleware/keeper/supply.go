@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+// GetMintedSupply returns the amount of native tokens minted against the IBC vouchers
+// locked in the escrow of a parachain channel and not burned yet.
+func (keeper Keeper) GetMintedSupply(ctx sdk.Context, nativeDenom, channelID string) math.Int {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetKeyMintedSupplyByNativeDenom(nativeDenom, channelID))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func (keeper Keeper) setMintedSupply(ctx sdk.Context, nativeDenom, channelID string, amount math.Int) {
+	store := ctx.KVStore(keeper.storeKey)
+	if amount.IsZero() {
+		store.Delete(types.GetKeyMintedSupplyByNativeDenom(nativeDenom, channelID))
+		return
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetKeyMintedSupplyByNativeDenom(nativeDenom, channelID), bz)
+}
+
+func (keeper Keeper) increaseMintedSupply(ctx sdk.Context, nativeDenom, channelID string, amount math.Int) {
+	keeper.setMintedSupply(ctx, nativeDenom, channelID, keeper.GetMintedSupply(ctx, nativeDenom, channelID).Add(amount))
+}
+
+func (keeper Keeper) decreaseMintedSupply(ctx sdk.Context, nativeDenom, channelID string, amount math.Int) {
+	mintedSupply := keeper.GetMintedSupply(ctx, nativeDenom, channelID).Sub(amount)
+	if mintedSupply.IsNegative() {
+		mintedSupply = math.ZeroInt()
+	}
+	keeper.setMintedSupply(ctx, nativeDenom, channelID, mintedSupply)
+}
+
+// GetEscrowedVouchers returns the IBC vouchers of a token info locked in the escrow of its channel.
+func (keeper Keeper) GetEscrowedVouchers(ctx sdk.Context, info types.ParachainIBCTokenInfo) sdk.Coin {
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, info.ChannelID)
+	return keeper.bankKeeper.GetBalance(ctx, escrowAddress, info.IbcDenom)
+}
+
+// ReleaseEscrowedVouchers burns native tokens of a token info held by holder and releases the
+// IBC vouchers locked against them in escrow to holder, it reverts the receive of the vouchers.
+func (keeper Keeper) ReleaseEscrowedVouchers(ctx sdk.Context, holder sdk.AccAddress, info types.ParachainIBCTokenInfo, amount math.Int) error {
+	nativeToken := sdk.NewCoins(sdk.NewCoin(info.NativeDenom, amount))
+	if err := keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, nativeToken); err != nil {
+		return errorsmod.Wrapf(err, "unable to take back the native tokens of %s", holder)
+	}
+	if err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, nativeToken); err != nil {
+		return errorsmod.Wrap(err, "failed to burn native tokens")
+	}
+	keeper.decreaseMintedSupply(ctx, info.NativeDenom, info.ChannelID, amount)
+
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, info.ChannelID)
+	return keeper.bankKeeper.SendCoins(ctx, escrowAddress, holder, sdk.NewCoins(sdk.NewCoin(info.IbcDenom, amount)))
+}
+
+// ValidateEscrowDeposit rejects IBC vouchers of a token info sent to the escrow of its channel,
+// no native tokens would be minted against them and the escrow would drift from the minted supply.
+func (keeper Keeper) ValidateEscrowDeposit(ctx sdk.Context, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	var err error
+	keeper.iterateEscrowedVouchers(ctx, toAddr, amt, func(info types.ParachainIBCTokenInfo, _ math.Int) {
+		err = errorsmod.Wrapf(types.ErrEscrowDeposit, "%s escrowed on %s", info.IbcDenom, info.ChannelID)
+	})
+	return err
+}
+
+// iterateEscrowedVouchers calls fn for the coins of amt which are the IBC vouchers of a token info
+// when addr is the escrow of its channel
+func (keeper Keeper) iterateEscrowedVouchers(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins, fn func(info types.ParachainIBCTokenInfo, amount math.Int)) {
+	for _, coin := range amt {
+		info, found := keeper.GetParachainIBCTokenInfoByIBCDenom(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		if addr.Equals(transfertypes.GetEscrowAddress(transfertypes.PortID, info.ChannelID)) {
+			fn(info, coin.Amount)
+		}
+	}
+}
+
+// initMintedSupply considers the vouchers already in escrow as backing native tokens
+// minted before the token info was registered, e.g. in genesis
+func (keeper Keeper) initMintedSupply(ctx sdk.Context, info types.ParachainIBCTokenInfo) {
+	keeper.setMintedSupply(ctx, info.NativeDenom, info.ChannelID, keeper.GetEscrowedVouchers(ctx, info).Amount)
+}
+
+// GetEscrowParity compares the vouchers escrowed for a token info with the native tokens minted against them.
+func (keeper Keeper) GetEscrowParity(ctx sdk.Context, info types.ParachainIBCTokenInfo) types.EscrowParity {
+	escrowed := keeper.GetEscrowedVouchers(ctx, info)
+	minted := keeper.GetMintedSupply(ctx, info.NativeDenom, info.ChannelID)
+
+	return types.EscrowParity{
+		NativeDenom:       info.NativeDenom,
+		IbcDenom:          info.IbcDenom,
+		ChannelID:         info.ChannelID,
+		EscrowedVouchers:  escrowed,
+		NativeSupply:      sdk.NewCoin(info.NativeDenom, minted),
+		TotalNativeSupply: keeper.bankKeeper.GetSupply(ctx, info.NativeDenom),
+		Delta:             escrowed.Amount.Sub(minted),
+	}
+}
+
+// ReconcileMintedSupply aligns the native supply minted against escrow with the vouchers
+// locked in escrow and returns the parity before the reconciliation.
+// The token info of the primary channel is reconciled if the channel is empty.
+func (keeper Keeper) ReconcileMintedSupply(ctx sdk.Context, nativeDenom, channelID string) (types.EscrowParity, error) {
+	info, found := keeper.resolveParachainIBCTokenInfo(ctx, nativeDenom, channelID)
+	if !found {
+		return types.EscrowParity{}, errorsmod.Wrapf(types.NotRegisteredNativeDenom, "%s", nativeDenom)
+	}
+
+	parity := keeper.GetEscrowParity(ctx, info)
+	if parity.Delta.IsZero() {
+		return types.EscrowParity{}, errorsmod.Wrapf(types.ErrNoParityDrift, "%s", nativeDenom)
+	}
+
+	keeper.setMintedSupply(ctx, nativeDenom, info.ChannelID, parity.EscrowedVouchers.Amount)
+	return parity, nil
+}
+
+// resolveParachainIBCTokenInfo returns the token info of a native denom on a channel,
+// or on its primary channel if the channel is empty
+func (keeper Keeper) resolveParachainIBCTokenInfo(ctx sdk.Context, nativeDenom, channelID string) (types.ParachainIBCTokenInfo, bool) {
+	if channelID == "" {
+		info := keeper.GetParachainIBCTokenInfoByNativeDenom(ctx, nativeDenom)
+		return info, info.Primary
+	}
+	return keeper.GetParachainIBCTokenInfo(ctx, nativeDenom, channelID)
+}
@@ -0,0 +1,84 @@
+package v3
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3:
+//
+//   - Token infos were keyed by native denom only, a native denom could be backed by
+//     a single channel. They are now keyed by (nativeDenom, channelID) and the existing
+//     channel becomes the primary channel of the native denom.
+//   - The native supply minted against escrow is tracked per (nativeDenom, channelID).
+//   - Pending removals are keyed by (nativeDenom, channelID), the existing removals
+//     remove all the channels of the native denom.
+//
+// Legacy keys don't contain the KeySeparator, which coin denoms can't contain either.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Migration of transfermiddleware token infos begin")
+
+	store := ctx.KVStore(storeKey)
+
+	tokenInfos := 0
+	for _, nativeDenom := range legacyKeys(store, types.KeyParachainIBCTokenInfoByNativeDenom) {
+		key := append(types.KeyParachainIBCTokenInfoByNativeDenom, []byte(nativeDenom)...)
+
+		var info types.ParachainIBCTokenInfo
+		if err := cdc.Unmarshal(store.Get(key), &info); err != nil {
+			return err
+		}
+		info.Primary = true
+
+		bz, err := cdc.Marshal(&info)
+		if err != nil {
+			return err
+		}
+		store.Delete(key)
+		store.Set(types.GetKeyParachainIBCTokenInfoByNativeDenom(info.NativeDenom, info.ChannelID), bz)
+		store.Set(types.GetKeyParachainIBCTokenInfoByAssetID(info.AssetId), bz)
+
+		mintedSupplyKey := append(types.KeyMintedSupplyByNativeDenom, []byte(nativeDenom)...)
+		if mintedSupply := store.Get(mintedSupplyKey); mintedSupply != nil {
+			store.Delete(mintedSupplyKey)
+			store.Set(types.GetKeyMintedSupplyByNativeDenom(info.NativeDenom, info.ChannelID), mintedSupply)
+		}
+		tokenInfos++
+	}
+
+	removals := 0
+	for _, nativeDenom := range legacyKeys(store, types.KeyParachainIBCTokenRemoveListByNativeDenom) {
+		key := append(types.KeyParachainIBCTokenRemoveListByNativeDenom, []byte(nativeDenom)...)
+		bz := store.Get(key)
+		store.Delete(key)
+		store.Set(types.GetKeyParachainIBCTokenRemoveListByNativeDenom(nativeDenom, ""), bz)
+		removals++
+	}
+
+	ctx.Logger().Info(
+		"Migration of transfermiddleware token infos done",
+		"totalTokenInfos", tokenInfos,
+		"totalRemovals", removals,
+	)
+	return nil
+}
+
+// legacyKeys returns the native denoms of the keys under the prefix which aren't composite keys.
+func legacyKeys(store sdk.KVStore, keyPrefix []byte) []string {
+	iterator := prefix.NewStore(store, keyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	nativeDenoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		if nativeDenom := string(iterator.Key()); !strings.Contains(nativeDenom, types.KeySeparator) {
+			nativeDenoms = append(nativeDenoms, nativeDenom)
+		}
+	}
+	return nativeDenoms
+}
@@ -0,0 +1,53 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	v3 "github.com/notional-labs/composable/v6/x/transfermiddleware/migrations/v3"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	storeKey := app.GetKey(types.StoreKey)
+	cdc := app.AppCodec()
+	store := ctx.KVStore(storeKey)
+
+	// v2 state keyed by native denom only
+	info := types.ParachainIBCTokenInfo{IbcDenom: "ibc-test", ChannelID: "channel-0", NativeDenom: "pica", AssetId: "1"}
+	bz := cdc.MustMarshal(&info)
+	store.Set(append(types.KeyParachainIBCTokenInfoByNativeDenom, []byte("pica")...), bz)
+	store.Set(types.GetKeyParachainIBCTokenInfoByAssetID("1"), bz)
+	store.Set(types.GetKeyNativeDenomAndIbcSecondaryIndex("ibc-test"), []byte("pica"))
+
+	mintedSupply, err := math.NewInt(1000).Marshal()
+	require.NoError(t, err)
+	store.Set(append(types.KeyMintedSupplyByNativeDenom, []byte("pica")...), mintedSupply)
+
+	removal := types.RemoveParachainIBCTokenInfo{NativeDenom: "pica", RemoveTime: ctx.BlockTime().Add(time.Hour)}
+	store.Set(append(types.KeyParachainIBCTokenRemoveListByNativeDenom, []byte("pica")...), cdc.MustMarshal(&removal))
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	keeper := app.TransferMiddlewareKeeper
+	migrated, found := keeper.GetParachainIBCTokenInfo(ctx, "pica", "channel-0")
+	require.True(t, found)
+	require.True(t, migrated.Primary)
+	require.Equal(t, migrated, keeper.GetParachainIBCTokenInfoByNativeDenom(ctx, "pica"))
+	require.Equal(t, migrated, keeper.GetParachainIBCTokenInfoByAssetID(ctx, "1"))
+	require.Equal(t, math.NewInt(1000), keeper.GetMintedSupply(ctx, "pica", "channel-0"))
+
+	pending, found := keeper.GetRemoveListInfo(ctx, "pica", "")
+	require.True(t, found)
+	require.Equal(t, removal.RemoveTime.Unix(), pending.RemoveTime.Unix())
+
+	require.False(t, store.Has(append(types.KeyParachainIBCTokenInfoByNativeDenom, []byte("pica")...)))
+	require.False(t, store.Has(append(types.KeyMintedSupplyByNativeDenom, []byte("pica")...)))
+	require.False(t, store.Has(append(types.KeyParachainIBCTokenRemoveListByNativeDenom, []byte("pica")...)))
+}
@@ -0,0 +1,60 @@
+package v4
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4:
+//
+//   - Token infos become entries of the bridge-token registry. The remote denom of the
+//     parachain tokens is their asset id, their native supply isn't capped and they
+//     aren't paused.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Migration of transfermiddleware token infos to bridge tokens begin")
+
+	store := ctx.KVStore(storeKey)
+
+	var infos []types.ParachainIBCTokenInfo
+	iterator := prefix.NewStore(store, types.KeyParachainIBCTokenInfoByNativeDenom).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.ParachainIBCTokenInfo
+		if err := cdc.Unmarshal(iterator.Value(), &info); err != nil {
+			iterator.Close()
+			return err
+		}
+		infos = append(infos, info)
+	}
+	iterator.Close()
+
+	for _, info := range infos {
+		if info.RemoteDenom == "" {
+			info.RemoteDenom = info.AssetId
+		}
+		if info.SupplyCap.IsNil() {
+			info.SupplyCap = math.ZeroInt()
+		}
+		info.Paused = false
+
+		bz, err := cdc.Marshal(&info)
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetKeyParachainIBCTokenInfoByNativeDenom(info.NativeDenom, info.ChannelID), bz)
+		// bridge tokens of other counterparties have no asset id to index
+		if info.AssetId != "" {
+			store.Set(types.GetKeyParachainIBCTokenInfoByAssetID(info.AssetId), bz)
+		}
+	}
+
+	ctx.Logger().Info(
+		"Migration of transfermiddleware token infos to bridge tokens done",
+		"totalTokenInfos", len(infos),
+	)
+	return nil
+}
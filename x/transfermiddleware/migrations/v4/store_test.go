@@ -0,0 +1,53 @@
+package v4_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	helpers "github.com/notional-labs/composable/v6/app/helpers"
+	v4 "github.com/notional-labs/composable/v6/x/transfermiddleware/migrations/v4"
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := helpers.SetupComposableAppWithValSet(t)
+	ctx := helpers.NewContextForApp(*app)
+	storeKey := app.GetKey(types.StoreKey)
+	cdc := app.AppCodec()
+	store := ctx.KVStore(storeKey)
+
+	// v3 token info without the bridge token fields
+	info := types.ParachainIBCTokenInfo{IbcDenom: "ibc-test", ChannelID: "channel-0", NativeDenom: "pica", AssetId: "1", Primary: true}
+	bz := cdc.MustMarshal(&info)
+	store.Set(types.GetKeyParachainIBCTokenInfoByNativeDenom("pica", "channel-0"), bz)
+	store.Set(types.GetKeyParachainIBCTokenInfoByAssetID("1"), bz)
+	store.Set(types.GetKeyNativeDenomAndIbcSecondaryIndex("ibc-test"), []byte("pica"))
+
+	// token info without asset id
+	bridgeInfo := types.ParachainIBCTokenInfo{IbcDenom: "ibc-usdc", ChannelID: "channel-1", NativeDenom: "usdc", Primary: true}
+	store.Set(types.GetKeyParachainIBCTokenInfoByNativeDenom("usdc", "channel-1"), cdc.MustMarshal(&bridgeInfo))
+	store.Set(types.GetKeyNativeDenomAndIbcSecondaryIndex("ibc-usdc"), []byte("usdc"))
+
+	require.NoError(t, v4.MigrateStore(ctx, storeKey, cdc))
+
+	keeper := app.TransferMiddlewareKeeper
+	migrated, found := keeper.GetParachainIBCTokenInfo(ctx, "pica", "channel-0")
+	require.True(t, found)
+	require.Equal(t, "1", migrated.RemoteDenom)
+	require.Equal(t, math.ZeroInt(), migrated.SupplyCap)
+	require.False(t, migrated.HasSupplyCap())
+	require.False(t, migrated.Paused)
+	require.True(t, migrated.Primary)
+	require.Equal(t, migrated, keeper.GetParachainIBCTokenInfoByAssetID(ctx, "1"))
+
+	byIBCDenom, found := keeper.GetParachainIBCTokenInfoByIBCDenom(ctx, "ibc-test")
+	require.True(t, found)
+	require.Equal(t, migrated, byIBCDenom)
+
+	// no asset id index is written for the token info without asset id
+	require.False(t, store.Has(types.GetKeyParachainIBCTokenInfoByAssetID("")))
+	_, found = keeper.GetParachainIBCTokenInfo(ctx, "usdc", "channel-1")
+	require.True(t, found)
+}
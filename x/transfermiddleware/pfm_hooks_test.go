@@ -0,0 +1,309 @@
+package transfermiddleware_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	customibctesting "github.com/notional-labs/composable/v6/app/ibctesting"
+	ibchookskeeper "github.com/notional-labs/composable/v6/x/ibc-hooks/keeper"
+	ibchookstypes "github.com/notional-labs/composable/v6/x/ibc-hooks/types"
+)
+
+const (
+	pfmWasmNativeDenom = "ppica"
+	pfmWasmIBCDenom    = "ibc/C053D637CCA2A2BA030E2C5EE1B28A16F71CCB0E45E8BE52766DC1B241B77878"
+	// the voucher of ppica forwarded from B to C
+	pfmWasmExpDenom = "ibc/3262D378E1636BE287EC355990D229DCEB828F0C60ED5049729575E235C60E8B"
+)
+
+// setupPFMWasmPaths opens the transfer channels A -> B and B -> C and maps the bond denom of A to ppica on B
+func (suite *TransferMiddlewareTestSuite) setupPFMWasmPaths() (pathAtoB, pathBtoC *customibctesting.Path) {
+	suite.SetupTest()
+	pathAtoB = NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(pathAtoB)
+	pathBtoC = NewTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(pathBtoC)
+
+	err := suite.chainB.TransferMiddleware().AddParachainIBCInfo(suite.chainB.GetContext(), pfmWasmIBCDenom, pathAtoB.EndpointB.ChannelID, pfmWasmNativeDenom, sdk.DefaultBondDenom)
+	suite.Require().NoError(err)
+	return pathAtoB, pathBtoC
+}
+
+// instantiateReflect instantiates the reflect contract on B owned by the intermediate sender of the sender of A,
+// so the packets of the sender can make the contract return their funds
+func (suite *TransferMiddlewareTestSuite) instantiateReflect(pathAtoB *customibctesting.Path) (contract sdk.AccAddress, intermediateSender string) {
+	suite.chainB.StoreContractCode(&suite.Suite, "../../tests/ibc-hooks/bytecode/reflect.wasm")
+	contract = suite.chainB.InstantiateContract(&suite.Suite, `{}`, 1)
+
+	intermediateSender, err := ibchookskeeper.DeriveIntermediateSender(pathAtoB.EndpointB.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), sdk.GetConfig().GetBech32AccountAddrPrefix())
+	suite.Require().NoError(err)
+
+	govModuleAddress := suite.chainB.GetTestSupport().AccountKeeper().GetModuleAddress(govtypes.ModuleName)
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(suite.chainB.GetTestSupport().WasmdKeeper())
+	_, err = contractKeeper.Execute(suite.chainB.GetContext(), contract, govModuleAddress, []byte(fmt.Sprintf(`{"change_owner":{"owner":"%s"}}`, intermediateSender)), nil)
+	suite.Require().NoError(err)
+	return contract, intermediateSender
+}
+
+// relayPacket relays the only packet sent by the source chain of a path and commits its receive
+func (suite *TransferMiddlewareTestSuite) relayPacket(path *customibctesting.Path) {
+	source, counterparty := path.EndpointA.Chain, path.EndpointB
+	suite.Require().Equal(1, len(source.PendingSendPackets))
+	packet := source.PendingSendPackets[0]
+	suite.coordinator.IncrementTime()
+	suite.coordinator.CommitBlock(source)
+	suite.Require().NoError(counterparty.UpdateClient())
+
+	suite.Require().NoError(counterparty.RecvPacket(packet))
+	source.PendingSendPackets = nil
+}
+
+// relayAck relays the only acknowledgement written by the counterparty chain of a path and returns it
+func (suite *TransferMiddlewareTestSuite) relayAck(path *customibctesting.Path) channeltypes.Acknowledgement {
+	counterparty := path.EndpointB.Chain
+	suite.Require().Equal(1, len(counterparty.PendingAckPackets))
+	ack := counterparty.PendingAckPackets[0]
+	suite.coordinator.IncrementTime()
+	suite.coordinator.CommitBlock(counterparty)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(ack.Packet, ack.Ack))
+	counterparty.PendingAckPackets = nil
+
+	var acknowledgement channeltypes.Acknowledgement
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ack.Ack, &acknowledgement))
+	return acknowledgement
+}
+
+// requireLastTxEvent requires the last tx delivered by a chain to emit the expected typed event
+func (suite *TransferMiddlewareTestSuite) requireLastTxEvent(chain *customibctesting.TestChain, expected proto.Message) {
+	for _, event := range chain.LastEvents {
+		if event.Type != proto.MessageName(expected) {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(event)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected, parsed)
+		return
+	}
+	suite.Require().Failf("missing event", "no %s event", proto.MessageName(expected))
+}
+
+func (suite *TransferMiddlewareTestSuite) transferFromA(pathAtoB *customibctesting.Path, amount sdk.Int, receiver, memo string) {
+	msg := transfertypes.NewMsgTransfer(
+		pathAtoB.EndpointA.ChannelConfig.PortID,
+		pathAtoB.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, amount),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		receiver,
+		clienttypes.NewHeight(1, 110),
+		0,
+		memo,
+	)
+	_, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *TransferMiddlewareTestSuite) TestTransferWithWasmThenPFM() {
+	transferAmount := sdk.NewInt(1000000000)
+	pathAtoB, pathBtoC := suite.setupPFMWasmPaths()
+	contract, intermediateSender := suite.instantiateReflect(pathAtoB)
+	senderAOriginalBalance := suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress())
+
+	// the contract receives the ppica minted for the vouchers and returns them to the intermediate sender,
+	// which forwards them to C
+	testAcc := RandomAccountAddress(suite.T())
+	memo := fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{"reflect_msg":{"msgs":[{"bank":{"send":{"to_address":"%s","amount":[{"denom":"%s","amount":"%s"}]}}}]}}},"forward":{"receiver":"%s","port":"%s","channel":"%s","timeout":"10m","retries":0}}`,
+		contract, intermediateSender, pfmWasmNativeDenom, transferAmount, testAcc, pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+	suite.transferFromA(pathAtoB, transferAmount, contract.String(), memo)
+
+	suite.relayPacket(pathAtoB)
+	suite.requireLastTxEvent(suite.chainB, &ibchookstypes.EventForward{
+		Contract:         contract.String(),
+		Sender:           intermediateSender,
+		OriginalSender:   suite.chainA.SenderAccount.GetAddress().String(),
+		PortID:           pathAtoB.EndpointB.ChannelConfig.PortID,
+		ChannelID:        pathAtoB.EndpointB.ChannelID,
+		Sequence:         1,
+		Receiver:         testAcc.String(),
+		ForwardPortID:    pathBtoC.EndpointA.ChannelConfig.PortID,
+		ForwardChannelID: pathBtoC.EndpointA.ChannelID,
+		ForwardSequence:  1,
+		Amount:           sdk.NewCoin(pfmWasmNativeDenom, transferAmount).String(),
+	})
+	// the packet from A is acknowledged once the forward is acknowledged
+	suite.Require().Equal(0, len(suite.chainB.PendingAckPackets))
+	suite.Require().True(suite.chainB.AllBalances(contract).IsZero())
+
+	escrowIbcDenomAddress := transfertypes.GetEscrowAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(pfmWasmIBCDenom, transferAmount)), suite.chainB.AllBalances(escrowIbcDenomAddress))
+	escrowNativeDenomAddress := transfertypes.GetEscrowAddress(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(pfmWasmNativeDenom, transferAmount)), suite.chainB.AllBalances(escrowNativeDenomAddress))
+
+	suite.relayPacket(pathBtoC)
+	suite.Require().True(suite.relayAck(pathBtoC).Success())
+	suite.Require().True(suite.relayAck(pathAtoB).Success())
+
+	suite.Require().True(suite.chainB.AllBalances(sdk.MustAccAddressFromBech32(intermediateSender)).IsZero())
+	suite.Require().Equal(senderAOriginalBalance.Sub(sdk.NewCoin(sdk.DefaultBondDenom, transferAmount)), suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress()))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(pfmWasmExpDenom, transferAmount)), suite.chainC.AllBalances(testAcc))
+}
+
+func (suite *TransferMiddlewareTestSuite) TestTransferWithWasmThenPFM_NoReturnedFunds() {
+	transferAmount := sdk.NewInt(1000000000)
+	pathAtoB, pathBtoC := suite.setupPFMWasmPaths()
+	contract, _ := suite.instantiateReflect(pathAtoB)
+	senderAOriginalBalance := suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress())
+
+	// nothing is forwarded if the contract keeps the funds, the packet is refunded
+	memo := fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{"reflect_msg":{"msgs":[]}}},"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`,
+		contract, RandomBech32AccountAddress(suite.T()), pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+	suite.transferFromA(pathAtoB, transferAmount, contract.String(), memo)
+
+	suite.relayPacket(pathAtoB)
+	suite.Require().Equal(0, len(suite.chainB.PendingSendPackets))
+	suite.Require().False(suite.relayAck(pathAtoB).Success())
+
+	suite.Require().True(suite.chainB.AllBalances(contract).IsZero())
+	escrowIbcDenomAddress := transfertypes.GetEscrowAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID)
+	suite.Require().True(suite.chainB.AllBalances(escrowIbcDenomAddress).IsZero())
+	suite.Require().Equal(senderAOriginalBalance, suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress()))
+}
+
+func (suite *TransferMiddlewareTestSuite) TestTransferWithPFMThenWasm() {
+	transferAmount := sdk.NewInt(1000000000)
+	pathAtoB, pathBtoC := suite.setupPFMWasmPaths()
+
+	suite.chainC.StoreContractCode(&suite.Suite, "../../tests/ibc-hooks/bytecode/counter.wasm")
+	contract := suite.chainC.InstantiateContract(&suite.Suite, `{"count": 0}`, 1)
+
+	// the ppica minted on B are forwarded to the contract on C
+	next, err := json.Marshal(map[string]interface{}{
+		"wasm": map[string]interface{}{
+			"contract": contract.String(),
+			"msg":      map[string]interface{}{"increment": map[string]interface{}{}},
+		},
+	})
+	suite.Require().NoError(err)
+	retries := uint8(0)
+	memo, err := json.Marshal(&PacketMetadata{
+		Forward: &ForwardMetadata{
+			Receiver: contract.String(),
+			Port:     pathBtoC.EndpointA.ChannelConfig.PortID,
+			Channel:  pathBtoC.EndpointA.ChannelID,
+			Timeout:  10 * time.Minute,
+			Retries:  &retries,
+			Next:     string(next),
+		},
+	})
+	suite.Require().NoError(err)
+	suite.transferFromA(pathAtoB, transferAmount, suite.chainB.SenderAccount.GetAddress().String(), string(memo))
+
+	suite.relayPacket(pathAtoB)
+	suite.relayPacket(pathBtoC)
+	// the acknowledgement of the contract is written on C and then on B
+	ack := suite.relayAck(pathBtoC)
+	suite.Require().True(ack.Success())
+	suite.Require().Contains(string(ack.GetResult()), "contract_result")
+	suite.Require().Equal(ack, suite.relayAck(pathAtoB))
+
+	escrowNativeDenomAddress := transfertypes.GetEscrowAddress(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(pfmWasmNativeDenom, transferAmount)), suite.chainB.AllBalances(escrowNativeDenomAddress))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(pfmWasmExpDenom, transferAmount)), suite.chainC.AllBalances(contract))
+}
+
+// timeoutForward times out the only packet sent by B to C
+func (suite *TransferMiddlewareTestSuite) timeoutForward(pathBtoC *customibctesting.Path, timeout time.Duration) {
+	suite.Require().Equal(1, len(suite.chainB.PendingSendPackets))
+	packet := suite.chainB.PendingSendPackets[0]
+	suite.chainB.PendingSendPackets = nil
+	suite.coordinator.IncrementTimeBy(timeout)
+	suite.coordinator.CommitBlock(suite.chainC)
+	suite.Require().NoError(pathBtoC.EndpointA.UpdateClient())
+
+	suite.Require().NoError(pathBtoC.EndpointA.TimeoutPacket(packet))
+}
+
+// forwardRefunded checks that a failed forward of the funds returned by the contract is refunded to the contract
+// and that the packet from A is acknowledged successfully since the contract was executed
+func (suite *TransferMiddlewareTestSuite) forwardRefunded(pathAtoB, pathBtoC *customibctesting.Path, contract sdk.AccAddress, intermediateSender string, transferAmount sdk.Int, senderAOriginalBalance sdk.Coins) {
+	suite.Require().True(suite.relayAck(pathAtoB).Success())
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(pfmWasmNativeDenom, transferAmount)), suite.chainB.AllBalances(contract))
+	suite.Require().True(suite.chainB.AllBalances(sdk.MustAccAddressFromBech32(intermediateSender)).IsZero())
+	escrowNativeDenomAddress := transfertypes.GetEscrowAddress(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+	suite.Require().True(suite.chainB.AllBalances(escrowNativeDenomAddress).IsZero())
+	escrowIbcDenomAddress := transfertypes.GetEscrowAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(pfmWasmIBCDenom, transferAmount)), suite.chainB.AllBalances(escrowIbcDenomAddress))
+	suite.Require().Equal(senderAOriginalBalance.Sub(sdk.NewCoin(sdk.DefaultBondDenom, transferAmount)), suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress()))
+	suite.Require().Empty(suite.chainB.GetTestSupport().IBCHooks().ExportGenesis(suite.chainB.GetContext()).ForwardRefunds)
+}
+
+func (suite *TransferMiddlewareTestSuite) TestTransferWithWasmThenPFM_ErrorAck() {
+	transferAmount := sdk.NewInt(1000000000)
+	pathAtoB, pathBtoC := suite.setupPFMWasmPaths()
+	contract, intermediateSender := suite.instantiateReflect(pathAtoB)
+	senderAOriginalBalance := suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress())
+	suite.chainC.GetTestSupport().TransferKeeper().SetParams(suite.chainC.GetContext(), transfertypes.Params{SendEnabled: true, ReceiveEnabled: false})
+
+	memo := fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{"reflect_msg":{"msgs":[{"bank":{"send":{"to_address":"%s","amount":[{"denom":"%s","amount":"%s"}]}}}]}}},"forward":{"receiver":"%s","port":"%s","channel":"%s","timeout":"10m","retries":0}}`,
+		contract, intermediateSender, pfmWasmNativeDenom, transferAmount, RandomBech32AccountAddress(suite.T()), pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+	suite.transferFromA(pathAtoB, transferAmount, contract.String(), memo)
+
+	suite.relayPacket(pathAtoB)
+	suite.Require().Len(suite.chainB.GetTestSupport().IBCHooks().ExportGenesis(suite.chainB.GetContext()).ForwardRefunds, 1)
+	suite.relayPacket(pathBtoC)
+	suite.Require().False(suite.relayAck(pathBtoC).Success())
+	suite.requireLastTxEvent(suite.chainB, &ibchookstypes.EventForwardRefund{
+		Contract:  contract.String(),
+		Sender:    intermediateSender,
+		PortID:    pathBtoC.EndpointA.ChannelConfig.PortID,
+		ChannelID: pathBtoC.EndpointA.ChannelID,
+		Sequence:  1,
+		Amount:    sdk.NewCoin(pfmWasmNativeDenom, transferAmount).String(),
+	})
+
+	suite.forwardRefunded(pathAtoB, pathBtoC, contract, intermediateSender, transferAmount, senderAOriginalBalance)
+}
+
+func (suite *TransferMiddlewareTestSuite) TestTransferWithWasmThenPFM_Timeout() {
+	transferAmount := sdk.NewInt(1000000000)
+	pathAtoB, pathBtoC := suite.setupPFMWasmPaths()
+	contract, intermediateSender := suite.instantiateReflect(pathAtoB)
+	senderAOriginalBalance := suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress())
+	hooksKeeper := suite.chainB.GetTestSupport().IBCHooks()
+
+	memo := fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{"reflect_msg":{"msgs":[{"bank":{"send":{"to_address":"%s","amount":[{"denom":"%s","amount":"%s"}]}}}]}}},"forward":{"receiver":"%s","port":"%s","channel":"%s","timeout":"1m","retries":1}}`,
+		contract, intermediateSender, pfmWasmNativeDenom, transferAmount, RandomBech32AccountAddress(suite.T()), pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+	suite.transferFromA(pathAtoB, transferAmount, contract.String(), memo)
+	suite.relayPacket(pathAtoB)
+
+	// the first timeout is retried by PFM, the forward is refunded when the retry times out
+	suite.timeoutForward(pathBtoC, 2*time.Minute)
+	suite.Require().Equal(0, len(suite.chainB.PendingAckPackets))
+	forwards := hooksKeeper.ExportGenesis(suite.chainB.GetContext()).ForwardRefunds
+	suite.Require().Len(forwards, 1)
+	suite.Require().Equal(suite.chainB.PendingSendPackets[0].Sequence, forwards[0].Sequence)
+	suite.Require().True(suite.chainB.AllBalances(contract).IsZero())
+
+	suite.timeoutForward(pathBtoC, 2*time.Minute)
+	suite.requireLastTxEvent(suite.chainB, &ibchookstypes.EventForwardRefund{
+		Contract:  contract.String(),
+		Sender:    intermediateSender,
+		PortID:    pathBtoC.EndpointA.ChannelConfig.PortID,
+		ChannelID: pathBtoC.EndpointA.ChannelID,
+		Sequence:  forwards[0].Sequence,
+		Amount:    sdk.NewCoin(pfmWasmNativeDenom, transferAmount).String(),
+		Timeout:   true,
+	})
+	suite.forwardRefunded(pathAtoB, pathBtoC, contract, intermediateSender, transferAmount, senderAOriginalBalance)
+}
@@ -654,6 +654,9 @@ func (suite *TransferMiddlewareTestSuite) TestTransferWithPFMReverse_ErrorAck()
 			escrowNativeDenomAddressBalance = suite.chainB.AllBalances(escrowNativeDenomAddress)
 			expBalance = sdk.NewCoins(sdk.NewCoin(nativeDenom, transferAmount))
 			suite.Require().Equal(expBalance, escrowNativeDenomAddressBalance)
+
+			// the native tokens minted back by the refund are tracked against the escrowed vouchers
+			suite.Require().Equal(transferAmount, chainBtransMiddleware.GetMintedSupply(suite.chainB.GetContext(), nativeDenom, pathAtoB.EndpointB.ChannelID))
 		})
 	}
 }
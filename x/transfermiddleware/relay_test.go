@@ -11,6 +11,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	customibctesting "github.com/notional-labs/composable/v6/app/ibctesting"
+	transfermiddlewarekeeper "github.com/notional-labs/composable/v6/x/transfermiddleware/keeper"
+	transfermiddlewaretypes "github.com/notional-labs/composable/v6/x/transfermiddleware/types"
 )
 
 // TODO: use testsuite here.
@@ -395,3 +397,133 @@ func (suite *TransferMiddlewareTestSuite) TestMintAndEscrowProcessWhenLaunchChai
 		suite.Require().Equal(expBalance, balance)
 	})
 }
+
+func (suite *TransferMiddlewareTestSuite) TestRemoveParachainIBCInfoWithMintedSupply() {
+	var (
+		transferAmount             = sdk.NewInt(1000000000)
+		nativeTokenSendOnChainA    = sdk.NewCoin(sdk.DefaultBondDenom, transferAmount)
+		nativeTokenReceiveOnChainB = sdk.NewCoin("ppica", transferAmount)
+		timeoutHeight              = clienttypes.NewHeight(1, 110)
+	)
+
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// Add parachain token info
+	chainBtransMiddlewareKeeper := suite.chainB.TransferMiddleware()
+	err := chainBtransMiddlewareKeeper.AddParachainIBCInfo(suite.chainB.GetContext(), "ibc/C053D637CCA2A2BA030E2C5EE1B28A16F71CCB0E45E8BE52766DC1B241B77878", path.EndpointB.ChannelID, "ppica", sdk.DefaultBondDenom)
+	suite.Require().NoError(err)
+
+	msg := ibctransfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nativeTokenSendOnChainA, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	err = suite.coordinator.RelayAndAckPendingPackets(path)
+	suite.Require().NoError(err)
+
+	// the minted ppica is tracked and backed by the escrowed vouchers
+	suite.Require().Equal(transferAmount, chainBtransMiddlewareKeeper.GetMintedSupply(suite.chainB.GetContext(), "ppica", path.EndpointB.ChannelID))
+	_, broken := transfermiddlewarekeeper.AllInvariants(chainBtransMiddlewareKeeper)(suite.chainB.GetContext())
+	suite.Require().False(broken)
+
+	// the token info can't be removed while the minted ppica is in circulation
+	_, err = chainBtransMiddlewareKeeper.AddParachainIBCInfoToRemoveList(suite.chainB.GetContext(), "ppica", "")
+	suite.Require().ErrorIs(err, transfermiddlewaretypes.ErrOutstandingMintedSupply)
+
+	// send token back
+	msg = ibctransfertypes.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, nativeTokenReceiveOnChainB, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	_, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+	err = suite.coordinator.RelayAndAckPendingPacketsReverse(path)
+	suite.Require().NoError(err)
+
+	suite.Require().True(chainBtransMiddlewareKeeper.GetMintedSupply(suite.chainB.GetContext(), "ppica", path.EndpointB.ChannelID).IsZero())
+	_, broken = transfermiddlewarekeeper.AllInvariants(chainBtransMiddlewareKeeper)(suite.chainB.GetContext())
+	suite.Require().False(broken)
+
+	removeTime, err := chainBtransMiddlewareKeeper.AddParachainIBCInfoToRemoveList(suite.chainB.GetContext(), "ppica", "")
+	suite.Require().NoError(err)
+
+	// nothing is minted against escrow during the removal window, the transfer is refunded on chain A
+	originalChainABalance := suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress())
+	msg = ibctransfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nativeTokenSendOnChainA, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	err = suite.coordinator.RelayAndAckPendingPackets(path)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(originalChainABalance, suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress()))
+	suite.Require().True(chainBtransMiddlewareKeeper.GetMintedSupply(suite.chainB.GetContext(), "ppica", path.EndpointB.ChannelID).IsZero())
+
+	// the token info is removed once the removal time passed
+	ctx := suite.chainB.GetContext().WithBlockTime(removeTime.Add(time.Second))
+	chainBtransMiddlewareKeeper.BeginBlocker(ctx)
+	suite.Require().Empty(chainBtransMiddlewareKeeper.GetParachainIBCTokenInfoByNativeDenom(ctx, "ppica").IbcDenom)
+	_, found := chainBtransMiddlewareKeeper.GetRemoveListInfo(ctx, "ppica", "")
+	suite.Require().False(found)
+}
+
+func (suite *TransferMiddlewareTestSuite) TestBridgeTokenSupplyCapAndPause() {
+	var (
+		transferAmount          = sdk.NewInt(1000)
+		supplyCap               = sdk.NewInt(1500)
+		nativeTokenSendOnChainA = sdk.NewCoin(sdk.DefaultBondDenom, transferAmount)
+		timeoutHeight           = clienttypes.NewHeight(1, 110)
+	)
+
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// register stake of chain A as a bridge token of chain B
+	chainBtransMiddlewareKeeper := suite.chainB.TransferMiddleware()
+	info, err := chainBtransMiddlewareKeeper.AddBridgeTokenInfo(suite.chainB.GetContext(), path.EndpointB.ChannelID, sdk.DefaultBondDenom, "ubridged", supplyCap)
+	suite.Require().NoError(err)
+	suite.Require().Empty(info.AssetId)
+
+	sendFromChainA := func() {
+		msg := ibctransfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nativeTokenSendOnChainA, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+		_, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+		err = suite.coordinator.RelayAndAckPendingPackets(path)
+		suite.Require().NoError(err)
+	}
+
+	// the vouchers are escrowed and the native denom is minted
+	sendFromChainA()
+	suite.Require().Equal(transferAmount, suite.chainB.Balance(suite.chainB.SenderAccount.GetAddress(), "ubridged").Amount)
+	suite.Require().True(suite.chainB.Balance(suite.chainB.SenderAccount.GetAddress(), info.IbcDenom).IsZero())
+	suite.Require().Equal(transferAmount, chainBtransMiddlewareKeeper.GetMintedSupply(suite.chainB.GetContext(), "ubridged", path.EndpointB.ChannelID))
+
+	// minting over the supply cap fails, the transfer is refunded on chain A
+	originalChainABalance := suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress())
+	sendFromChainA()
+	suite.Require().Equal(originalChainABalance, suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress()))
+	suite.Require().Equal(transferAmount, chainBtransMiddlewareKeeper.GetMintedSupply(suite.chainB.GetContext(), "ubridged", path.EndpointB.ChannelID))
+
+	// nothing is minted while the bridge token is paused
+	err = chainBtransMiddlewareKeeper.UpdateBridgeTokenInfo(suite.chainB.GetContext(), "ubridged", path.EndpointB.ChannelID, sdk.ZeroInt(), true)
+	suite.Require().NoError(err)
+	sendFromChainA()
+	suite.Require().Equal(originalChainABalance, suite.chainA.AllBalances(suite.chainA.SenderAccount.GetAddress()))
+
+	// and the native denom can't be sent back
+	msg := ibctransfertypes.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoin("ubridged", transferAmount), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	_, err = suite.chainB.SendMsgsWithExpPass(false, msg)
+	suite.Require().ErrorContains(err, transfermiddlewaretypes.ErrBridgeTokenPaused.Error())
+
+	// SignAndDeliver calls app.Commit()
+	suite.chainB.NextBlock()
+
+	// increment sequence for successful transaction execution
+	err = suite.chainB.SenderAccount.SetSequence(suite.chainB.SenderAccount.GetSequence() + 1)
+	suite.Require().NoError(err)
+
+	// the uncapped bridge token mints again once unpaused
+	err = chainBtransMiddlewareKeeper.UpdateBridgeTokenInfo(suite.chainB.GetContext(), "ubridged", path.EndpointB.ChannelID, sdk.ZeroInt(), false)
+	suite.Require().NoError(err)
+	sendFromChainA()
+	suite.Require().Equal(transferAmount.MulRaw(2), chainBtransMiddlewareKeeper.GetMintedSupply(suite.chainB.GetContext(), "ubridged", path.EndpointB.ChannelID))
+}
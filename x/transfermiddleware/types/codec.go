@@ -18,7 +18,13 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgAddParachainIBCTokenInfo{}, "composable/MsgAddParachainInfo")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveParachainIBCTokenInfo{}, "composable/MsgRemoveParachainInfo")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelRemoveParachainIBCTokenInfo{}, "composable/MsgCancelRemoveParachainInfo")
+	legacy.RegisterAminoMsg(cdc, &MsgReconcile{}, "composable/MsgReconcile")
+	legacy.RegisterAminoMsg(cdc, &MsgSetPrimaryChannel{}, "composable/MsgSetPrimaryChannel")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterBridgeToken{}, "composable/MsgRegisterBridgeToken")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateBridgeToken{}, "composable/MsgUpdateBridgeToken")
 	legacy.RegisterAminoMsg(cdc, &MsgAddRlyAddress{}, "composable/MsgAddRlyAddress")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveRlyAddress{}, "composable/MsgRemoveRlyAddress")
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -26,7 +32,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgAddParachainIBCTokenInfo{},
 		&MsgRemoveParachainIBCTokenInfo{},
+		&MsgCancelRemoveParachainIBCTokenInfo{},
+		&MsgReconcile{},
+		&MsgSetPrimaryChannel{},
+		&MsgRegisterBridgeToken{},
+		&MsgUpdateBridgeToken{},
 		&MsgAddRlyAddress{},
+		&MsgRemoveRlyAddress{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
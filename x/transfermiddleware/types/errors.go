@@ -10,4 +10,13 @@ var (
 	NotFungibleTokenPacketData        = sdkerrors.Register(ModuleName, 3, "not fungible token packet data")
 	ErrMultipleMapping                = sdkerrors.Register(ModuleName, 4, "err mapping key to multiple value")
 	NotRegisteredNativeDenom          = sdkerrors.Register(ModuleName, 5, "nativeDenom is not registered")
+	ErrRlyAddressNotFound             = sdkerrors.Register(ModuleName, 6, "rly address is not in allow list")
+	ErrNotInRemoveList                = sdkerrors.Register(ModuleName, 7, "native denom is not in remove list")
+	ErrOutstandingMintedSupply        = sdkerrors.Register(ModuleName, 8, "native tokens minted against escrow are outstanding")
+	ErrTokenPendingRemoval            = sdkerrors.Register(ModuleName, 9, "token info is pending removal")
+	ErrNoParityDrift                  = sdkerrors.Register(ModuleName, 10, "escrowed vouchers and native supply are equal")
+	ErrPrimaryChannel                 = sdkerrors.Register(ModuleName, 11, "primary channel can't be removed while other channels back the native denom")
+	ErrBridgeTokenPaused              = sdkerrors.Register(ModuleName, 12, "bridge token is paused")
+	ErrSupplyCapExceeded              = sdkerrors.Register(ModuleName, 13, "bridge token supply cap exceeded")
+	ErrEscrowDeposit                  = sdkerrors.Register(ModuleName, 14, "IBC vouchers can't be sent directly to the escrow backing their native denom")
 )
@@ -2,13 +2,30 @@ package types
 
 // staking module event types
 const (
-	EventAddParachainIBCTokenInfo    = "add-parachain-token-info"    // #nosec G101
-	EventRemoveParachainIBCTokenInfo = "remove-parachain-token-info" // #nosec G101
-	EventAddRlyToAllowList           = "add-rly-to-allow-list"       //#nosec G101
+	EventAddParachainIBCTokenInfo          = "add-parachain-token-info"           // #nosec G101
+	EventRemoveParachainIBCTokenInfo       = "remove-parachain-token-info"        // #nosec G101
+	EventCancelRemoveParachainIBCTokenInfo = "cancel-remove-parachain-token-info" // #nosec G101
+	EventReconcileEscrowParity             = "reconcile-escrow-parity"
+	EventSetPrimaryChannel                 = "set-primary-channel"
+	EventRegisterBridgeToken               = "register-bridge-token"
+	EventUpdateBridgeToken                 = "update-bridge-token"
+	EventAddRlyToAllowList                 = "add-rly-to-allow-list"      //#nosec G101
+	EventRemoveRlyFromAllowList            = "remove-rly-from-allow-list" //#nosec G101
 
 	AttributeKeyNativeDenom = "native-denom"
 	AttributeKeyIbcDenom    = "ibc-denom"
+	AttributeKeyChannelID   = "channel-id"
 	AttributeKeyAssetID     = "asset-id"
 	AttributeKeyRlyAdress   = "rly-address"
 	AttributeKeyRemoveTime  = "remove_time"
+	AttributeKeyOperator    = "operator-name"
+	AttributeKeyExpiry      = "expiry"
+	AttributeKeyAuthority   = "authority"
+	AttributeKeyEscrowed    = "escrowed-vouchers"
+	AttributeKeyPrevSupply  = "previous-native-supply"
+	AttributeKeyNewSupply   = "native-supply"
+	AttributeKeyDelta       = "delta"
+	AttributeKeyRemoteDenom = "remote-denom"
+	AttributeKeySupplyCap   = "supply-cap"
+	AttributeKeyPaused      = "paused"
 )
@@ -19,11 +19,19 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return nil
+	err = validateMintedSupplies(data.TokenInfos, data.MintedSupplies)
+	if err != nil {
+		return err
+	}
+
+	return validatePendingRemovals(data.TokenInfos, data.PendingRemovals)
 }
 
 func validateTokenInfos(infos []ParachainIBCTokenInfo) error {
 	infoMap := make(map[string]bool, len(infos))
+	ibcDenomMap := make(map[string]bool, len(infos))
+	channelMap := make(map[string]bool, len(infos))
+	primaryMap := make(map[string]bool, len(infos))
 
 	for i := 0; i < len(infos); i++ {
 		info := infos[i]
@@ -33,14 +41,82 @@ func validateTokenInfos(infos []ParachainIBCTokenInfo) error {
 			return err
 		}
 
-		strKey := info.AssetId
+		// check duplicate based on assetId, bridge tokens of other counterparties have none
+		if info.AssetId != "" {
+			if _, ok := infoMap[info.AssetId]; ok {
+				return fmt.Errorf("duplicate parachain token info in genesis state: assetId %v, nativeDenom %v", info.AssetId, info.NativeDenom)
+			}
+			infoMap[info.AssetId] = true
+		}
+
+		// check duplicate based on ibcDenom
+		if _, ok := ibcDenomMap[info.IbcDenom]; ok {
+			return fmt.Errorf("duplicate parachain token info in genesis state: ibcDenom %v", info.IbcDenom)
+		}
+		ibcDenomMap[info.IbcDenom] = true
 
-		// check duplicate based on assetId
-		if _, ok := infoMap[strKey]; ok {
-			return fmt.Errorf("duplicate parachain token info in genesis state: assetId %v, nativeDenom %v", info.AssetId, info.NativeDenom)
+		// check duplicate based on nativeDenom and channelID
+		channelKey := info.NativeDenom + KeySeparator + info.ChannelID
+		if _, ok := channelMap[channelKey]; ok {
+			return fmt.Errorf("duplicate parachain token info in genesis state: nativeDenom %v, channelID %v", info.NativeDenom, info.ChannelID)
 		}
+		channelMap[channelKey] = true
 
-		infoMap[strKey] = true
+		// check a single primary channel per nativeDenom
+		if info.Primary {
+			if _, ok := primaryMap[info.NativeDenom]; ok {
+				return fmt.Errorf("multiple primary channels in genesis state: nativeDenom %v", info.NativeDenom)
+			}
+			primaryMap[info.NativeDenom] = true
+		}
+	}
+
+	return nil
+}
+
+func validateMintedSupplies(infos []ParachainIBCTokenInfo, mintedSupplies []MintedSupply) error {
+	channelMap := make(map[string]bool, len(infos))
+	for _, info := range infos {
+		channelMap[info.NativeDenom+KeySeparator+info.ChannelID] = true
+	}
+
+	supplyMap := make(map[string]bool, len(mintedSupplies))
+	for _, mintedSupply := range mintedSupplies {
+		channelKey := mintedSupply.NativeDenom + KeySeparator + mintedSupply.ChannelID
+		if !channelMap[channelKey] {
+			return fmt.Errorf("minted supply without parachain token info in genesis state: nativeDenom %v, channelID %v", mintedSupply.NativeDenom, mintedSupply.ChannelID)
+		}
+		if _, ok := supplyMap[channelKey]; ok {
+			return fmt.Errorf("duplicate minted supply in genesis state: nativeDenom %v, channelID %v", mintedSupply.NativeDenom, mintedSupply.ChannelID)
+		}
+		supplyMap[channelKey] = true
+
+		if mintedSupply.Amount.IsNil() || mintedSupply.Amount.IsNegative() {
+			return fmt.Errorf("invalid minted supply in genesis state: nativeDenom %v, channelID %v, amount %v", mintedSupply.NativeDenom, mintedSupply.ChannelID, mintedSupply.Amount)
+		}
+	}
+
+	return nil
+}
+
+func validatePendingRemovals(infos []ParachainIBCTokenInfo, removals []RemoveParachainIBCTokenInfo) error {
+	// a removal without channel removes all the channels of the native denom
+	channelMap := make(map[string]bool, 2*len(infos))
+	for _, info := range infos {
+		channelMap[info.NativeDenom+KeySeparator+info.ChannelID] = true
+		channelMap[info.NativeDenom+KeySeparator] = true
+	}
+
+	removalMap := make(map[string]bool, len(removals))
+	for _, removal := range removals {
+		removalKey := removal.NativeDenom + KeySeparator + removal.ChannelID
+		if !channelMap[removalKey] {
+			return fmt.Errorf("pending removal without parachain token info in genesis state: nativeDenom %v, channelID %v", removal.NativeDenom, removal.ChannelID)
+		}
+		if _, ok := removalMap[removalKey]; ok {
+			return fmt.Errorf("duplicate pending removal in genesis state: nativeDenom %v, channelID %v", removal.NativeDenom, removal.ChannelID)
+		}
+		removalMap[removalKey] = true
 	}
 
 	return nil
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +29,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	TokenInfos []ParachainIBCTokenInfo `protobuf:"bytes,1,rep,name=token_infos,json=tokenInfos,proto3" json:"token_infos"`
 	Params     Params                  `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// minted_supplies are the native supplies minted against the vouchers
+	// escrowed on each channel.
+	MintedSupplies []MintedSupply `protobuf:"bytes,3,rep,name=minted_supplies,json=mintedSupplies,proto3" json:"minted_supplies"`
+	// pending_removals are the token infos waiting for their removal time.
+	PendingRemovals []RemoveParachainIBCTokenInfo `protobuf:"bytes,4,rep,name=pending_removals,json=pendingRemovals,proto3" json:"pending_removals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,8 +83,78 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetMintedSupplies() []MintedSupply {
+	if m != nil {
+		return m.MintedSupplies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRemovals() []RemoveParachainIBCTokenInfo {
+	if m != nil {
+		return m.PendingRemovals
+	}
+	return nil
+}
+
+// MintedSupply is the amount of native tokens minted against the IBC vouchers
+// locked in the escrow of a channel.
+type MintedSupply struct {
+	NativeDenom string                `protobuf:"bytes,1,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
+	ChannelID   string                `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Amount      cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *MintedSupply) Reset()         { *m = MintedSupply{} }
+func (m *MintedSupply) String() string { return proto.CompactTextString(m) }
+func (*MintedSupply) ProtoMessage()    {}
+func (*MintedSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9aae52ba7c9ee39, []int{1}
+}
+func (m *MintedSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintedSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintedSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintedSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintedSupply.Merge(m, src)
+}
+func (m *MintedSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintedSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintedSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintedSupply proto.InternalMessageInfo
+
+func (m *MintedSupply) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
+	}
+	return ""
+}
+
+func (m *MintedSupply) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "composable.transfermiddleware.v1beta1.GenesisState")
+	proto.RegisterType((*MintedSupply)(nil), "composable.transfermiddleware.v1beta1.MintedSupply")
 }
 
 func init() {
@@ -86,24 +162,36 @@ func init() {
 }
 
 var fileDescriptor_a9aae52ba7c9ee39 = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4e, 0xce, 0xcf, 0x2d,
-	0xc8, 0x2f, 0x4e, 0x4c, 0xca, 0x49, 0xd5, 0x2f, 0x29, 0x4a, 0xcc, 0x2b, 0x4e, 0x4b, 0x2d, 0xca,
-	0xcd, 0x4c, 0x49, 0xc9, 0x49, 0x2d, 0x4f, 0x2c, 0x4a, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x52, 0x45, 0x68, 0xd2, 0xc3, 0xd4, 0xa4, 0x07, 0xd5, 0x24, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f,
-	0xd6, 0xa1, 0x0f, 0x62, 0x41, 0x34, 0x4b, 0x19, 0x11, 0x67, 0x63, 0x41, 0x62, 0x51, 0x62, 0x2e,
-	0xd4, 0x42, 0x29, 0x07, 0xe2, 0xf5, 0x24, 0x67, 0x24, 0x66, 0xe6, 0xc5, 0x97, 0xe4, 0x67, 0xa7,
-	0xe6, 0xc5, 0x67, 0xe6, 0xa5, 0x41, 0x6d, 0x55, 0x3a, 0xc0, 0xc8, 0xc5, 0xe3, 0x0e, 0xf1, 0x44,
-	0x70, 0x49, 0x62, 0x49, 0xaa, 0x50, 0x32, 0x17, 0x37, 0x42, 0x51, 0xb1, 0x04, 0xa3, 0x02, 0xb3,
-	0x06, 0xb7, 0x91, 0x8d, 0x1e, 0x51, 0x3e, 0xd3, 0x0b, 0x80, 0x59, 0xe4, 0xe9, 0xe4, 0x1c, 0x02,
-	0x32, 0xc5, 0x33, 0x2f, 0x2d, 0xdf, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xae, 0x12, 0x98,
-	0x40, 0xb1, 0x90, 0x37, 0x17, 0x1b, 0xc4, 0x1f, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xba,
-	0x24, 0x98, 0x9f, 0x5b, 0x0c, 0x35, 0x10, 0x6a, 0x84, 0x93, 0xc9, 0x89, 0x47, 0x72, 0x8c, 0x17,
-	0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c,
-	0x37, 0x1e, 0xcb, 0x31, 0x44, 0x49, 0x55, 0x60, 0x0b, 0x95, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24,
-	0x36, 0xb0, 0xff, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xb2, 0x4a, 0xf8, 0xe9, 0x01,
-	0x00, 0x00,
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x1b, 0xbb, 0x14, 0x3a, 0xad, 0xae, 0x46, 0xc5, 0xd0, 0x43, 0x52, 0x02, 0x42, 0x2f,
+	0x26, 0x6c, 0xeb, 0x69, 0xf1, 0xa0, 0xd9, 0x45, 0x09, 0x22, 0x48, 0xd6, 0x93, 0x97, 0x30, 0x4d,
+	0x5e, 0xdb, 0x61, 0x33, 0x33, 0x21, 0x33, 0x5b, 0xed, 0xb7, 0xf0, 0xea, 0x37, 0xda, 0xe3, 0x5e,
+	0x04, 0xf1, 0x10, 0xa4, 0xfd, 0x06, 0xfd, 0x04, 0x92, 0xcc, 0x2c, 0x29, 0x28, 0x92, 0xbd, 0xe5,
+	0xbd, 0xcc, 0xef, 0xff, 0x7b, 0x6f, 0x18, 0x34, 0x4b, 0x38, 0xcd, 0xb9, 0xc0, 0xf3, 0x0c, 0x7c,
+	0x59, 0x60, 0x26, 0x16, 0x50, 0x50, 0x92, 0xa6, 0x19, 0x7c, 0xc1, 0x05, 0xf8, 0xeb, 0x93, 0x39,
+	0x48, 0x7c, 0xe2, 0x2f, 0x81, 0x81, 0x20, 0xc2, 0xcb, 0x0b, 0x2e, 0xb9, 0xf9, 0xbc, 0x81, 0xbc,
+	0xbf, 0x21, 0x4f, 0x43, 0xa3, 0x27, 0x4b, 0xbe, 0xe4, 0x35, 0xe1, 0x57, 0x5f, 0x0a, 0x1e, 0x4d,
+	0xdb, 0x19, 0x73, 0x5c, 0x60, 0xaa, 0x85, 0xa3, 0xd7, 0xed, 0x99, 0x64, 0x85, 0x09, 0x8b, 0x25,
+	0xbf, 0x04, 0x16, 0x13, 0xb6, 0xd0, 0x56, 0xf7, 0x7b, 0x17, 0x0d, 0xdf, 0xa9, 0x25, 0x2e, 0x24,
+	0x96, 0x60, 0x26, 0x68, 0xd0, 0x1c, 0x12, 0x96, 0x31, 0xee, 0x4e, 0x06, 0xd3, 0x57, 0x5e, 0xab,
+	0xcd, 0xbc, 0x8f, 0xb7, 0xa2, 0x30, 0x38, 0xfb, 0x54, 0xa5, 0x84, 0x6c, 0xc1, 0x83, 0xa3, 0xeb,
+	0xd2, 0xe9, 0x44, 0x48, 0xde, 0x36, 0x84, 0xf9, 0x1e, 0xf5, 0xd4, 0x1e, 0xd6, 0xbd, 0xb1, 0x31,
+	0x19, 0x4c, 0x5f, 0xdc, 0x21, 0x9f, 0x0a, 0x1d, 0xa8, 0x23, 0xcc, 0x39, 0x3a, 0xa6, 0x84, 0x49,
+	0x48, 0x63, 0x71, 0x95, 0xe7, 0x19, 0x01, 0x61, 0x75, 0xeb, 0xa9, 0x67, 0x2d, 0x53, 0x3f, 0xd4,
+	0xf4, 0x45, 0x05, 0x6f, 0x74, 0xf6, 0x03, 0xda, 0xf4, 0x08, 0x08, 0x53, 0xa0, 0x87, 0x39, 0xb0,
+	0x94, 0xb0, 0x65, 0x5c, 0x00, 0xe5, 0x6b, 0x9c, 0x09, 0xeb, 0xa8, 0x96, 0x04, 0x2d, 0x25, 0x51,
+	0x85, 0xc1, 0xff, 0x2e, 0xe8, 0x58, 0x1b, 0x22, 0x2d, 0x70, 0x7f, 0x18, 0x68, 0x78, 0x38, 0x9b,
+	0x79, 0x8a, 0x86, 0x0c, 0x4b, 0xb2, 0x86, 0x38, 0x05, 0xc6, 0xa9, 0x65, 0x8c, 0x8d, 0x49, 0x3f,
+	0x78, 0xb6, 0x2f, 0x9d, 0xc7, 0x1b, 0x4c, 0xb3, 0x53, 0xf7, 0xf0, 0xaf, 0x1b, 0x0d, 0x54, 0x79,
+	0x5e, 0x55, 0xe6, 0x1b, 0x84, 0x92, 0x15, 0x66, 0x0c, 0xb2, 0x98, 0xa4, 0xf5, 0xb5, 0xf7, 0x03,
+	0x77, 0x5b, 0x3a, 0xfd, 0x33, 0xd5, 0x0d, 0xcf, 0xf7, 0xa5, 0xf3, 0x48, 0xc5, 0x34, 0x07, 0xdd,
+	0xa8, 0xaf, 0x8b, 0x30, 0x35, 0xdf, 0xa2, 0x1e, 0xa6, 0xfc, 0x8a, 0x49, 0xab, 0x5b, 0xe3, 0x5e,
+	0x35, 0xf6, 0xaf, 0xd2, 0x79, 0x9a, 0x70, 0x41, 0xb9, 0x10, 0xe9, 0xa5, 0x47, 0xb8, 0x4f, 0xb1,
+	0x5c, 0x79, 0x21, 0x93, 0xfb, 0xd2, 0xb9, 0xaf, 0xe2, 0x14, 0xe4, 0x46, 0x9a, 0x0e, 0x5e, 0x5e,
+	0x6f, 0x6d, 0xe3, 0x66, 0x6b, 0x1b, 0xbf, 0xb7, 0xb6, 0xf1, 0x6d, 0x67, 0x77, 0x6e, 0x76, 0x76,
+	0xe7, 0xe7, 0xce, 0xee, 0x7c, 0x1e, 0x7d, 0xfd, 0xd7, 0x33, 0x96, 0x9b, 0x1c, 0xc4, 0xbc, 0x57,
+	0x3f, 0xd8, 0xd9, 0x9f, 0x01, 0x00, 0x62, 0x57, 0x2c, 0xd4, 0x9a, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +214,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingRemovals) > 0 {
+		for iNdEx := len(m.PendingRemovals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRemovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MintedSupplies) > 0 {
+		for iNdEx := len(m.MintedSupplies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintedSupplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -153,6 +269,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MintedSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintedSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintedSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -178,6 +341,37 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MintedSupplies) > 0 {
+		for _, e := range m.MintedSupplies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRemovals) > 0 {
+		for _, e := range m.PendingRemovals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintedSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -283,6 +477,222 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupplies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintedSupplies = append(m.MintedSupplies, MintedSupply{})
+			if err := m.MintedSupplies[len(m.MintedSupplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRemovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRemovals = append(m.PendingRemovals, RemoveParachainIBCTokenInfo{})
+			if err := m.PendingRemovals[len(m.PendingRemovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintedSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintedSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintedSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,6 +27,18 @@ var (
 		NativeDenom: "native-3",
 		AssetId:     "asset-3",
 	}
+	bridgeToken = ParachainIBCTokenInfo{
+		IbcDenom:    "ibc-test-5",
+		ChannelID:   "channel-5",
+		NativeDenom: "native-5",
+		RemoteDenom: "uusdc",
+	}
+	dupIBCDenom = ParachainIBCTokenInfo{
+		IbcDenom:    "ibc-test-1",
+		ChannelID:   "channel-6",
+		NativeDenom: "native-6",
+		RemoteDenom: "uusdc",
+	}
 	dup1 = ParachainIBCTokenInfo{
 		IbcDenom:    "ibc-test-4",
 		ChannelID:   "channel-4",
@@ -78,6 +92,13 @@ func TestGenesisState_Validate(t *testing.T) {
 			infos:       duplicateInfos,
 			expectedErr: true,
 		},
+		"valid bridge token without asset id": {
+			infos: []ParachainIBCTokenInfo{info1, bridgeToken},
+		},
+		"duplicate ibc denom": {
+			infos:       []ParachainIBCTokenInfo{info1, dupIBCDenom},
+			expectedErr: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -97,3 +118,66 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_ValidateSupplyAndRemovals(t *testing.T) {
+	testCases := map[string]struct {
+		mintedSupplies  []MintedSupply
+		pendingRemovals []RemoveParachainIBCTokenInfo
+
+		expectedErr bool
+	}{
+		"valid minted supplies and pending removals": {
+			mintedSupplies: []MintedSupply{
+				{NativeDenom: "native-1", ChannelID: "channel-1", Amount: math.NewInt(100)},
+				{NativeDenom: "native-2", ChannelID: "channel-2", Amount: math.ZeroInt()},
+			},
+			pendingRemovals: []RemoveParachainIBCTokenInfo{
+				{NativeDenom: "native-1", ChannelID: "channel-1"},
+				{NativeDenom: "native-2"},
+			},
+		},
+		"minted supply of unknown channel": {
+			mintedSupplies: []MintedSupply{{NativeDenom: "native-1", ChannelID: "channel-2", Amount: math.NewInt(100)}},
+			expectedErr:    true,
+		},
+		"duplicate minted supply": {
+			mintedSupplies: []MintedSupply{
+				{NativeDenom: "native-1", ChannelID: "channel-1", Amount: math.NewInt(100)},
+				{NativeDenom: "native-1", ChannelID: "channel-1", Amount: math.NewInt(200)},
+			},
+			expectedErr: true,
+		},
+		"negative minted supply": {
+			mintedSupplies: []MintedSupply{{NativeDenom: "native-1", ChannelID: "channel-1", Amount: math.NewInt(-1)}},
+			expectedErr:    true,
+		},
+		"pending removal of unknown native denom": {
+			pendingRemovals: []RemoveParachainIBCTokenInfo{{NativeDenom: "native-3"}},
+			expectedErr:     true,
+		},
+		"duplicate pending removal": {
+			pendingRemovals: []RemoveParachainIBCTokenInfo{
+				{NativeDenom: "native-1", ChannelID: "channel-1"},
+				{NativeDenom: "native-1", ChannelID: "channel-1"},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateGenesis(GenesisState{
+				TokenInfos:      []ParachainIBCTokenInfo{info1, info2},
+				MintedSupplies:  tc.mintedSupplies,
+				PendingRemovals: tc.pendingRemovals,
+			})
+
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -3,13 +3,63 @@ package types
 import (
 	fmt "fmt"
 	"strconv"
+	"strings"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 )
 
 func (p ParachainIBCTokenInfo) ValidateBasic() error {
-	_, err := strconv.Atoi(p.AssetId)
-	if err != nil {
-		return fmt.Errorf("error parsing into int %v", p.AssetId)
+	// bridge tokens of other counterparties are identified by their remote denom only
+	if p.AssetId != "" || p.RemoteDenom == "" {
+		_, err := strconv.Atoi(p.AssetId)
+		if err != nil {
+			return fmt.Errorf("error parsing into int %v", p.AssetId)
+		}
+	}
+
+	if p.RemoteDenom != "" {
+		if err := ibctransfertypes.ValidatePrefixedDenom(p.RemoteDenom); err != nil {
+			return err
+		}
+	}
+
+	if !p.SupplyCap.IsNil() && p.SupplyCap.IsNegative() {
+		return fmt.Errorf("negative supply cap %v", p.SupplyCap)
 	}
 
 	return nil
 }
+
+// HasSupplyCap returns true if the native supply minted on the channel is capped.
+func (p ParachainIBCTokenInfo) HasSupplyCap() bool {
+	return !p.SupplyCap.IsNil() && p.SupplyCap.IsPositive()
+}
+
+// GetBridgeTokenIBCDenom returns the IBC denom of the vouchers of a remote denom received on a channel.
+func GetBridgeTokenIBCDenom(channelID, remoteDenom string) string {
+	prefixedDenom := ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, channelID, remoteDenom)
+	return ibctransfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+// NewDenomMetadata returns the bank metadata of a native denom shown as display with exponent decimals,
+// the symbol defaults to the upper case display.
+func NewDenomMetadata(nativeDenom, display string, exponent uint32, symbol string) banktypes.Metadata {
+	if symbol == "" {
+		symbol = strings.ToUpper(display)
+	}
+
+	denomUnits := []*banktypes.DenomUnit{{Denom: nativeDenom, Exponent: 0}}
+	if display != nativeDenom {
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{Denom: display, Exponent: exponent})
+	}
+
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("%s minted by the %s module", symbol, ModuleName),
+		DenomUnits:  denomUnits,
+		Base:        nativeDenom,
+		Display:     display,
+		Name:        display,
+		Symbol:      symbol,
+	}
+}
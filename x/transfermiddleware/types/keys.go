@@ -23,10 +23,20 @@ var (
 	KeyIBCDenomAndNativeIndex                   = []byte{0x03}
 	KeyRlyAddress                               = []byte{0x04}
 	KeyParachainIBCTokenRemoveListByNativeDenom = []byte{0x05}
+	KeyMintedSupplyByNativeDenom                = []byte{0x06}
+
+	// LegacyRlyAddressValue is the value of the rly addresses allowed before they carried metadata
+	LegacyRlyAddressValue = []byte{1}
 )
 
-func GetKeyParachainIBCTokenInfoByNativeDenom(nativeDenom string) []byte {
-	return append(KeyParachainIBCTokenInfoByNativeDenom, []byte(nativeDenom)...)
+// GetKeyParachainIBCTokenInfoByNativeDenom returns the key of the token info of a native denom on a channel
+func GetKeyParachainIBCTokenInfoByNativeDenom(nativeDenom, channelID string) []byte {
+	return append(GetKeyParachainIBCTokenInfoByNativeDenomPrefix(nativeDenom), []byte(channelID)...)
+}
+
+// GetKeyParachainIBCTokenInfoByNativeDenomPrefix returns the prefix of the token infos of all the channels of a native denom
+func GetKeyParachainIBCTokenInfoByNativeDenomPrefix(nativeDenom string) []byte {
+	return append(KeyParachainIBCTokenInfoByNativeDenom, []byte(nativeDenom+KeySeparator)...)
 }
 
 func GetKeyParachainIBCTokenInfoByAssetID(assetID string) []byte {
@@ -41,6 +51,12 @@ func GetKeyByRlyAddress(rlyAddress string) []byte {
 	return append(KeyRlyAddress, []byte(rlyAddress)...)
 }
 
-func GetKeyParachainIBCTokenRemoveListByNativeDenom(nativeDenom string) []byte {
-	return append(KeyParachainIBCTokenRemoveListByNativeDenom, []byte(nativeDenom)...)
+// GetKeyParachainIBCTokenRemoveListByNativeDenom returns the key of a pending removal,
+// the channel is empty when all the channels of the native denom are removed
+func GetKeyParachainIBCTokenRemoveListByNativeDenom(nativeDenom, channelID string) []byte {
+	return append(KeyParachainIBCTokenRemoveListByNativeDenom, []byte(nativeDenom+KeySeparator+channelID)...)
+}
+
+func GetKeyMintedSupplyByNativeDenom(nativeDenom, channelID string) []byte {
+	return append(KeyMintedSupplyByNativeDenom, []byte(nativeDenom+KeySeparator+channelID)...)
 }
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"fmt"
+
 	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
@@ -10,9 +13,15 @@ import (
 var _ sdk.Msg = &MsgAddParachainIBCTokenInfo{}
 
 const (
-	TypeMsgAddParachainIBCTokenInfo    = "add_para"
-	TypeMsgRemoveParachainIBCTokenInfo = "remove_para"
-	TypeMsgAddRlyAddress               = "add_rly_address"
+	TypeMsgAddParachainIBCTokenInfo          = "add_para"
+	TypeMsgRemoveParachainIBCTokenInfo       = "remove_para"
+	TypeMsgCancelRemoveParachainIBCTokenInfo = "cancel_remove_para"
+	TypeMsgReconcile                         = "reconcile"
+	TypeMsgSetPrimaryChannel                 = "set_primary_channel"
+	TypeMsgRegisterBridgeToken               = "register_bridge_token"
+	TypeMsgUpdateBridgeToken                 = "update_bridge_token"
+	TypeMsgAddRlyAddress                     = "add_rly_address"
+	TypeMsgRemoveRlyAddress                  = "remove_rly_address"
 )
 
 func NewMsgAddParachainIBCTokenInfo(
@@ -66,6 +75,11 @@ func (msg *MsgAddParachainIBCTokenInfo) ValidateBasic() error {
 		return err
 	}
 
+	// validate the bank metadata of the native denom
+	if msg.Display != "" {
+		return NewDenomMetadata(msg.NativeDenom, msg.Display, msg.Exponent, msg.Symbol).Validate()
+	}
+
 	return nil
 }
 
@@ -74,10 +88,12 @@ var _ sdk.Msg = &MsgRemoveParachainIBCTokenInfo{}
 func NewMsgRemoveParachainIBCTokenInfo(
 	authority string,
 	nativeDenom string,
+	channelID string,
 ) *MsgRemoveParachainIBCTokenInfo {
 	return &MsgRemoveParachainIBCTokenInfo{
 		Authority:   authority,
 		NativeDenom: nativeDenom,
+		ChannelID:   channelID,
 	}
 }
 
@@ -104,6 +120,269 @@ func (msg *MsgRemoveParachainIBCTokenInfo) ValidateBasic() error {
 		return sdkerrors.Wrap(err, "invalid authority address")
 	}
 
+	return validateOptionalChannelID(msg.ChannelID)
+}
+
+var _ sdk.Msg = &MsgCancelRemoveParachainIBCTokenInfo{}
+
+func NewMsgCancelRemoveParachainIBCTokenInfo(
+	authority string,
+	nativeDenom string,
+	channelID string,
+) *MsgCancelRemoveParachainIBCTokenInfo {
+	return &MsgCancelRemoveParachainIBCTokenInfo{
+		Authority:   authority,
+		NativeDenom: nativeDenom,
+		ChannelID:   channelID,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCancelRemoveParachainIBCTokenInfo) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCancelRemoveParachainIBCTokenInfo) Type() string {
+	return TypeMsgCancelRemoveParachainIBCTokenInfo
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgCancelRemoveParachainIBCTokenInfo) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCancelRemoveParachainIBCTokenInfo message.
+func (msg *MsgCancelRemoveParachainIBCTokenInfo) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgCancelRemoveParachainIBCTokenInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if err := sdk.ValidateDenom(msg.NativeDenom); err != nil {
+		return err
+	}
+
+	return validateOptionalChannelID(msg.ChannelID)
+}
+
+var _ sdk.Msg = &MsgReconcile{}
+
+func NewMsgReconcile(
+	authority string,
+	nativeDenom string,
+	channelID string,
+) *MsgReconcile {
+	return &MsgReconcile{
+		Authority:   authority,
+		NativeDenom: nativeDenom,
+		ChannelID:   channelID,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgReconcile) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgReconcile) Type() string { return TypeMsgReconcile }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgReconcile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgReconcile message.
+func (msg *MsgReconcile) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgReconcile) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if err := sdk.ValidateDenom(msg.NativeDenom); err != nil {
+		return err
+	}
+
+	return validateOptionalChannelID(msg.ChannelID)
+}
+
+var _ sdk.Msg = &MsgSetPrimaryChannel{}
+
+func NewMsgSetPrimaryChannel(
+	authority string,
+	nativeDenom string,
+	channelID string,
+) *MsgSetPrimaryChannel {
+	return &MsgSetPrimaryChannel{
+		Authority:   authority,
+		NativeDenom: nativeDenom,
+		ChannelID:   channelID,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetPrimaryChannel) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetPrimaryChannel) Type() string { return TypeMsgSetPrimaryChannel }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetPrimaryChannel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgSetPrimaryChannel message.
+func (msg *MsgSetPrimaryChannel) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgSetPrimaryChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if err := sdk.ValidateDenom(msg.NativeDenom); err != nil {
+		return err
+	}
+
+	return host.ChannelIdentifierValidator(msg.ChannelID)
+}
+
+var _ sdk.Msg = &MsgRegisterBridgeToken{}
+
+func NewMsgRegisterBridgeToken(
+	authority string,
+	channelID string,
+	remoteDenom string,
+	nativeDenom string,
+	supplyCap math.Int,
+	display string,
+	exponent uint32,
+	symbol string,
+) *MsgRegisterBridgeToken {
+	return &MsgRegisterBridgeToken{
+		Authority:   authority,
+		ChannelID:   channelID,
+		RemoteDenom: remoteDenom,
+		NativeDenom: nativeDenom,
+		SupplyCap:   supplyCap,
+		Display:     display,
+		Exponent:    exponent,
+		Symbol:      symbol,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRegisterBridgeToken) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRegisterBridgeToken) Type() string { return TypeMsgRegisterBridgeToken }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRegisterBridgeToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRegisterBridgeToken message.
+func (msg *MsgRegisterBridgeToken) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRegisterBridgeToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
+		return err
+	}
+
+	if err := ibctransfertypes.ValidatePrefixedDenom(msg.RemoteDenom); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(msg.NativeDenom); err != nil {
+		return err
+	}
+
+	if err := validateSupplyCap(msg.SupplyCap); err != nil {
+		return err
+	}
+
+	if msg.Display != "" {
+		return NewDenomMetadata(msg.NativeDenom, msg.Display, msg.Exponent, msg.Symbol).Validate()
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateBridgeToken{}
+
+func NewMsgUpdateBridgeToken(
+	authority string,
+	nativeDenom string,
+	channelID string,
+	supplyCap math.Int,
+	paused bool,
+) *MsgUpdateBridgeToken {
+	return &MsgUpdateBridgeToken{
+		Authority:   authority,
+		NativeDenom: nativeDenom,
+		ChannelID:   channelID,
+		SupplyCap:   supplyCap,
+		Paused:      paused,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateBridgeToken) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUpdateBridgeToken) Type() string { return TypeMsgUpdateBridgeToken }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateBridgeToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateBridgeToken message.
+func (msg *MsgUpdateBridgeToken) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateBridgeToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if err := sdk.ValidateDenom(msg.NativeDenom); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
+		return err
+	}
+
+	return validateSupplyCap(msg.SupplyCap)
+}
+
+func validateSupplyCap(supplyCap math.Int) error {
+	if supplyCap.IsNil() || supplyCap.IsNegative() {
+		return fmt.Errorf("invalid supply cap %s", supplyCap)
+	}
 	return nil
 }
 
@@ -142,9 +421,62 @@ func (msg *MsgAddRlyAddress) ValidateBasic() error {
 		return sdkerrors.Wrap(err, "invalid authority address")
 	}
 
-	if _, err := sdk.AccAddressFromBech32(msg.RlyAddress); err != nil {
+	relayer := AllowedRelayer{
+		Address:     msg.RlyAddress,
+		ClientIDs:   msg.ClientIDs,
+		ClientTypes: msg.ClientTypes,
+	}
+	return relayer.ValidateBasic()
+}
+
+var _ sdk.Msg = &MsgRemoveRlyAddress{}
+
+func NewMsgRemoveRlyAddress(
+	authority string,
+	rlyAdress string,
+) *MsgRemoveRlyAddress {
+	return &MsgRemoveRlyAddress{
+		Authority:  authority,
+		RlyAddress: rlyAdress,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRemoveRlyAddress) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRemoveRlyAddress) Type() string { return TypeMsgRemoveRlyAddress }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRemoveRlyAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveRlyAddress message.
+func (msg *MsgRemoveRlyAddress) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRemoveRlyAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
 		return sdkerrors.Wrap(err, "invalid authority address")
 	}
 
+	if _, err := sdk.AccAddressFromBech32(msg.RlyAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid rly address")
+	}
+
 	return nil
 }
+
+// validateOptionalChannelID validates the channel id of msgs where an empty
+// channel id applies to all channels of the native denom.
+func validateOptionalChannelID(channelID string) error {
+	if channelID == "" {
+		return nil
+	}
+
+	return host.ChannelIdentifierValidator(channelID)
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -28,7 +30,9 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ParachainIBCTokenInfo represents information about transferable IBC tokens
-// from Parachain(Substrate based network).
+// from Parachain. It is an entry of the bridge-token registry: the IBC vouchers
+// received on the channel are locked in escrow and the native denom is minted
+// in exchange.
 type ParachainIBCTokenInfo struct {
 	// ibc_denom is the denomination of the ibced token transferred from the
 	// dotsama chain.
@@ -37,8 +41,20 @@ type ParachainIBCTokenInfo struct {
 	ChannelID string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
 	// native denom is new native minted denom in composable chain.
 	NativeDenom string `protobuf:"bytes,3,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
-	// asset id is the id of the asset on Picasso
+	// asset id is the id of the asset on Picasso, empty for bridge tokens of
+	// other counterparties.
 	AssetId string `protobuf:"bytes,4,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	// primary is true for the channel returned when the native denom is resolved
+	// without a channel, a native denom has exactly one primary channel.
+	Primary bool `protobuf:"varint,5,opt,name=primary,proto3" json:"primary,omitempty" yaml:"primary"`
+	// supply_cap is the maximum native supply minted against escrow on the
+	// channel, zero means no cap.
+	SupplyCap cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=supply_cap,json=supplyCap,proto3,customtype=cosmossdk.io/math.Int" json:"supply_cap" yaml:"supply_cap"`
+	// paused stops minting on receive and burning on send for the entry.
+	Paused bool `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	// remote_denom is the denom of the token on the counterparty chain, the IBC
+	// denom is the voucher of remote_denom received on the channel.
+	RemoteDenom string `protobuf:"bytes,8,opt,name=remote_denom,json=remoteDenom,proto3" json:"remote_denom,omitempty" yaml:"remote_denom"`
 }
 
 func (m *ParachainIBCTokenInfo) Reset()         { *m = ParachainIBCTokenInfo{} }
@@ -102,11 +118,36 @@ func (m *ParachainIBCTokenInfo) GetAssetId() string {
 	return ""
 }
 
+func (m *ParachainIBCTokenInfo) GetPrimary() bool {
+	if m != nil {
+		return m.Primary
+	}
+	return false
+}
+
+func (m *ParachainIBCTokenInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *ParachainIBCTokenInfo) GetRemoteDenom() string {
+	if m != nil {
+		return m.RemoteDenom
+	}
+	return ""
+}
+
 type RemoveParachainIBCTokenInfo struct {
 	// native denom is new native minted denom in composable chain.
 	NativeDenom string `protobuf:"bytes,1,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
+	//
 	// remove_time is the time at which the parachain token info will be removed.
 	RemoveTime time.Time `protobuf:"bytes,2,opt,name=remove_time,json=removeTime,proto3,stdtime" json:"remove_time" yaml:"start_time"`
+	// channel_id is the channel of the token info to remove, all the channels of
+	// the native denom are removed if empty.
+	ChannelID string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
 }
 
 func (m *RemoveParachainIBCTokenInfo) Reset()         { *m = RemoveParachainIBCTokenInfo{} }
@@ -156,9 +197,206 @@ func (m *RemoveParachainIBCTokenInfo) GetRemoveTime() time.Time {
 	return time.Time{}
 }
 
+func (m *RemoveParachainIBCTokenInfo) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+// AllowedRelayer is an entry of the allow list of addresses permitted to
+// submit 08-wasm client updates.
+type AllowedRelayer struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// operator_name is a human readable name of the relayer operator.
+	OperatorName string `protobuf:"bytes,2,opt,name=operator_name,json=operatorName,proto3" json:"operator_name,omitempty" yaml:"operator_name"`
+	// added_height is the block height at which the relayer was allowed.
+	AddedHeight int64 `protobuf:"varint,3,opt,name=added_height,json=addedHeight,proto3" json:"added_height,omitempty" yaml:"added_height"`
+	// expiry is the time after which the relayer is no longer allowed, the zero
+	// time means it never expires.
+	Expiry time.Time `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry" yaml:"expiry"`
+	// client_ids restricts the relayer to updating these clients, all clients
+	// are allowed if empty.
+	ClientIDs []string `protobuf:"bytes,5,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty" yaml:"client_ids"`
+	// client_types restricts the relayer to updating clients of these light
+	// client types (e.g. 08-wasm), all types are allowed if empty.
+	ClientTypes []string `protobuf:"bytes,6,rep,name=client_types,json=clientTypes,proto3" json:"client_types,omitempty" yaml:"client_types"`
+}
+
+func (m *AllowedRelayer) Reset()         { *m = AllowedRelayer{} }
+func (m *AllowedRelayer) String() string { return proto.CompactTextString(m) }
+func (*AllowedRelayer) ProtoMessage()    {}
+func (*AllowedRelayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b056b58fc55452d7, []int{2}
+}
+func (m *AllowedRelayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedRelayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedRelayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedRelayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedRelayer.Merge(m, src)
+}
+func (m *AllowedRelayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedRelayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedRelayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedRelayer proto.InternalMessageInfo
+
+func (m *AllowedRelayer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AllowedRelayer) GetOperatorName() string {
+	if m != nil {
+		return m.OperatorName
+	}
+	return ""
+}
+
+func (m *AllowedRelayer) GetAddedHeight() int64 {
+	if m != nil {
+		return m.AddedHeight
+	}
+	return 0
+}
+
+func (m *AllowedRelayer) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func (m *AllowedRelayer) GetClientIDs() []string {
+	if m != nil {
+		return m.ClientIDs
+	}
+	return nil
+}
+
+func (m *AllowedRelayer) GetClientTypes() []string {
+	if m != nil {
+		return m.ClientTypes
+	}
+	return nil
+}
+
+// EscrowParity compares the IBC vouchers locked in escrow with the native
+// tokens minted against them for a parachain token info.
+type EscrowParity struct {
+	NativeDenom string `protobuf:"bytes,1,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
+	IbcDenom    string `protobuf:"bytes,2,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty" yaml:"ibc_denom"`
+	ChannelID   string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// escrowed_vouchers are the IBC vouchers locked in the escrow address of the
+	// channel.
+	EscrowedVouchers types.Coin `protobuf:"bytes,4,opt,name=escrowed_vouchers,json=escrowedVouchers,proto3" json:"escrowed_vouchers" yaml:"escrowed_vouchers"`
+	// native_supply is the supply of native tokens minted against escrow.
+	NativeSupply types.Coin `protobuf:"bytes,5,opt,name=native_supply,json=nativeSupply,proto3" json:"native_supply" yaml:"native_supply"`
+	// total_native_supply is the total supply of the native denom, including
+	// tokens not minted by the module.
+	TotalNativeSupply types.Coin `protobuf:"bytes,6,opt,name=total_native_supply,json=totalNativeSupply,proto3" json:"total_native_supply" yaml:"total_native_supply"`
+	// delta is the escrowed vouchers minus the native supply, a negative delta
+	// means minted tokens are not backed.
+	Delta cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=delta,proto3,customtype=cosmossdk.io/math.Int" json:"delta" yaml:"delta"`
+}
+
+func (m *EscrowParity) Reset()         { *m = EscrowParity{} }
+func (m *EscrowParity) String() string { return proto.CompactTextString(m) }
+func (*EscrowParity) ProtoMessage()    {}
+func (*EscrowParity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b056b58fc55452d7, []int{3}
+}
+func (m *EscrowParity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowParity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowParity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowParity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowParity.Merge(m, src)
+}
+func (m *EscrowParity) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowParity) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowParity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowParity proto.InternalMessageInfo
+
+func (m *EscrowParity) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
+	}
+	return ""
+}
+
+func (m *EscrowParity) GetIbcDenom() string {
+	if m != nil {
+		return m.IbcDenom
+	}
+	return ""
+}
+
+func (m *EscrowParity) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *EscrowParity) GetEscrowedVouchers() types.Coin {
+	if m != nil {
+		return m.EscrowedVouchers
+	}
+	return types.Coin{}
+}
+
+func (m *EscrowParity) GetNativeSupply() types.Coin {
+	if m != nil {
+		return m.NativeSupply
+	}
+	return types.Coin{}
+}
+
+func (m *EscrowParity) GetTotalNativeSupply() types.Coin {
+	if m != nil {
+		return m.TotalNativeSupply
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*ParachainIBCTokenInfo)(nil), "composable.transfermiddleware.v1beta1.ParachainIBCTokenInfo")
 	proto.RegisterType((*RemoveParachainIBCTokenInfo)(nil), "composable.transfermiddleware.v1beta1.RemoveParachainIBCTokenInfo")
+	proto.RegisterType((*AllowedRelayer)(nil), "composable.transfermiddleware.v1beta1.AllowedRelayer")
+	proto.RegisterType((*EscrowParity)(nil), "composable.transfermiddleware.v1beta1.EscrowParity")
 }
 
 func init() {
@@ -166,33 +404,61 @@ func init() {
 }
 
 var fileDescriptor_b056b58fc55452d7 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0xae, 0x93, 0x40,
-	0x14, 0x86, 0x19, 0x35, 0x7a, 0x99, 0x9a, 0xa8, 0xdc, 0x6b, 0xbc, 0xc1, 0xc8, 0x98, 0x49, 0x4c,
-	0x5c, 0x41, 0xaa, 0xae, 0xee, 0x4a, 0x69, 0x37, 0xec, 0x0c, 0xe9, 0xaa, 0x1b, 0x32, 0xc0, 0x40,
-	0x27, 0xc2, 0x0c, 0x81, 0xb1, 0xda, 0xb7, 0xe8, 0xcb, 0xf8, 0x0e, 0x5d, 0x76, 0xe9, 0x0a, 0x0d,
-	0x7d, 0x03, 0x76, 0xee, 0x0c, 0x33, 0xc5, 0x1a, 0xed, 0xe6, 0xee, 0xce, 0xe1, 0x9c, 0xff, 0xfb,
-	0x27, 0xff, 0x01, 0xbe, 0x4f, 0x44, 0x59, 0x89, 0x86, 0xc4, 0x05, 0xf5, 0x64, 0x4d, 0x78, 0x93,
-	0xd1, 0xba, 0x64, 0x69, 0x5a, 0xd0, 0x2f, 0xa4, 0xa6, 0xde, 0x7a, 0x1a, 0x53, 0x49, 0xa6, 0x5e,
-	0x45, 0x6a, 0x92, 0xac, 0x08, 0xe3, 0x91, 0x14, 0x9f, 0x28, 0x8f, 0x18, 0xcf, 0x84, 0x5b, 0xd5,
-	0x42, 0x0a, 0xeb, 0xd5, 0x89, 0xe0, 0xfe, 0x4f, 0x70, 0x8f, 0x04, 0xfb, 0x2a, 0x17, 0xb9, 0x50,
-	0x0a, 0x6f, 0xa8, 0xb4, 0xd8, 0x46, 0xb9, 0x10, 0x79, 0x41, 0x3d, 0xd5, 0xc5, 0x9f, 0x33, 0x4f,
-	0xb2, 0x92, 0x36, 0x92, 0x94, 0x95, 0x5e, 0xc0, 0xbf, 0x00, 0x7c, 0xfa, 0x71, 0x34, 0x0f, 0xfc,
-	0xd9, 0x62, 0xb0, 0x0f, 0x78, 0x26, 0xac, 0x29, 0x34, 0x59, 0x9c, 0x44, 0x29, 0xe5, 0xa2, 0xbc,
-	0x06, 0x2f, 0xc1, 0x6b, 0xd3, 0xbf, 0xea, 0x5b, 0xf4, 0x78, 0x43, 0xca, 0xe2, 0x06, 0xff, 0x19,
-	0xe1, 0xf0, 0x82, 0xc5, 0xc9, 0x7c, 0x28, 0xad, 0x0f, 0x10, 0x26, 0x2b, 0xc2, 0x39, 0x2d, 0x22,
-	0x96, 0x5e, 0xdf, 0x51, 0x1a, 0xdc, 0xb5, 0xc8, 0x9c, 0xe9, 0xaf, 0xc1, 0xbc, 0x6f, 0xd1, 0x13,
-	0x0d, 0x38, 0x2d, 0xe2, 0xd0, 0x3c, 0x36, 0x41, 0x6a, 0xdd, 0xc0, 0x87, 0x9c, 0x48, 0xb6, 0xa6,
-	0x47, 0xe3, 0xbb, 0x0a, 0xf2, 0xac, 0x6f, 0xd1, 0xa5, 0xd6, 0xfd, 0x3d, 0xc5, 0xe1, 0x44, 0xb7,
-	0xda, 0xde, 0x85, 0x17, 0xa4, 0x69, 0xa8, 0x1c, 0xcc, 0xef, 0x29, 0xdd, 0x65, 0xdf, 0xa2, 0x47,
-	0x5a, 0x37, 0x4e, 0x70, 0xf8, 0x40, 0x95, 0x41, 0x8a, 0xbf, 0x01, 0xf8, 0x3c, 0xa4, 0xa5, 0x58,
-	0xd3, 0xf3, 0x09, 0xfc, 0xfb, 0x16, 0x70, 0x8b, 0xb7, 0x2c, 0xe1, 0xa4, 0x56, 0xe8, 0x68, 0x48,
-	0x5c, 0x65, 0x31, 0x79, 0x63, 0xbb, 0xfa, 0x1c, 0xee, 0x78, 0x0e, 0x77, 0x31, 0x9e, 0xc3, 0x7f,
-	0xb1, 0x6b, 0x91, 0x71, 0x8a, 0xa7, 0x91, 0xa4, 0x96, 0x4a, 0x8b, 0xb7, 0x3f, 0x10, 0x08, 0xa1,
-	0xa6, 0x0d, 0xfb, 0xfe, 0xbb, 0x5d, 0xe7, 0x80, 0x7d, 0xe7, 0x80, 0x9f, 0x9d, 0x03, 0xb6, 0x07,
-	0xc7, 0xd8, 0x1f, 0x1c, 0xe3, 0xfb, 0xc1, 0x31, 0x96, 0xf6, 0xd7, 0x73, 0x3f, 0x99, 0xdc, 0x54,
-	0xb4, 0x89, 0xef, 0x2b, 0xd3, 0xb7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x69, 0xab, 0x4b, 0xb5,
-	0x92, 0x02, 0x00, 0x00,
+	// 849 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xd6, 0x89, 0x13, 0x8f, 0x93, 0xd2, 0x6c, 0x52, 0xb1, 0x35, 0xe0, 0x8d, 0x46, 0x42,
+	0x2a, 0x12, 0xac, 0x95, 0xc2, 0xa9, 0x12, 0x12, 0xb5, 0x83, 0x84, 0x0f, 0x54, 0x65, 0x88, 0x38,
+	0x54, 0x48, 0xab, 0xf1, 0xce, 0x8b, 0x3d, 0xea, 0xee, 0xce, 0x6a, 0x66, 0xe2, 0xd4, 0x9f, 0x80,
+	0x6b, 0x3f, 0x10, 0x1f, 0xa0, 0xc7, 0x1c, 0x11, 0x87, 0x05, 0x92, 0x6f, 0xe0, 0x13, 0x47, 0xb4,
+	0x33, 0xb3, 0xf1, 0x26, 0x44, 0x8a, 0x08, 0xb7, 0x79, 0x7f, 0x7e, 0xef, 0xb7, 0xef, 0xf7, 0x9e,
+	0x9f, 0xd1, 0x37, 0x89, 0xc8, 0x0a, 0xa1, 0xe8, 0x24, 0x85, 0x81, 0x96, 0x34, 0x57, 0x27, 0x20,
+	0x33, 0xce, 0x58, 0x0a, 0x67, 0x54, 0xc2, 0x60, 0x7e, 0x38, 0x01, 0x4d, 0x0f, 0x07, 0x05, 0x95,
+	0x34, 0x99, 0x51, 0x9e, 0xc7, 0x5a, 0xbc, 0x81, 0x3c, 0xe6, 0xf9, 0x89, 0x88, 0x0a, 0x29, 0xb4,
+	0xf0, 0x3f, 0x5d, 0x55, 0x88, 0xfe, 0x5d, 0x21, 0x72, 0x15, 0x7a, 0xfb, 0x53, 0x31, 0x15, 0x06,
+	0x31, 0xa8, 0x5e, 0x16, 0xdc, 0x0b, 0xa7, 0x42, 0x4c, 0x53, 0x18, 0x18, 0x6b, 0x72, 0x7a, 0x32,
+	0xd0, 0x3c, 0x03, 0xa5, 0x69, 0x56, 0xb8, 0x84, 0x7e, 0x22, 0x54, 0x26, 0xd4, 0x60, 0x42, 0xd5,
+	0xea, 0x6b, 0x12, 0xc1, 0x73, 0x1b, 0xc7, 0x7f, 0xb5, 0xd0, 0xe3, 0x57, 0xf5, 0xc7, 0x8d, 0x87,
+	0xa3, 0xe3, 0xea, 0xf3, 0xc6, 0xf9, 0x89, 0xf0, 0x0f, 0x51, 0x87, 0x4f, 0x92, 0x98, 0x41, 0x2e,
+	0xb2, 0xc0, 0x3b, 0xf0, 0x9e, 0x76, 0x86, 0xfb, 0xcb, 0x32, 0x7c, 0xb4, 0xa0, 0x59, 0xfa, 0x1c,
+	0x5f, 0x85, 0x30, 0xd9, 0xe2, 0x93, 0xe4, 0xa8, 0x7a, 0xfa, 0x2f, 0x10, 0x4a, 0x66, 0x34, 0xcf,
+	0x21, 0x8d, 0x39, 0x0b, 0x1e, 0x18, 0x0c, 0xbe, 0x28, 0xc3, 0xce, 0xc8, 0x7a, 0xc7, 0x47, 0xcb,
+	0x32, 0xdc, 0xb5, 0x05, 0x56, 0x89, 0x98, 0x74, 0x9c, 0x31, 0x66, 0xfe, 0x73, 0xb4, 0x9d, 0x53,
+	0xcd, 0xe7, 0xe0, 0x88, 0x5b, 0xa6, 0xc8, 0x87, 0xcb, 0x32, 0xdc, 0xb3, 0xb8, 0x66, 0x14, 0x93,
+	0xae, 0x35, 0x2d, 0x7d, 0x84, 0xb6, 0xa8, 0x52, 0xa0, 0x2b, 0xf2, 0x75, 0x83, 0xdb, 0x5b, 0x96,
+	0xe1, 0x07, 0x16, 0x57, 0x47, 0x30, 0xd9, 0x34, 0xcf, 0x31, 0xf3, 0x3f, 0x47, 0x9b, 0x85, 0xe4,
+	0x19, 0x95, 0x8b, 0x60, 0xe3, 0xc0, 0x7b, 0xba, 0x35, 0xf4, 0x97, 0x65, 0xf8, 0xd0, 0xa6, 0xbb,
+	0x00, 0x26, 0x75, 0x8a, 0xff, 0x03, 0x42, 0xea, 0xb4, 0x28, 0xd2, 0x45, 0x9c, 0xd0, 0x22, 0x68,
+	0x9b, 0xfa, 0xcf, 0xde, 0x97, 0xe1, 0xda, 0xef, 0x65, 0xf8, 0xd8, 0xaa, 0xac, 0xd8, 0x9b, 0x88,
+	0x8b, 0x41, 0x46, 0xf5, 0x2c, 0x1a, 0xe7, 0x7a, 0xd5, 0xec, 0x0a, 0x88, 0x49, 0xc7, 0x1a, 0x23,
+	0x5a, 0xf8, 0x9f, 0xa1, 0x76, 0x41, 0x4f, 0x15, 0xb0, 0x60, 0xd3, 0xf0, 0xef, 0x2e, 0xcb, 0x70,
+	0xc7, 0xf1, 0x1b, 0x3f, 0x26, 0x2e, 0xa1, 0xd2, 0x45, 0x42, 0x26, 0x74, 0xad, 0xcb, 0xd6, 0x4d,
+	0x5d, 0x9a, 0x51, 0x4c, 0xba, 0xd6, 0x34, 0xba, 0xe0, 0xbf, 0x3d, 0xf4, 0x11, 0x81, 0x4c, 0xcc,
+	0xe1, 0xf6, 0x49, 0xdf, 0xd4, 0xdc, 0xfb, 0x0f, 0x9a, 0xbf, 0x46, 0x86, 0x6a, 0x0e, 0x71, 0xb5,
+	0x79, 0x66, 0xe6, 0xdd, 0x67, 0xbd, 0xc8, 0xae, 0x65, 0x54, 0xaf, 0x65, 0x74, 0x5c, 0xaf, 0xe5,
+	0xf0, 0x93, 0x4a, 0xb2, 0x86, 0x32, 0x9a, 0x4a, 0x6d, 0xb0, 0xf8, 0xdd, 0x1f, 0xa1, 0x47, 0x90,
+	0xad, 0x56, 0xe5, 0xdf, 0x58, 0xa7, 0xd6, 0x3d, 0xd6, 0x09, 0xff, 0xd2, 0x42, 0x0f, 0x5f, 0xa4,
+	0xa9, 0x38, 0x03, 0x46, 0x20, 0xa5, 0x0b, 0x90, 0xd5, 0xd4, 0x29, 0x63, 0x12, 0x94, 0x72, 0x8d,
+	0x36, 0xa6, 0xee, 0x02, 0xd5, 0x8e, 0xd8, 0x97, 0xff, 0x35, 0xda, 0x11, 0x05, 0x48, 0xaa, 0x85,
+	0x8c, 0x73, 0xea, 0x3a, 0xec, 0x0c, 0x83, 0x65, 0x19, 0xee, 0x5b, 0xcc, 0xb5, 0x30, 0x26, 0xdb,
+	0xb5, 0xfd, 0x92, 0x66, 0x50, 0x49, 0x4b, 0x19, 0x03, 0x16, 0xcf, 0x80, 0x4f, 0x67, 0xda, 0x34,
+	0xd1, 0x6a, 0x4a, 0xdb, 0x8c, 0x62, 0xd2, 0x35, 0xe6, 0x77, 0xc6, 0xf2, 0xbf, 0x47, 0x6d, 0x78,
+	0x5b, 0x70, 0xb9, 0x08, 0xd6, 0xef, 0x54, 0xf5, 0x89, 0x53, 0xd5, 0x6d, 0x8f, 0xc5, 0x59, 0x45,
+	0x5d, 0x11, 0xa3, 0x66, 0xca, 0x21, 0xaf, 0x7e, 0x04, 0x2a, 0xd8, 0x38, 0x68, 0x5d, 0xa9, 0x69,
+	0xbc, 0xe3, 0x23, 0xd5, 0x50, 0xf3, 0x2a, 0xb1, 0x52, 0xd3, 0xc6, 0x99, 0xaa, 0xba, 0x71, 0x11,
+	0xbd, 0x28, 0x40, 0x05, 0x6d, 0x53, 0xa4, 0xd1, 0x4d, 0x33, 0x8a, 0x49, 0xd7, 0x9a, 0xc7, 0xc6,
+	0xfa, 0x75, 0x1d, 0x6d, 0x7f, 0xab, 0x12, 0x29, 0xce, 0x5e, 0x51, 0xc9, 0xf5, 0xe2, 0x7f, 0x6d,
+	0xdd, 0xb5, 0xdb, 0xf4, 0xe0, 0x1e, 0xb7, 0xe9, 0x3e, 0xcb, 0xe4, 0xcf, 0xd0, 0x2e, 0x98, 0x0e,
+	0x80, 0xc5, 0x73, 0x71, 0x9a, 0xcc, 0x40, 0x2a, 0x37, 0x9b, 0x27, 0x91, 0xbd, 0x00, 0x51, 0x75,
+	0x67, 0xeb, 0x9b, 0x1d, 0x8d, 0x04, 0xcf, 0x87, 0x07, 0x6e, 0x34, 0x81, 0x1b, 0xcd, 0xcd, 0x0a,
+	0x98, 0x3c, 0xaa, 0x7d, 0x3f, 0x39, 0x97, 0xff, 0x33, 0xda, 0x71, 0xdd, 0xdb, 0x63, 0x11, 0x6c,
+	0xdc, 0xc5, 0xf2, 0xb1, 0x63, 0xd9, 0xbf, 0xa6, 0x9d, 0x45, 0x63, 0xe2, 0x94, 0xfe, 0xd1, 0x98,
+	0x7e, 0x86, 0xf6, 0xb4, 0xd0, 0x34, 0x8d, 0xaf, 0x73, 0xb4, 0xef, 0xe2, 0xc0, 0x8e, 0xa3, 0x67,
+	0x39, 0x6e, 0xa9, 0x81, 0xc9, 0xae, 0xf1, 0xbe, 0x6c, 0xd2, 0x8d, 0xd0, 0x06, 0x83, 0x54, 0x53,
+	0x73, 0xe4, 0x3a, 0xc3, 0x2f, 0xee, 0xba, 0x99, 0xdb, 0xb6, 0xbc, 0xc1, 0x60, 0x62, 0xb1, 0xc3,
+	0xaf, 0xde, 0x5f, 0xf4, 0xbd, 0xf3, 0x8b, 0xbe, 0xf7, 0xe7, 0x45, 0xdf, 0x7b, 0x77, 0xd9, 0x5f,
+	0x3b, 0xbf, 0xec, 0xaf, 0xfd, 0x76, 0xd9, 0x5f, 0x7b, 0xdd, 0x7b, 0x7b, 0xdb, 0x1f, 0xaf, 0x59,
+	0xc1, 0x49, 0xdb, 0xfc, 0x54, 0xbe, 0xfc, 0x67, 0x00, 0xf4, 0xeb, 0xd8, 0x4f, 0xa6, 0x07, 0x00,
+	0x00,
 }
 
 func (m *ParachainIBCTokenInfo) Marshal() (dAtA []byte, err error) {
@@ -215,6 +481,43 @@ func (m *ParachainIBCTokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemoteDenom) > 0 {
+		i -= len(m.RemoteDenom)
+		copy(dAtA[i:], m.RemoteDenom)
+		i = encodeVarintParachainTokenInfo(dAtA, i, uint64(len(m.RemoteDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.SupplyCap.Size()
+		i -= size
+		if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParachainTokenInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Primary {
+		i--
+		if m.Primary {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.AssetId) > 0 {
 		i -= len(m.AssetId)
 		copy(dAtA[i:], m.AssetId)
@@ -266,6 +569,13 @@ func (m *RemoveParachainIBCTokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintParachainTokenInfo(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RemoveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RemoveTime):])
 	if err1 != nil {
 		return 0, err1
@@ -284,6 +594,158 @@ func (m *RemoveParachainIBCTokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *AllowedRelayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedRelayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedRelayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientTypes) > 0 {
+		for iNdEx := len(m.ClientTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientTypes[iNdEx])
+			copy(dAtA[i:], m.ClientTypes[iNdEx])
+			i = encodeVarintParachainTokenInfo(dAtA, i, uint64(len(m.ClientTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ClientIDs) > 0 {
+		for iNdEx := len(m.ClientIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientIDs[iNdEx])
+			copy(dAtA[i:], m.ClientIDs[iNdEx])
+			i = encodeVarintParachainTokenInfo(dAtA, i, uint64(len(m.ClientIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParachainTokenInfo(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.AddedHeight != 0 {
+		i = encodeVarintParachainTokenInfo(dAtA, i, uint64(m.AddedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OperatorName) > 0 {
+		i -= len(m.OperatorName)
+		copy(dAtA[i:], m.OperatorName)
+		i = encodeVarintParachainTokenInfo(dAtA, i, uint64(len(m.OperatorName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParachainTokenInfo(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowParity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowParity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowParity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Delta.Size()
+		i -= size
+		if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParachainTokenInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.TotalNativeSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParachainTokenInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.NativeSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParachainTokenInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.EscrowedVouchers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParachainTokenInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintParachainTokenInfo(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintParachainTokenInfo(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintParachainTokenInfo(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParachainTokenInfo(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParachainTokenInfo(v)
 	base := offset
@@ -317,31 +779,541 @@ func (m *ParachainIBCTokenInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParachainTokenInfo(uint64(l))
 	}
-	return n
-}
+	if m.Primary {
+		n += 2
+	}
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovParachainTokenInfo(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.RemoteDenom)
+	if l > 0 {
+		n += 1 + l + sovParachainTokenInfo(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveParachainIBCTokenInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovParachainTokenInfo(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RemoveTime)
+	n += 1 + l + sovParachainTokenInfo(uint64(l))
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovParachainTokenInfo(uint64(l))
+	}
+	return n
+}
+
+func (m *AllowedRelayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParachainTokenInfo(uint64(l))
+	}
+	l = len(m.OperatorName)
+	if l > 0 {
+		n += 1 + l + sovParachainTokenInfo(uint64(l))
+	}
+	if m.AddedHeight != 0 {
+		n += 1 + sovParachainTokenInfo(uint64(m.AddedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovParachainTokenInfo(uint64(l))
+	if len(m.ClientIDs) > 0 {
+		for _, s := range m.ClientIDs {
+			l = len(s)
+			n += 1 + l + sovParachainTokenInfo(uint64(l))
+		}
+	}
+	if len(m.ClientTypes) > 0 {
+		for _, s := range m.ClientTypes {
+			l = len(s)
+			n += 1 + l + sovParachainTokenInfo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EscrowParity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovParachainTokenInfo(uint64(l))
+	}
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovParachainTokenInfo(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovParachainTokenInfo(uint64(l))
+	}
+	l = m.EscrowedVouchers.Size()
+	n += 1 + l + sovParachainTokenInfo(uint64(l))
+	l = m.NativeSupply.Size()
+	n += 1 + l + sovParachainTokenInfo(uint64(l))
+	l = m.TotalNativeSupply.Size()
+	n += 1 + l + sovParachainTokenInfo(uint64(l))
+	l = m.Delta.Size()
+	n += 1 + l + sovParachainTokenInfo(uint64(l))
+	return n
+}
+
+func sovParachainTokenInfo(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParachainTokenInfo(x uint64) (n int) {
+	return sovParachainTokenInfo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParachainTokenInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParachainIBCTokenInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParachainIBCTokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Primary = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParachainTokenInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParachainTokenInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveParachainIBCTokenInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveParachainIBCTokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RemoveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParachainTokenInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *RemoveParachainIBCTokenInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NativeDenom)
-	if l > 0 {
-		n += 1 + l + sovParachainTokenInfo(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RemoveTime)
-	n += 1 + l + sovParachainTokenInfo(uint64(l))
-	return n
-}
-
-func sovParachainTokenInfo(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParachainTokenInfo(x uint64) (n int) {
-	return sovParachainTokenInfo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
+func (m *AllowedRelayer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -364,15 +1336,15 @@ func (m *ParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParachainIBCTokenInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowedRelayer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParachainIBCTokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowedRelayer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -400,11 +1372,11 @@ func (m *ParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -432,11 +1404,63 @@ func (m *ParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			m.OperatorName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedHeight", wireType)
+			}
+			m.AddedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIDs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -464,11 +1488,11 @@ func (m *ParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			m.ClientIDs = append(m.ClientIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -496,7 +1520,7 @@ func (m *ParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetId = string(dAtA[iNdEx:postIndex])
+			m.ClientTypes = append(m.ClientTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -519,7 +1543,7 @@ func (m *ParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
+func (m *EscrowParity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -542,10 +1566,10 @@ func (m *RemoveParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveParachainIBCTokenInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: EscrowParity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveParachainIBCTokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EscrowParity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -582,7 +1606,71 @@ func (m *RemoveParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedVouchers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -609,7 +1697,107 @@ func (m *RemoveParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RemoveTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EscrowedVouchers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalNativeSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalNativeSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParachainTokenInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParachainTokenInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -173,6 +174,9 @@ type QueryParaTokenInfoResponse struct {
 	ChannelID   string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
 	NativeDenom string `protobuf:"bytes,3,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
 	AssetId     string `protobuf:"bytes,4,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	// infos are the token infos of all the channels backing the native denom,
+	// the fields above describe the primary channel.
+	Infos []ParachainIBCTokenInfo `protobuf:"bytes,5,rep,name=infos,proto3" json:"infos"`
 }
 
 func (m *QueryParaTokenInfoResponse) Reset()         { *m = QueryParaTokenInfoResponse{} }
@@ -236,6 +240,360 @@ func (m *QueryParaTokenInfoResponse) GetAssetId() string {
 	return ""
 }
 
+func (m *QueryParaTokenInfoResponse) GetInfos() []ParachainIBCTokenInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+// ParaTokenInfoEntry is a parachain token info with the IBC vouchers locked in
+// the escrow of its channel and the native tokens minted against them.
+type ParaTokenInfoEntry struct {
+	Info             ParachainIBCTokenInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info"`
+	EscrowedVouchers types.Coin            `protobuf:"bytes,2,opt,name=escrowed_vouchers,json=escrowedVouchers,proto3" json:"escrowed_vouchers" yaml:"escrowed_vouchers"`
+	MintedSupply     types.Coin            `protobuf:"bytes,3,opt,name=minted_supply,json=mintedSupply,proto3" json:"minted_supply" yaml:"minted_supply"`
+}
+
+func (m *ParaTokenInfoEntry) Reset()         { *m = ParaTokenInfoEntry{} }
+func (m *ParaTokenInfoEntry) String() string { return proto.CompactTextString(m) }
+func (*ParaTokenInfoEntry) ProtoMessage()    {}
+func (*ParaTokenInfoEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241820e1315881d1, []int{4}
+}
+func (m *ParaTokenInfoEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParaTokenInfoEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParaTokenInfoEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParaTokenInfoEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaTokenInfoEntry.Merge(m, src)
+}
+func (m *ParaTokenInfoEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParaTokenInfoEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaTokenInfoEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaTokenInfoEntry proto.InternalMessageInfo
+
+func (m *ParaTokenInfoEntry) GetInfo() ParachainIBCTokenInfo {
+	if m != nil {
+		return m.Info
+	}
+	return ParachainIBCTokenInfo{}
+}
+
+func (m *ParaTokenInfoEntry) GetEscrowedVouchers() types.Coin {
+	if m != nil {
+		return m.EscrowedVouchers
+	}
+	return types.Coin{}
+}
+
+func (m *ParaTokenInfoEntry) GetMintedSupply() types.Coin {
+	if m != nil {
+		return m.MintedSupply
+	}
+	return types.Coin{}
+}
+
+// QueryAllParaTokenInfosRequest is the request type for the
+// Query/AllParaTokenInfos RPC method.
+type QueryAllParaTokenInfosRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllParaTokenInfosRequest) Reset()         { *m = QueryAllParaTokenInfosRequest{} }
+func (m *QueryAllParaTokenInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllParaTokenInfosRequest) ProtoMessage()    {}
+func (*QueryAllParaTokenInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241820e1315881d1, []int{5}
+}
+func (m *QueryAllParaTokenInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllParaTokenInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllParaTokenInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllParaTokenInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllParaTokenInfosRequest.Merge(m, src)
+}
+func (m *QueryAllParaTokenInfosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllParaTokenInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllParaTokenInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllParaTokenInfosRequest proto.InternalMessageInfo
+
+func (m *QueryAllParaTokenInfosRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllParaTokenInfosResponse is the response type for the
+// Query/AllParaTokenInfos RPC method.
+type QueryAllParaTokenInfosResponse struct {
+	Infos []ParaTokenInfoEntry `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllParaTokenInfosResponse) Reset()         { *m = QueryAllParaTokenInfosResponse{} }
+func (m *QueryAllParaTokenInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllParaTokenInfosResponse) ProtoMessage()    {}
+func (*QueryAllParaTokenInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241820e1315881d1, []int{6}
+}
+func (m *QueryAllParaTokenInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllParaTokenInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllParaTokenInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllParaTokenInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllParaTokenInfosResponse.Merge(m, src)
+}
+func (m *QueryAllParaTokenInfosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllParaTokenInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllParaTokenInfosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllParaTokenInfosResponse proto.InternalMessageInfo
+
+func (m *QueryAllParaTokenInfosResponse) GetInfos() []ParaTokenInfoEntry {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *QueryAllParaTokenInfosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParaTokenInfoByAssetIDRequest is the request type for the
+// Query/ParaTokenInfoByAssetID RPC method.
+type QueryParaTokenInfoByAssetIDRequest struct {
+	AssetId string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+}
+
+func (m *QueryParaTokenInfoByAssetIDRequest) Reset()         { *m = QueryParaTokenInfoByAssetIDRequest{} }
+func (m *QueryParaTokenInfoByAssetIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParaTokenInfoByAssetIDRequest) ProtoMessage()    {}
+func (*QueryParaTokenInfoByAssetIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241820e1315881d1, []int{7}
+}
+func (m *QueryParaTokenInfoByAssetIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParaTokenInfoByAssetIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParaTokenInfoByAssetIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParaTokenInfoByAssetIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParaTokenInfoByAssetIDRequest.Merge(m, src)
+}
+func (m *QueryParaTokenInfoByAssetIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParaTokenInfoByAssetIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParaTokenInfoByAssetIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParaTokenInfoByAssetIDRequest proto.InternalMessageInfo
+
+func (m *QueryParaTokenInfoByAssetIDRequest) GetAssetId() string {
+	if m != nil {
+		return m.AssetId
+	}
+	return ""
+}
+
+// QueryParaTokenInfoByAssetIDResponse is the response type for the
+// Query/ParaTokenInfoByAssetID RPC method.
+type QueryParaTokenInfoByAssetIDResponse struct {
+	Info ParaTokenInfoEntry `protobuf:"bytes,1,opt,name=info,proto3" json:"info"`
+}
+
+func (m *QueryParaTokenInfoByAssetIDResponse) Reset()         { *m = QueryParaTokenInfoByAssetIDResponse{} }
+func (m *QueryParaTokenInfoByAssetIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParaTokenInfoByAssetIDResponse) ProtoMessage()    {}
+func (*QueryParaTokenInfoByAssetIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241820e1315881d1, []int{8}
+}
+func (m *QueryParaTokenInfoByAssetIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParaTokenInfoByAssetIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParaTokenInfoByAssetIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParaTokenInfoByAssetIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParaTokenInfoByAssetIDResponse.Merge(m, src)
+}
+func (m *QueryParaTokenInfoByAssetIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParaTokenInfoByAssetIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParaTokenInfoByAssetIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParaTokenInfoByAssetIDResponse proto.InternalMessageInfo
+
+func (m *QueryParaTokenInfoByAssetIDResponse) GetInfo() ParaTokenInfoEntry {
+	if m != nil {
+		return m.Info
+	}
+	return ParaTokenInfoEntry{}
+}
+
+// QueryParaTokenInfoByIBCDenomRequest is the request type for the
+// Query/ParaTokenInfoByIBCDenom RPC method.
+type QueryParaTokenInfoByIBCDenomRequest struct {
+	IbcDenom string `protobuf:"bytes,1,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty" yaml:"ibc_denom"`
+}
+
+func (m *QueryParaTokenInfoByIBCDenomRequest) Reset()         { *m = QueryParaTokenInfoByIBCDenomRequest{} }
+func (m *QueryParaTokenInfoByIBCDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParaTokenInfoByIBCDenomRequest) ProtoMessage()    {}
+func (*QueryParaTokenInfoByIBCDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241820e1315881d1, []int{9}
+}
+func (m *QueryParaTokenInfoByIBCDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParaTokenInfoByIBCDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParaTokenInfoByIBCDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParaTokenInfoByIBCDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParaTokenInfoByIBCDenomRequest.Merge(m, src)
+}
+func (m *QueryParaTokenInfoByIBCDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParaTokenInfoByIBCDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParaTokenInfoByIBCDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParaTokenInfoByIBCDenomRequest proto.InternalMessageInfo
+
+func (m *QueryParaTokenInfoByIBCDenomRequest) GetIbcDenom() string {
+	if m != nil {
+		return m.IbcDenom
+	}
+	return ""
+}
+
+// QueryParaTokenInfoByIBCDenomResponse is the response type for the
+// Query/ParaTokenInfoByIBCDenom RPC method.
+type QueryParaTokenInfoByIBCDenomResponse struct {
+	Info ParaTokenInfoEntry `protobuf:"bytes,1,opt,name=info,proto3" json:"info"`
+}
+
+func (m *QueryParaTokenInfoByIBCDenomResponse) Reset()         { *m = QueryParaTokenInfoByIBCDenomResponse{} }
+func (m *QueryParaTokenInfoByIBCDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParaTokenInfoByIBCDenomResponse) ProtoMessage()    {}
+func (*QueryParaTokenInfoByIBCDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241820e1315881d1, []int{10}
+}
+func (m *QueryParaTokenInfoByIBCDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParaTokenInfoByIBCDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParaTokenInfoByIBCDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParaTokenInfoByIBCDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParaTokenInfoByIBCDenomResponse.Merge(m, src)
+}
+func (m *QueryParaTokenInfoByIBCDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParaTokenInfoByIBCDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParaTokenInfoByIBCDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParaTokenInfoByIBCDenomResponse proto.InternalMessageInfo
+
+func (m *QueryParaTokenInfoByIBCDenomResponse) GetInfo() ParaTokenInfoEntry {
+	if m != nil {
+		return m.Info
+	}
+	return ParaTokenInfoEntry{}
+}
+
 // QueryIBCWhiteListRequest is the response type for the QueryIBCWhiteListRequest
 // RPC method.
 type QueryIBCWhiteListRequest struct {
@@ -246,7 +604,7 @@ func (m *QueryIBCWhiteListRequest) Reset()         { *m = QueryIBCWhiteListReque
 func (m *QueryIBCWhiteListRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIBCWhiteListRequest) ProtoMessage()    {}
 func (*QueryIBCWhiteListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241820e1315881d1, []int{4}
+	return fileDescriptor_241820e1315881d1, []int{11}
 }
 func (m *QueryIBCWhiteListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,13 +646,15 @@ type QueryIBCWhiteListResponse struct {
 	WhiteList []string `protobuf:"bytes,1,rep,name=white_list,json=whiteList,proto3" json:"white_list,omitempty" yaml:"white_list"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// relayers are the allow list entries, with their metadata and scope.
+	Relayers []AllowedRelayer `protobuf:"bytes,3,rep,name=relayers,proto3" json:"relayers"`
 }
 
 func (m *QueryIBCWhiteListResponse) Reset()         { *m = QueryIBCWhiteListResponse{} }
 func (m *QueryIBCWhiteListResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIBCWhiteListResponse) ProtoMessage()    {}
 func (*QueryIBCWhiteListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_241820e1315881d1, []int{5}
+	return fileDescriptor_241820e1315881d1, []int{12}
 }
 func (m *QueryIBCWhiteListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,88 +697,350 @@ func (m *QueryIBCWhiteListResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryEscrowAddressRequest)(nil), "composable.transfermiddleware.v1beta1.QueryEscrowAddressRequest")
-	proto.RegisterType((*QueryEscrowAddressResponse)(nil), "composable.transfermiddleware.v1beta1.QueryEscrowAddressResponse")
-	proto.RegisterType((*QueryParaTokenInfoRequest)(nil), "composable.transfermiddleware.v1beta1.QueryParaTokenInfoRequest")
-	proto.RegisterType((*QueryParaTokenInfoResponse)(nil), "composable.transfermiddleware.v1beta1.QueryParaTokenInfoResponse")
-	proto.RegisterType((*QueryIBCWhiteListRequest)(nil), "composable.transfermiddleware.v1beta1.QueryIBCWhiteListRequest")
-	proto.RegisterType((*QueryIBCWhiteListResponse)(nil), "composable.transfermiddleware.v1beta1.QueryIBCWhiteListResponse")
+func (m *QueryIBCWhiteListResponse) GetRelayers() []AllowedRelayer {
+	if m != nil {
+		return m.Relayers
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("composable/transfermiddleware/v1beta1/query.proto", fileDescriptor_241820e1315881d1)
+// QueryPendingRemovalsRequest is the request type for the Query/PendingRemovals
+// RPC method.
+type QueryPendingRemovalsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var fileDescriptor_241820e1315881d1 = []byte{
-	// 646 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x4f, 0x13, 0x4f,
-	0x14, 0x67, 0x81, 0xff, 0x5f, 0x3a, 0x08, 0xea, 0x02, 0xb1, 0xac, 0xa6, 0xc5, 0x49, 0x54, 0xe2,
-	0x61, 0x37, 0x45, 0x4e, 0x5c, 0xa4, 0x05, 0x35, 0x4d, 0x3c, 0xe0, 0xc6, 0x84, 0xc4, 0x03, 0xcd,
-	0xec, 0xee, 0xa3, 0x4c, 0xdc, 0xce, 0x2c, 0x3b, 0x03, 0xb5, 0x57, 0x3f, 0x81, 0x89, 0x37, 0xbf,
-	0x86, 0x31, 0x7e, 0x05, 0x8f, 0x24, 0x5e, 0x3c, 0x35, 0xa6, 0xf8, 0x09, 0xfa, 0x01, 0x8c, 0xd9,
-	0x99, 0x29, 0x6d, 0xa1, 0x46, 0x8a, 0xb7, 0x9d, 0x79, 0xef, 0xf7, 0x7b, 0xbf, 0xf7, 0xfa, 0x7b,
-	0x53, 0x54, 0x0a, 0x79, 0x23, 0xe1, 0x82, 0x04, 0x31, 0x78, 0x32, 0x25, 0x4c, 0xec, 0x43, 0xda,
-	0xa0, 0x51, 0x14, 0x43, 0x93, 0xa4, 0xe0, 0x1d, 0x97, 0x02, 0x90, 0xa4, 0xe4, 0x1d, 0x1e, 0x41,
-	0xda, 0x72, 0x93, 0x94, 0x4b, 0x6e, 0xdf, 0xef, 0x43, 0xdc, 0x8b, 0x10, 0xd7, 0x40, 0x9c, 0xc5,
-	0x3a, 0xaf, 0x73, 0x85, 0xf0, 0xb2, 0x2f, 0x0d, 0x76, 0xee, 0xd6, 0x39, 0xaf, 0xc7, 0xe0, 0x91,
-	0x84, 0x7a, 0x84, 0x31, 0x2e, 0x89, 0xa4, 0x9c, 0x09, 0x13, 0x7d, 0x14, 0x72, 0xd1, 0xe0, 0xc2,
-	0x0b, 0x88, 0x00, 0x5d, 0xf3, 0x4c, 0x41, 0x42, 0xea, 0x94, 0xa9, 0x64, 0x9d, 0x8b, 0xf7, 0xd0,
-	0xf2, 0xcb, 0x2c, 0xe3, 0xa9, 0x08, 0x53, 0xde, 0x2c, 0x47, 0x51, 0x0a, 0x42, 0xf8, 0x70, 0x78,
-	0x04, 0x42, 0xda, 0x65, 0x84, 0xc2, 0x03, 0xc2, 0x18, 0xc4, 0x35, 0x1a, 0xe5, 0xad, 0x15, 0x6b,
-	0x35, 0x57, 0xc1, 0x9d, 0x76, 0x31, 0xb7, 0xa5, 0x6f, 0xab, 0xdb, 0xdd, 0x76, 0xf1, 0x56, 0x8b,
-	0x34, 0xe2, 0x0d, 0xdc, 0x4f, 0xc4, 0x7e, 0xce, 0x1c, 0xaa, 0x11, 0xde, 0x43, 0xce, 0x28, 0x7e,
-	0x91, 0x70, 0x26, 0xc0, 0xde, 0x44, 0xf3, 0xa0, 0x02, 0x35, 0xa2, 0x23, 0xa6, 0xc8, 0x72, 0xb7,
-	0x5d, 0x5c, 0xd2, 0xbc, 0xc3, 0x71, 0xec, 0xcf, 0xc1, 0x20, 0x13, 0xde, 0x35, 0xfa, 0x77, 0x48,
-	0x4a, 0x5e, 0xf1, 0x37, 0xc0, 0xaa, 0x6c, 0x9f, 0xf7, 0xf4, 0x6f, 0xa0, 0xeb, 0x59, 0xb3, 0xc7,
-	0x50, 0x8b, 0x80, 0xf1, 0x86, 0x21, 0xbf, 0xdd, 0x6d, 0x17, 0x17, 0x34, 0xf9, 0x60, 0x14, 0xfb,
-	0xb3, 0xfa, 0xb8, 0xad, 0x4e, 0xbf, 0x2c, 0xa3, 0xfc, 0x1c, 0xb3, 0x51, 0x5e, 0x42, 0x39, 0x1a,
-	0x84, 0x43, 0xbc, 0x8b, 0xdd, 0x76, 0xf1, 0xa6, 0xe6, 0x3d, 0x0b, 0x61, 0x7f, 0x86, 0x06, 0xa1,
-	0x62, 0x3c, 0x37, 0xcd, 0xc9, 0x2b, 0x4c, 0xf3, 0x42, 0x43, 0x53, 0x97, 0x6f, 0xc8, 0x76, 0xd1,
-	0x0c, 0x11, 0x02, 0x64, 0x56, 0x7c, 0x5a, 0xe1, 0x16, 0xba, 0xed, 0xe2, 0x0d, 0x8d, 0xeb, 0x45,
-	0xb0, 0x7f, 0x4d, 0x7d, 0x56, 0x23, 0x1c, 0xa0, 0xbc, 0xea, 0xbf, 0x5a, 0xd9, 0xda, 0x3d, 0xa0,
-	0x12, 0x5e, 0x50, 0x21, 0x7b, 0x83, 0x7d, 0x86, 0x50, 0xdf, 0x49, 0xaa, 0xfd, 0xd9, 0xb5, 0x07,
-	0xae, 0xb6, 0x9d, 0x9b, 0xd9, 0xce, 0xd5, 0x56, 0x37, 0xb6, 0x73, 0x77, 0x48, 0x1d, 0x0c, 0xd6,
-	0x1f, 0x40, 0xe2, 0x8f, 0x96, 0xf9, 0xf9, 0x86, 0x8b, 0x98, 0x19, 0xaf, 0x23, 0xd4, 0xcc, 0x2e,
-	0x6b, 0x31, 0x15, 0x32, 0x6f, 0xad, 0x4c, 0xad, 0xe6, 0x2a, 0x4b, 0xfd, 0x19, 0xf5, 0x63, 0xd8,
-	0xcf, 0x35, 0x7b, 0x68, 0xfb, 0xf9, 0x90, 0xb6, 0x49, 0xa5, 0xed, 0xe1, 0x5f, 0xb5, 0xe9, 0x92,
-	0x83, 0xe2, 0xd6, 0x3e, 0x4d, 0xa3, 0xff, 0x94, 0x38, 0xfb, 0x8b, 0x85, 0xe6, 0x86, 0x6c, 0x60,
-	0x6f, 0xba, 0x97, 0x5a, 0x5f, 0xf7, 0x8f, 0xde, 0x74, 0xca, 0xff, 0xc0, 0xa0, 0xc5, 0xe2, 0x7b,
-	0xef, 0xbe, 0xfd, 0xfc, 0x30, 0x79, 0xc7, 0x5e, 0xf6, 0x06, 0x9e, 0x9f, 0x84, 0xa4, 0x44, 0x66,
-	0xa9, 0x34, 0xd3, 0x99, 0x29, 0x1f, 0x5a, 0xbd, 0xf1, 0x94, 0x8f, 0x7a, 0x15, 0xc6, 0x53, 0x3e,
-	0x72, 0xef, 0x47, 0x2b, 0xd7, 0x8b, 0x6d, 0x16, 0xdd, 0xfe, 0x6c, 0xa1, 0x79, 0x1f, 0x62, 0xd2,
-	0x82, 0xb4, 0x1c, 0x86, 0xfc, 0x88, 0x49, 0xfb, 0xc9, 0x38, 0x85, 0x47, 0xd8, 0xd6, 0xd9, 0xbc,
-	0x3a, 0x81, 0x11, 0xbe, 0xa2, 0x84, 0x3b, 0x76, 0x7e, 0x50, 0x38, 0x0d, 0x42, 0x65, 0xbf, 0xcc,
-	0x8a, 0x95, 0xf5, 0xaf, 0x9d, 0x82, 0x75, 0xd2, 0x29, 0x58, 0x3f, 0x3a, 0x05, 0xeb, 0xfd, 0x69,
-	0x61, 0xe2, 0xe4, 0xb4, 0x30, 0xf1, 0xfd, 0xb4, 0x30, 0xf1, 0xda, 0x79, 0x3b, 0xea, 0xbf, 0x41,
-	0xb6, 0x12, 0x10, 0xc1, 0xff, 0xea, 0x35, 0x7e, 0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xec, 0xd0,
-	0x53, 0xe9, 0x49, 0x06, 0x00, 0x00,
+func (m *QueryPendingRemovalsRequest) Reset()         { *m = QueryPendingRemovalsRequest{} }
+func (m *QueryPendingRemovalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRemovalsRequest) ProtoMessage()    {}
+func (*QueryPendingRemovalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241820e1315881d1, []int{13}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ParaTokenInfo queries all token info of a native denom.
-	ParaTokenInfo(ctx context.Context, in *QueryParaTokenInfoRequest, opts ...grpc.CallOption) (*QueryParaTokenInfoResponse, error)
-	EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error)
-	RelayerAccount(ctx context.Context, in *QueryIBCWhiteListRequest, opts ...grpc.CallOption) (*QueryIBCWhiteListResponse, error)
+func (m *QueryPendingRemovalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPendingRemovalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRemovalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPendingRemovalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRemovalsRequest.Merge(m, src)
+}
+func (m *QueryPendingRemovalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRemovalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRemovalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRemovalsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRemovalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingRemovalsResponse is the response type for the
+// Query/PendingRemovals RPC method.
+type QueryPendingRemovalsResponse struct {
+	PendingRemovals []RemoveParachainIBCTokenInfo `protobuf:"bytes,1,rep,name=pending_removals,json=pendingRemovals,proto3" json:"pending_removals" yaml:"pending_removals"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingRemovalsResponse) Reset()         { *m = QueryPendingRemovalsResponse{} }
+func (m *QueryPendingRemovalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRemovalsResponse) ProtoMessage()    {}
+func (*QueryPendingRemovalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241820e1315881d1, []int{14}
+}
+func (m *QueryPendingRemovalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRemovalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRemovalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRemovalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRemovalsResponse.Merge(m, src)
+}
+func (m *QueryPendingRemovalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRemovalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRemovalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRemovalsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRemovalsResponse) GetPendingRemovals() []RemoveParachainIBCTokenInfo {
+	if m != nil {
+		return m.PendingRemovals
+	}
+	return nil
+}
+
+func (m *QueryPendingRemovalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParityRequest is the request type for the Query/Parity RPC method.
+type QueryParityRequest struct {
+	NativeDenom string `protobuf:"bytes,1,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
+	// channel_id is the channel of the token info, the primary channel if empty.
+	ChannelID string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *QueryParityRequest) Reset()         { *m = QueryParityRequest{} }
+func (m *QueryParityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParityRequest) ProtoMessage()    {}
+func (*QueryParityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241820e1315881d1, []int{15}
+}
+func (m *QueryParityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParityRequest.Merge(m, src)
+}
+func (m *QueryParityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParityRequest proto.InternalMessageInfo
+
+func (m *QueryParityRequest) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
+	}
+	return ""
+}
+
+func (m *QueryParityRequest) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+// QueryParityResponse is the response type for the Query/Parity RPC method.
+type QueryParityResponse struct {
+	Parity EscrowParity `protobuf:"bytes,1,opt,name=parity,proto3" json:"parity"`
+}
+
+func (m *QueryParityResponse) Reset()         { *m = QueryParityResponse{} }
+func (m *QueryParityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParityResponse) ProtoMessage()    {}
+func (*QueryParityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_241820e1315881d1, []int{16}
+}
+func (m *QueryParityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParityResponse.Merge(m, src)
+}
+func (m *QueryParityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParityResponse proto.InternalMessageInfo
+
+func (m *QueryParityResponse) GetParity() EscrowParity {
+	if m != nil {
+		return m.Parity
+	}
+	return EscrowParity{}
+}
+
+func init() {
+	proto.RegisterType((*QueryEscrowAddressRequest)(nil), "composable.transfermiddleware.v1beta1.QueryEscrowAddressRequest")
+	proto.RegisterType((*QueryEscrowAddressResponse)(nil), "composable.transfermiddleware.v1beta1.QueryEscrowAddressResponse")
+	proto.RegisterType((*QueryParaTokenInfoRequest)(nil), "composable.transfermiddleware.v1beta1.QueryParaTokenInfoRequest")
+	proto.RegisterType((*QueryParaTokenInfoResponse)(nil), "composable.transfermiddleware.v1beta1.QueryParaTokenInfoResponse")
+	proto.RegisterType((*ParaTokenInfoEntry)(nil), "composable.transfermiddleware.v1beta1.ParaTokenInfoEntry")
+	proto.RegisterType((*QueryAllParaTokenInfosRequest)(nil), "composable.transfermiddleware.v1beta1.QueryAllParaTokenInfosRequest")
+	proto.RegisterType((*QueryAllParaTokenInfosResponse)(nil), "composable.transfermiddleware.v1beta1.QueryAllParaTokenInfosResponse")
+	proto.RegisterType((*QueryParaTokenInfoByAssetIDRequest)(nil), "composable.transfermiddleware.v1beta1.QueryParaTokenInfoByAssetIDRequest")
+	proto.RegisterType((*QueryParaTokenInfoByAssetIDResponse)(nil), "composable.transfermiddleware.v1beta1.QueryParaTokenInfoByAssetIDResponse")
+	proto.RegisterType((*QueryParaTokenInfoByIBCDenomRequest)(nil), "composable.transfermiddleware.v1beta1.QueryParaTokenInfoByIBCDenomRequest")
+	proto.RegisterType((*QueryParaTokenInfoByIBCDenomResponse)(nil), "composable.transfermiddleware.v1beta1.QueryParaTokenInfoByIBCDenomResponse")
+	proto.RegisterType((*QueryIBCWhiteListRequest)(nil), "composable.transfermiddleware.v1beta1.QueryIBCWhiteListRequest")
+	proto.RegisterType((*QueryIBCWhiteListResponse)(nil), "composable.transfermiddleware.v1beta1.QueryIBCWhiteListResponse")
+	proto.RegisterType((*QueryPendingRemovalsRequest)(nil), "composable.transfermiddleware.v1beta1.QueryPendingRemovalsRequest")
+	proto.RegisterType((*QueryPendingRemovalsResponse)(nil), "composable.transfermiddleware.v1beta1.QueryPendingRemovalsResponse")
+	proto.RegisterType((*QueryParityRequest)(nil), "composable.transfermiddleware.v1beta1.QueryParityRequest")
+	proto.RegisterType((*QueryParityResponse)(nil), "composable.transfermiddleware.v1beta1.QueryParityResponse")
+}
+
+func init() {
+	proto.RegisterFile("composable/transfermiddleware/v1beta1/query.proto", fileDescriptor_241820e1315881d1)
+}
+
+var fileDescriptor_241820e1315881d1 = []byte{
+	// 1192 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0x6d, 0x89, 0x5f, 0x92, 0x26, 0x99, 0xa4, 0xc4, 0xd9, 0xa4, 0x76, 0x98, 0xb4,
+	0xa5, 0x02, 0x64, 0x2b, 0x69, 0x39, 0x34, 0x42, 0x22, 0xb6, 0x13, 0x90, 0x81, 0x43, 0xbb, 0x2d,
+	0x4d, 0x85, 0x50, 0xad, 0xf5, 0xee, 0xc4, 0x5e, 0xb1, 0xde, 0xd9, 0xee, 0x6c, 0x12, 0x0c, 0x37,
+	0x3e, 0x00, 0x42, 0xea, 0x11, 0x3e, 0x06, 0x88, 0x23, 0x1c, 0x38, 0xf4, 0x58, 0x89, 0x4b, 0x0f,
+	0xc8, 0xaa, 0x12, 0x3e, 0x81, 0xb9, 0x70, 0x44, 0x3b, 0x33, 0x6b, 0x7b, 0x9d, 0x75, 0x62, 0xc7,
+	0xe6, 0xe6, 0x99, 0x37, 0xef, 0xf7, 0xde, 0xef, 0xcd, 0xfb, 0x33, 0x6b, 0xd8, 0x30, 0x68, 0xcd,
+	0xa5, 0x4c, 0x2f, 0xdb, 0x24, 0xeb, 0x7b, 0xba, 0xc3, 0xf6, 0x89, 0x57, 0xb3, 0x4c, 0xd3, 0x26,
+	0x47, 0xba, 0x47, 0xb2, 0x87, 0x1b, 0x65, 0xe2, 0xeb, 0x1b, 0xd9, 0x67, 0x07, 0xc4, 0xab, 0x67,
+	0x5c, 0x8f, 0xfa, 0x14, 0xdd, 0x6c, 0xab, 0x64, 0x4e, 0xab, 0x64, 0xa4, 0x8a, 0xba, 0x58, 0xa1,
+	0x15, 0xca, 0x35, 0xb2, 0xc1, 0x2f, 0xa1, 0xac, 0xae, 0x56, 0x28, 0xad, 0xd8, 0x24, 0xab, 0xbb,
+	0x56, 0x56, 0x77, 0x1c, 0xea, 0xeb, 0xbe, 0x45, 0x1d, 0x26, 0xa5, 0xef, 0x18, 0x94, 0xd5, 0x28,
+	0xcb, 0x96, 0x75, 0x46, 0x84, 0xcd, 0x96, 0x07, 0xae, 0x5e, 0xb1, 0x1c, 0x7e, 0x58, 0x9e, 0x4d,
+	0x75, 0x9e, 0x0d, 0x4f, 0x19, 0xd4, 0x0a, 0xe5, 0xdb, 0xfd, 0x31, 0x73, 0x75, 0x4f, 0x37, 0xaa,
+	0xba, 0xe5, 0x94, 0x7c, 0xfa, 0x15, 0x71, 0x4a, 0x96, 0xb3, 0x2f, 0x7d, 0xc5, 0x4f, 0x61, 0xf9,
+	0x41, 0xe0, 0xc3, 0x2e, 0x33, 0x3c, 0x7a, 0x94, 0x33, 0x4d, 0x8f, 0x30, 0xa6, 0x91, 0x67, 0x07,
+	0x84, 0xf9, 0x28, 0x07, 0x60, 0x54, 0x75, 0xc7, 0x21, 0x76, 0xc9, 0x32, 0x93, 0xca, 0x9a, 0x72,
+	0x3b, 0x91, 0xc7, 0xc7, 0x8d, 0x74, 0xa2, 0x20, 0x76, 0x8b, 0x3b, 0xcd, 0x46, 0x7a, 0xbe, 0xae,
+	0xd7, 0xec, 0x2d, 0xdc, 0x3e, 0x88, 0xb5, 0x84, 0x5c, 0x14, 0x4d, 0xfc, 0x14, 0xd4, 0x38, 0x7c,
+	0xe6, 0x52, 0x87, 0x11, 0xb4, 0x0d, 0x57, 0x09, 0x17, 0x94, 0x74, 0x21, 0x91, 0x46, 0x96, 0x9b,
+	0x8d, 0xf4, 0x35, 0x81, 0x1b, 0x95, 0x63, 0x6d, 0x86, 0x74, 0x22, 0xe1, 0x3d, 0xe9, 0xff, 0x7d,
+	0xdd, 0xd3, 0x1f, 0x05, 0xe4, 0x8a, 0xce, 0x3e, 0x0d, 0xfd, 0xdf, 0x82, 0xe9, 0x20, 0x9c, 0x87,
+	0xa4, 0x64, 0x12, 0x87, 0xd6, 0x24, 0xf8, 0x52, 0xb3, 0x91, 0x5e, 0x10, 0xe0, 0x9d, 0x52, 0xac,
+	0x4d, 0x89, 0xe5, 0x0e, 0x5f, 0xbd, 0x1a, 0x07, 0x35, 0x0e, 0x59, 0x7a, 0xbe, 0x01, 0x09, 0xab,
+	0x6c, 0x44, 0x70, 0x17, 0x9b, 0x8d, 0xf4, 0x9c, 0xc0, 0x6d, 0x89, 0xb0, 0x36, 0x69, 0x95, 0x0d,
+	0x8e, 0xd8, 0x15, 0xcd, 0xf1, 0x0b, 0x44, 0xf3, 0x14, 0xa1, 0x89, 0xfe, 0x09, 0xa1, 0x0c, 0x4c,
+	0xea, 0x8c, 0x11, 0x3f, 0x30, 0x7e, 0x89, 0xeb, 0x2d, 0x34, 0x1b, 0xe9, 0x59, 0xa1, 0x17, 0x4a,
+	0xb0, 0xf6, 0x06, 0xff, 0x59, 0x34, 0xd1, 0x13, 0xb8, 0x1c, 0xe4, 0x09, 0x4b, 0x5e, 0x5e, 0x9b,
+	0xb8, 0x3d, 0xb5, 0xf9, 0x41, 0xa6, 0xaf, 0x92, 0xc8, 0xdc, 0x0f, 0x73, 0xad, 0x98, 0x2f, 0xb4,
+	0xc2, 0x96, 0xbf, 0xf4, 0xa2, 0x91, 0x1e, 0xd3, 0x04, 0x20, 0xfe, 0x79, 0x1c, 0x50, 0x24, 0xaa,
+	0xbb, 0x8e, 0xef, 0xd5, 0xd1, 0x63, 0xb8, 0x14, 0xc8, 0x79, 0x34, 0x47, 0x63, 0x8f, 0xe3, 0xa1,
+	0x2a, 0xcc, 0x8b, 0x9c, 0x21, 0x66, 0xe9, 0x90, 0x1e, 0x18, 0x55, 0xe2, 0x31, 0x1e, 0xfe, 0xa9,
+	0xcd, 0xe5, 0x8c, 0x28, 0xb0, 0x4c, 0x50, 0x60, 0x2d, 0xc8, 0x02, 0xb5, 0x9c, 0xfc, 0x5a, 0x80,
+	0xd0, 0x6c, 0xa4, 0x93, 0x9d, 0x69, 0xd8, 0x81, 0x80, 0xb5, 0xb9, 0x70, 0xef, 0xb1, 0xdc, 0x42,
+	0x5f, 0xc2, 0x4c, 0xcd, 0x72, 0x7c, 0x62, 0x96, 0xd8, 0x81, 0xeb, 0xda, 0xf5, 0xe4, 0xc4, 0x79,
+	0x56, 0x56, 0xa5, 0x95, 0x45, 0x61, 0x25, 0xa2, 0x8d, 0xb5, 0x69, 0xb1, 0x7e, 0x28, 0x96, 0x15,
+	0xb8, 0xce, 0x13, 0x32, 0x67, 0xdb, 0x91, 0xe8, 0xb5, 0xca, 0xf5, 0x23, 0x80, 0x76, 0x07, 0x91,
+	0x61, 0xbc, 0x15, 0xb1, 0x2d, 0x5a, 0x5c, 0x3b, 0x74, 0x15, 0x22, 0x75, 0xb5, 0x0e, 0x4d, 0xfc,
+	0xbb, 0x02, 0xa9, 0x5e, 0x96, 0x64, 0xfa, 0x7f, 0x1e, 0x26, 0x87, 0xc2, 0x93, 0xe3, 0xde, 0x00,
+	0x97, 0x15, 0xbd, 0xf5, 0x48, 0x66, 0xa0, 0x8f, 0x23, 0x0c, 0xc4, 0x1d, 0xbd, 0x7d, 0x2e, 0x03,
+	0xe1, 0x53, 0x84, 0xc2, 0x23, 0xc0, 0xa7, 0x8b, 0x37, 0x5f, 0xcf, 0xf1, 0xdc, 0xde, 0x09, 0x03,
+	0xd6, 0x59, 0x12, 0xca, 0xf9, 0x25, 0x81, 0xbf, 0x81, 0xf5, 0x33, 0x51, 0x65, 0x70, 0x1e, 0x46,
+	0x12, 0x79, 0xe8, 0xd8, 0x70, 0x30, 0xfc, 0x24, 0xde, 0x76, 0x31, 0x5f, 0xe0, 0xe5, 0x1d, 0x52,
+	0x1a, 0xbc, 0x2f, 0xe1, 0x6f, 0xe1, 0xc6, 0xd9, 0xc8, 0xff, 0x27, 0xad, 0x32, 0x24, 0xb9, 0xf1,
+	0x62, 0xbe, 0xb0, 0x57, 0xb5, 0x7c, 0xf2, 0x99, 0xc5, 0xfc, 0x51, 0xe7, 0xf3, 0x3f, 0x0a, 0x2c,
+	0xc7, 0x18, 0x91, 0xb4, 0xee, 0x02, 0x1c, 0x05, 0x9b, 0x25, 0xdb, 0x62, 0x3e, 0xcf, 0xe7, 0x44,
+	0xfe, 0x5a, 0xbb, 0x13, 0xb7, 0x65, 0x58, 0x4b, 0x1c, 0x85, 0xda, 0x23, 0xcb, 0x54, 0xb4, 0x07,
+	0x93, 0x1e, 0xb1, 0xf5, 0x7a, 0xd0, 0x94, 0x26, 0x78, 0x31, 0xbd, 0xdf, 0x67, 0x64, 0x73, 0xb6,
+	0x1d, 0x74, 0x1f, 0x4d, 0x68, 0xcb, 0xa8, 0xb6, 0xc0, 0x30, 0x81, 0x15, 0x71, 0xad, 0xc4, 0x31,
+	0x2d, 0xa7, 0xa2, 0x91, 0x1a, 0x3d, 0xd4, 0xed, 0x91, 0x37, 0x8b, 0x7f, 0x15, 0x58, 0x8d, 0xb7,
+	0x23, 0xe3, 0xfb, 0xbd, 0x02, 0x73, 0xae, 0x90, 0x95, 0x3c, 0x29, 0x94, 0x6d, 0x23, 0xdf, 0x27,
+	0x53, 0x8e, 0x49, 0xe2, 0x3b, 0x7d, 0x5a, 0x76, 0xd0, 0x25, 0x71, 0x5d, 0xdd, 0x96, 0xb0, 0x36,
+	0xeb, 0x46, 0x1d, 0x1b, 0x5d, 0x93, 0x79, 0xae, 0x00, 0x0a, 0x2b, 0xc7, 0xf2, 0xeb, 0x23, 0x78,
+	0x75, 0x8c, 0xe0, 0x8d, 0x80, 0xab, 0xb0, 0x10, 0x71, 0x4a, 0x5e, 0xc3, 0x03, 0xb8, 0xe2, 0xf2,
+	0x1d, 0x79, 0xd7, 0x77, 0xfa, 0x8c, 0xbd, 0x78, 0xb8, 0x09, 0x30, 0x99, 0x63, 0x12, 0x68, 0xf3,
+	0xa7, 0x69, 0xb8, 0xcc, 0x4d, 0xa1, 0x5f, 0x15, 0x98, 0x89, 0x14, 0x3a, 0xda, 0xee, 0x13, 0xbe,
+	0xe7, 0xe3, 0x4d, 0xcd, 0x0d, 0x81, 0x20, 0x38, 0xe3, 0xb7, 0xbe, 0xfb, 0xf3, 0xef, 0xe7, 0xe3,
+	0x2b, 0x68, 0x39, 0xdb, 0xf1, 0x4e, 0x0e, 0x1e, 0xc3, 0xfc, 0x19, 0xcc, 0x1f, 0x07, 0x7f, 0x28,
+	0x30, 0x7f, 0x6a, 0xcc, 0xa1, 0x9d, 0x41, 0x6c, 0xf7, 0x9a, 0xc7, 0xea, 0xee, 0x90, 0x28, 0x92,
+	0x05, 0xe6, 0x2c, 0x56, 0x91, 0xda, 0x93, 0x05, 0x43, 0x7f, 0x29, 0xf0, 0x66, 0xfc, 0x54, 0x42,
+	0xc5, 0x0b, 0xc7, 0xb1, 0x7b, 0x5e, 0xaa, 0x9f, 0x8c, 0x02, 0x4a, 0xb2, 0x7a, 0x97, 0xb3, 0xba,
+	0x89, 0xd6, 0x7b, 0xb2, 0xca, 0x96, 0xeb, 0x7c, 0xf0, 0x5a, 0x26, 0x7a, 0xad, 0xc0, 0x52, 0x8f,
+	0xf1, 0x84, 0x86, 0x71, 0xaa, 0x6b, 0x7a, 0xaa, 0x9f, 0x8e, 0x04, 0x4b, 0x32, 0x7c, 0x8f, 0x33,
+	0xbc, 0x85, 0x6e, 0x9c, 0xc5, 0xd0, 0x2a, 0x1b, 0xbc, 0x0f, 0xf0, 0x12, 0x8a, 0x7c, 0x24, 0x0d,
+	0x56, 0x42, 0x71, 0xdf, 0x6f, 0x6a, 0x6e, 0x08, 0x84, 0xb3, 0x4a, 0x48, 0x3c, 0x7c, 0xe5, 0x27,
+	0x19, 0xfa, 0x45, 0x81, 0xab, 0x72, 0x08, 0xe5, 0x0c, 0x83, 0x1e, 0x38, 0x3e, 0xfa, 0x70, 0x10,
+	0xc3, 0x31, 0xa3, 0x5f, 0xdd, 0xbe, 0x38, 0x80, 0x74, 0x7c, 0x8d, 0x3b, 0xae, 0xa2, 0x64, 0xa7,
+	0xe3, 0x56, 0xd9, 0xe0, 0x23, 0x3c, 0x18, 0xe7, 0xe8, 0x37, 0x05, 0x66, 0xbb, 0x86, 0x16, 0xca,
+	0x0f, 0x94, 0x00, 0xb1, 0x93, 0x55, 0x2d, 0x0c, 0x85, 0x21, 0xdd, 0x5f, 0xe7, 0xee, 0x5f, 0x47,
+	0x2b, 0x91, 0xe4, 0x11, 0x87, 0xc3, 0xd9, 0x86, 0x7e, 0x54, 0xe0, 0x8a, 0xe8, 0xcc, 0xe8, 0xde,
+	0x80, 0x99, 0xdb, 0x9e, 0x57, 0xea, 0xd6, 0x45, 0x54, 0xa5, 0x9b, 0x2a, 0x77, 0x73, 0x11, 0xa1,
+	0xae, 0x1c, 0x0f, 0x86, 0xc5, 0xdd, 0x17, 0xc7, 0x29, 0xe5, 0xe5, 0x71, 0x4a, 0x79, 0x7d, 0x9c,
+	0x52, 0x7e, 0x38, 0x49, 0x8d, 0xbd, 0x3c, 0x49, 0x8d, 0xbd, 0x3a, 0x49, 0x8d, 0x7d, 0xa1, 0x7e,
+	0x1d, 0xf7, 0x6f, 0x85, 0x5f, 0x77, 0x09, 0x2b, 0x5f, 0xe1, 0xff, 0x4b, 0xdc, 0xf9, 0x6f, 0x00,
+	0xb4, 0xb3, 0xfa, 0xf8, 0xb5, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ParaTokenInfo queries all token info of a native denom.
+	ParaTokenInfo(ctx context.Context, in *QueryParaTokenInfoRequest, opts ...grpc.CallOption) (*QueryParaTokenInfoResponse, error)
+	// AllParaTokenInfos queries the token infos of all the parachain channels.
+	AllParaTokenInfos(ctx context.Context, in *QueryAllParaTokenInfosRequest, opts ...grpc.CallOption) (*QueryAllParaTokenInfosResponse, error)
+	// ParaTokenInfoByAssetID queries the token info of a parachain asset id.
+	ParaTokenInfoByAssetID(ctx context.Context, in *QueryParaTokenInfoByAssetIDRequest, opts ...grpc.CallOption) (*QueryParaTokenInfoByAssetIDResponse, error)
+	// ParaTokenInfoByIBCDenom queries the token info of an IBC denom.
+	ParaTokenInfoByIBCDenom(ctx context.Context, in *QueryParaTokenInfoByIBCDenomRequest, opts ...grpc.CallOption) (*QueryParaTokenInfoByIBCDenomResponse, error)
+	EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error)
+	RelayerAccount(ctx context.Context, in *QueryIBCWhiteListRequest, opts ...grpc.CallOption) (*QueryIBCWhiteListResponse, error)
+	// PendingRemovals queries the parachain token infos scheduled for removal.
+	PendingRemovals(ctx context.Context, in *QueryPendingRemovalsRequest, opts ...grpc.CallOption) (*QueryPendingRemovalsResponse, error)
+	// Parity queries the escrowed vouchers and native supply of a native denom.
+	Parity(ctx context.Context, in *QueryParityRequest, opts ...grpc.CallOption) (*QueryParityResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
 func (c *queryClient) ParaTokenInfo(ctx context.Context, in *QueryParaTokenInfoRequest, opts ...grpc.CallOption) (*QueryParaTokenInfoResponse, error) {
@@ -430,6 +1052,33 @@ func (c *queryClient) ParaTokenInfo(ctx context.Context, in *QueryParaTokenInfoR
 	return out, nil
 }
 
+func (c *queryClient) AllParaTokenInfos(ctx context.Context, in *QueryAllParaTokenInfosRequest, opts ...grpc.CallOption) (*QueryAllParaTokenInfosResponse, error) {
+	out := new(QueryAllParaTokenInfosResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Query/AllParaTokenInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParaTokenInfoByAssetID(ctx context.Context, in *QueryParaTokenInfoByAssetIDRequest, opts ...grpc.CallOption) (*QueryParaTokenInfoByAssetIDResponse, error) {
+	out := new(QueryParaTokenInfoByAssetIDResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Query/ParaTokenInfoByAssetID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParaTokenInfoByIBCDenom(ctx context.Context, in *QueryParaTokenInfoByIBCDenomRequest, opts ...grpc.CallOption) (*QueryParaTokenInfoByIBCDenomResponse, error) {
+	out := new(QueryParaTokenInfoByIBCDenomResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Query/ParaTokenInfoByIBCDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error) {
 	out := new(QueryEscrowAddressResponse)
 	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Query/EscrowAddress", in, out, opts...)
@@ -448,12 +1097,40 @@ func (c *queryClient) RelayerAccount(ctx context.Context, in *QueryIBCWhiteListR
 	return out, nil
 }
 
+func (c *queryClient) PendingRemovals(ctx context.Context, in *QueryPendingRemovalsRequest, opts ...grpc.CallOption) (*QueryPendingRemovalsResponse, error) {
+	out := new(QueryPendingRemovalsResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Query/PendingRemovals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Parity(ctx context.Context, in *QueryParityRequest, opts ...grpc.CallOption) (*QueryParityResponse, error) {
+	out := new(QueryParityResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Query/Parity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ParaTokenInfo queries all token info of a native denom.
 	ParaTokenInfo(context.Context, *QueryParaTokenInfoRequest) (*QueryParaTokenInfoResponse, error)
+	// AllParaTokenInfos queries the token infos of all the parachain channels.
+	AllParaTokenInfos(context.Context, *QueryAllParaTokenInfosRequest) (*QueryAllParaTokenInfosResponse, error)
+	// ParaTokenInfoByAssetID queries the token info of a parachain asset id.
+	ParaTokenInfoByAssetID(context.Context, *QueryParaTokenInfoByAssetIDRequest) (*QueryParaTokenInfoByAssetIDResponse, error)
+	// ParaTokenInfoByIBCDenom queries the token info of an IBC denom.
+	ParaTokenInfoByIBCDenom(context.Context, *QueryParaTokenInfoByIBCDenomRequest) (*QueryParaTokenInfoByIBCDenomResponse, error)
 	EscrowAddress(context.Context, *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error)
 	RelayerAccount(context.Context, *QueryIBCWhiteListRequest) (*QueryIBCWhiteListResponse, error)
+	// PendingRemovals queries the parachain token infos scheduled for removal.
+	PendingRemovals(context.Context, *QueryPendingRemovalsRequest) (*QueryPendingRemovalsResponse, error)
+	// Parity queries the escrowed vouchers and native supply of a native denom.
+	Parity(context.Context, *QueryParityRequest) (*QueryParityResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -463,12 +1140,27 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) ParaTokenInfo(ctx context.Context, req *QueryParaTokenInfoRequest) (*QueryParaTokenInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ParaTokenInfo not implemented")
 }
+func (*UnimplementedQueryServer) AllParaTokenInfos(ctx context.Context, req *QueryAllParaTokenInfosRequest) (*QueryAllParaTokenInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllParaTokenInfos not implemented")
+}
+func (*UnimplementedQueryServer) ParaTokenInfoByAssetID(ctx context.Context, req *QueryParaTokenInfoByAssetIDRequest) (*QueryParaTokenInfoByAssetIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParaTokenInfoByAssetID not implemented")
+}
+func (*UnimplementedQueryServer) ParaTokenInfoByIBCDenom(ctx context.Context, req *QueryParaTokenInfoByIBCDenomRequest) (*QueryParaTokenInfoByIBCDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParaTokenInfoByIBCDenom not implemented")
+}
 func (*UnimplementedQueryServer) EscrowAddress(ctx context.Context, req *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EscrowAddress not implemented")
 }
 func (*UnimplementedQueryServer) RelayerAccount(ctx context.Context, req *QueryIBCWhiteListRequest) (*QueryIBCWhiteListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RelayerAccount not implemented")
 }
+func (*UnimplementedQueryServer) PendingRemovals(ctx context.Context, req *QueryPendingRemovalsRequest) (*QueryPendingRemovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRemovals not implemented")
+}
+func (*UnimplementedQueryServer) Parity(ctx context.Context, req *QueryParityRequest) (*QueryParityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Parity not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -492,6 +1184,60 @@ func _Query_ParaTokenInfo_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllParaTokenInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllParaTokenInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllParaTokenInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Query/AllParaTokenInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllParaTokenInfos(ctx, req.(*QueryAllParaTokenInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParaTokenInfoByAssetID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParaTokenInfoByAssetIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParaTokenInfoByAssetID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Query/ParaTokenInfoByAssetID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParaTokenInfoByAssetID(ctx, req.(*QueryParaTokenInfoByAssetIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParaTokenInfoByIBCDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParaTokenInfoByIBCDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParaTokenInfoByIBCDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Query/ParaTokenInfoByIBCDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParaTokenInfoByIBCDenom(ctx, req.(*QueryParaTokenInfoByIBCDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EscrowAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryEscrowAddressRequest)
 	if err := dec(in); err != nil {
@@ -528,6 +1274,42 @@ func _Query_RelayerAccount_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingRemovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRemovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRemovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Query/PendingRemovals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRemovals(ctx, req.(*QueryPendingRemovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Parity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Parity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Query/Parity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Parity(ctx, req.(*QueryParityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "composable.transfermiddleware.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -536,6 +1318,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ParaTokenInfo",
 			Handler:    _Query_ParaTokenInfo_Handler,
 		},
+		{
+			MethodName: "AllParaTokenInfos",
+			Handler:    _Query_AllParaTokenInfos_Handler,
+		},
+		{
+			MethodName: "ParaTokenInfoByAssetID",
+			Handler:    _Query_ParaTokenInfoByAssetID_Handler,
+		},
+		{
+			MethodName: "ParaTokenInfoByIBCDenom",
+			Handler:    _Query_ParaTokenInfoByIBCDenom_Handler,
+		},
 		{
 			MethodName: "EscrowAddress",
 			Handler:    _Query_EscrowAddress_Handler,
@@ -544,6 +1338,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RelayerAccount",
 			Handler:    _Query_RelayerAccount_Handler,
 		},
+		{
+			MethodName: "PendingRemovals",
+			Handler:    _Query_PendingRemovals_Handler,
+		},
+		{
+			MethodName: "Parity",
+			Handler:    _Query_Parity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "composable/transfermiddleware/v1beta1/query.proto",
@@ -659,7 +1461,21 @@ func (m *QueryParaTokenInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
-	if len(m.AssetId) > 0 {
+	if len(m.Infos) > 0 {
+		for iNdEx := len(m.Infos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AssetId) > 0 {
 		i -= len(m.AssetId)
 		copy(dAtA[i:], m.AssetId)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetId)))
@@ -690,6 +1506,269 @@ func (m *QueryParaTokenInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ParaTokenInfoEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParaTokenInfoEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParaTokenInfoEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintedSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.EscrowedVouchers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllParaTokenInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllParaTokenInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllParaTokenInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllParaTokenInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllParaTokenInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllParaTokenInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Infos) > 0 {
+		for iNdEx := len(m.Infos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParaTokenInfoByAssetIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParaTokenInfoByAssetIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParaTokenInfoByAssetIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetId) > 0 {
+		i -= len(m.AssetId)
+		copy(dAtA[i:], m.AssetId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParaTokenInfoByAssetIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParaTokenInfoByAssetIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParaTokenInfoByAssetIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParaTokenInfoByIBCDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParaTokenInfoByIBCDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParaTokenInfoByIBCDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParaTokenInfoByIBCDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParaTokenInfoByIBCDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParaTokenInfoByIBCDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryIBCWhiteListRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -716,173 +1795,1486 @@ func (m *QueryIBCWhiteListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 			if err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCWhiteListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCWhiteListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCWhiteListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relayers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WhiteList) > 0 {
+		for iNdEx := len(m.WhiteList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WhiteList[iNdEx])
+			copy(dAtA[i:], m.WhiteList[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.WhiteList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRemovalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRemovalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRemovalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRemovalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRemovalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRemovalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingRemovals) > 0 {
+		for iNdEx := len(m.PendingRemovals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRemovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Parity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEscrowAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParaTokenInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParaTokenInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Infos) > 0 {
+		for _, e := range m.Infos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParaTokenInfoEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Info.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EscrowedVouchers.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllParaTokenInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllParaTokenInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Infos) > 0 {
+		for _, e := range m.Infos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParaTokenInfoByAssetIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParaTokenInfoByAssetIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Info.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParaTokenInfoByIBCDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParaTokenInfoByIBCDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Info.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIBCWhiteListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCWhiteListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WhiteList) > 0 {
+		for _, s := range m.WhiteList {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, e := range m.Relayers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingRemovalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRemovalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingRemovals) > 0 {
+		for _, e := range m.PendingRemovals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Parity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParaTokenInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParaTokenInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParaTokenInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParaTokenInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParaTokenInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParaTokenInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infos = append(m.Infos, ParachainIBCTokenInfo{})
+			if err := m.Infos[len(m.Infos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParaTokenInfoEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParaTokenInfoEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParaTokenInfoEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedVouchers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowedVouchers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllParaTokenInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllParaTokenInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllParaTokenInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllParaTokenInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllParaTokenInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllParaTokenInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infos = append(m.Infos, ParaTokenInfoEntry{})
+			if err := m.Infos[len(m.Infos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParaTokenInfoByAssetIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParaTokenInfoByAssetIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParaTokenInfoByAssetIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *QueryIBCWhiteListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryIBCWhiteListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
-
-func (m *QueryIBCWhiteListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+func (m *QueryParaTokenInfoByAssetIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.WhiteList) > 0 {
-		for iNdEx := len(m.WhiteList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.WhiteList[iNdEx])
-			copy(dAtA[i:], m.WhiteList[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.WhiteList[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParaTokenInfoByAssetIDResponse: wiretype end group for non-group")
 		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryEscrowAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryEscrowAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EscrowAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryParaTokenInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NativeDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryParaTokenInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.IbcDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.NativeDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AssetId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryIBCWhiteListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryIBCWhiteListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.WhiteList) > 0 {
-		for _, s := range m.WhiteList {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParaTokenInfoByAssetIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParaTokenInfoByIBCDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -905,15 +3297,15 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParaTokenInfoByIBCDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParaTokenInfoByIBCDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -941,7 +3333,7 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -964,7 +3356,7 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParaTokenInfoByIBCDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -987,17 +3379,17 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParaTokenInfoByIBCDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParaTokenInfoByIBCDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1007,23 +3399,24 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1046,7 +3439,7 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParaTokenInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIBCWhiteListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1069,17 +3462,17 @@ func (m *QueryParaTokenInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParaTokenInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIBCWhiteListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParaTokenInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIBCWhiteListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1089,23 +3482,27 @@ func (m *QueryParaTokenInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1128,7 +3525,7 @@ func (m *QueryParaTokenInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParaTokenInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIBCWhiteListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1151,15 +3548,15 @@ func (m *QueryParaTokenInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParaTokenInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIBCWhiteListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParaTokenInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIBCWhiteListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WhiteList", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1187,13 +3584,13 @@ func (m *QueryParaTokenInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			m.WhiteList = append(m.WhiteList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1203,29 +3600,33 @@ func (m *QueryParaTokenInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1235,29 +3636,81 @@ func (m *QueryParaTokenInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			m.Relayers = append(m.Relayers, AllowedRelayer{})
+			if err := m.Relayers[len(m.Relayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRemovalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRemovalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRemovalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1267,23 +3720,27 @@ func (m *QueryParaTokenInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1306,7 +3763,7 @@ func (m *QueryParaTokenInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIBCWhiteListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRemovalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1329,13 +3786,47 @@ func (m *QueryIBCWhiteListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIBCWhiteListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRemovalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIBCWhiteListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRemovalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRemovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRemovals = append(m.PendingRemovals, RemoveParachainIBCTokenInfo{})
+			if err := m.PendingRemovals[len(m.PendingRemovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1365,7 +3856,7 @@ func (m *QueryIBCWhiteListRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1392,7 +3883,7 @@ func (m *QueryIBCWhiteListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIBCWhiteListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1415,15 +3906,15 @@ func (m *QueryIBCWhiteListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIBCWhiteListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIBCWhiteListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhiteList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1451,11 +3942,93 @@ func (m *QueryIBCWhiteListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhiteList = append(m.WhiteList, string(dAtA[iNdEx:postIndex]))
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1482,10 +4055,7 @@ func (m *QueryIBCWhiteListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Parity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
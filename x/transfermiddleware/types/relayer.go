@@ -0,0 +1,66 @@
+package types
+
+import (
+	fmt "fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// ValidateBasic validates the address and the client scope of an allowed relayer
+func (r AllowedRelayer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return fmt.Errorf("invalid relayer address %s: %w", r.Address, err)
+	}
+
+	return validateRelayerScope(r.ClientIDs, r.ClientTypes)
+}
+
+// IsExpired returns true if the relayer is no longer allowed at the given block time
+func (r AllowedRelayer) IsExpired(blockTime time.Time) bool {
+	return !r.Expiry.IsZero() && !blockTime.Before(r.Expiry)
+}
+
+// AllowsClient returns true if the relayer is permitted to update the given client,
+// an empty list of client ids or client types doesn't restrict the relayer
+func (r AllowedRelayer) AllowsClient(clientID string) bool {
+	if len(r.ClientIDs) != 0 && !contains(r.ClientIDs, clientID) {
+		return false
+	}
+
+	if len(r.ClientTypes) != 0 {
+		clientType, _, err := clienttypes.ParseClientIdentifier(clientID)
+		if err != nil || !contains(r.ClientTypes, clientType) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validateRelayerScope(clientIDs, clientTypes []string) error {
+	for _, clientID := range clientIDs {
+		if err := host.ClientIdentifierValidator(clientID); err != nil {
+			return err
+		}
+	}
+
+	for _, clientType := range clientTypes {
+		if err := clienttypes.ValidateClientType(clientType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
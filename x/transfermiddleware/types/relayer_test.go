@@ -0,0 +1,135 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/notional-labs/composable/v6/x/transfermiddleware/types"
+)
+
+func TestAllowedRelayerIsExpired(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		expiry time.Time
+
+		expectedExpired bool
+	}{
+		"no expiry": {
+			expiry: time.Time{},
+		},
+		"expiry in the future": {
+			expiry: now.Add(time.Second),
+		},
+		"expiry at block time": {
+			expiry:          now,
+			expectedExpired: true,
+		},
+		"expiry in the past": {
+			expiry:          now.Add(-time.Second),
+			expectedExpired: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			relayer := types.AllowedRelayer{Expiry: tc.expiry}
+			require.Equal(t, tc.expectedExpired, relayer.IsExpired(now))
+		})
+	}
+}
+
+func TestAllowedRelayerAllowsClient(t *testing.T) {
+	testCases := map[string]struct {
+		relayer  types.AllowedRelayer
+		clientID string
+
+		expectedAllowed bool
+	}{
+		"unscoped relayer": {
+			relayer:         types.AllowedRelayer{},
+			clientID:        "07-tendermint-0",
+			expectedAllowed: true,
+		},
+		"client id in scope": {
+			relayer:         types.AllowedRelayer{ClientIDs: []string{"08-wasm-0", "08-wasm-1"}},
+			clientID:        "08-wasm-1",
+			expectedAllowed: true,
+		},
+		"client id out of scope": {
+			relayer:  types.AllowedRelayer{ClientIDs: []string{"08-wasm-0"}},
+			clientID: "08-wasm-1",
+		},
+		"client type in scope": {
+			relayer:         types.AllowedRelayer{ClientTypes: []string{"08-wasm"}},
+			clientID:        "08-wasm-3",
+			expectedAllowed: true,
+		},
+		"client type out of scope": {
+			relayer:  types.AllowedRelayer{ClientTypes: []string{"08-wasm"}},
+			clientID: "07-tendermint-0",
+		},
+		"client id in scope but client type out of scope": {
+			relayer:  types.AllowedRelayer{ClientIDs: []string{"07-tendermint-0"}, ClientTypes: []string{"08-wasm"}},
+			clientID: "07-tendermint-0",
+		},
+		"invalid client id with client type scope": {
+			relayer:  types.AllowedRelayer{ClientTypes: []string{"08-wasm"}},
+			clientID: "08-wasm",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedAllowed, tc.relayer.AllowsClient(tc.clientID))
+		})
+	}
+}
+
+func TestMsgAddRlyAddressValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority")).String()
+	rlyAddress := sdk.AccAddress([]byte("relayer")).String()
+
+	testCases := map[string]struct {
+		msg *types.MsgAddRlyAddress
+
+		expectedErr bool
+	}{
+		"unscoped relayer": {
+			msg: types.NewMsgAddRlyAddress(authority, rlyAddress),
+		},
+		"scoped relayer": {
+			msg: &types.MsgAddRlyAddress{Authority: authority, RlyAddress: rlyAddress, ClientIDs: []string{"08-wasm-0"}, ClientTypes: []string{"08-wasm"}},
+		},
+		"invalid authority": {
+			msg:         types.NewMsgAddRlyAddress("authority", rlyAddress),
+			expectedErr: true,
+		},
+		"invalid relayer address": {
+			msg:         types.NewMsgAddRlyAddress(authority, "relayer"),
+			expectedErr: true,
+		},
+		"invalid client id": {
+			msg:         &types.MsgAddRlyAddress{Authority: authority, RlyAddress: rlyAddress, ClientIDs: []string{"a"}},
+			expectedErr: true,
+		},
+		"invalid client type": {
+			msg:         &types.MsgAddRlyAddress{Authority: authority, RlyAddress: rlyAddress, ClientTypes: []string{"08-wasm-"}},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,23 +5,28 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,6 +43,11 @@ type MsgAddParachainIBCTokenInfo struct {
 	IbcDenom    string `protobuf:"bytes,3,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty" yaml:"ibc_denom"`
 	NativeDenom string `protobuf:"bytes,4,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
 	AssetId     string `protobuf:"bytes,5,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty" yaml:"asset_id"`
+	// display, exponent and symbol describe the native denom in the bank denom
+	// metadata, no metadata is registered if display is empty.
+	Display  string `protobuf:"bytes,6,opt,name=display,proto3" json:"display,omitempty" yaml:"display"`
+	Exponent uint32 `protobuf:"varint,7,opt,name=exponent,proto3" json:"exponent,omitempty" yaml:"exponent"`
+	Symbol   string `protobuf:"bytes,8,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
 }
 
 func (m *MsgAddParachainIBCTokenInfo) Reset()         { *m = MsgAddParachainIBCTokenInfo{} }
@@ -108,6 +118,27 @@ func (m *MsgAddParachainIBCTokenInfo) GetAssetId() string {
 	return ""
 }
 
+func (m *MsgAddParachainIBCTokenInfo) GetDisplay() string {
+	if m != nil {
+		return m.Display
+	}
+	return ""
+}
+
+func (m *MsgAddParachainIBCTokenInfo) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+func (m *MsgAddParachainIBCTokenInfo) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
 type MsgAddParachainIBCTokenInfoResponse struct {
 }
 
@@ -151,6 +182,9 @@ type MsgRemoveParachainIBCTokenInfo struct {
 	// overwritten).
 	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
 	NativeDenom string `protobuf:"bytes,2,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"ibc_denom"`
+	// channel_id is the channel of the token info to remove, all the channels of
+	// the native denom are removed if empty.
+	ChannelID string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
 }
 
 func (m *MsgRemoveParachainIBCTokenInfo) Reset()         { *m = MsgRemoveParachainIBCTokenInfo{} }
@@ -200,6 +234,13 @@ func (m *MsgRemoveParachainIBCTokenInfo) GetNativeDenom() string {
 	return ""
 }
 
+func (m *MsgRemoveParachainIBCTokenInfo) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
 type MsgRemoveParachainIBCTokenInfoResponse struct {
 }
 
@@ -238,26 +279,30 @@ func (m *MsgRemoveParachainIBCTokenInfoResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveParachainIBCTokenInfoResponse proto.InternalMessageInfo
 
-// MsgAddRlyAddress represents a message to add new rly address to allow list
-type MsgAddRlyAddress struct {
+// MsgCancelRemoveParachainIBCTokenInfo represents a message to cancel a
+// pending removal of parachain info.
+type MsgCancelRemoveParachainIBCTokenInfo struct {
 	// authority is the address that controls the module (defaults to x/gov unless
 	// overwritten).
-	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
-	RlyAddress string `protobuf:"bytes,2,opt,name=rly_address,json=rlyAddress,proto3" json:"rly_address,omitempty" yaml:"rly_address"`
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	NativeDenom string `protobuf:"bytes,2,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
+	// channel_id is the channel of the pending removal, empty if all the
+	// channels of the native denom are removed.
+	ChannelID string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
 }
 
-func (m *MsgAddRlyAddress) Reset()         { *m = MsgAddRlyAddress{} }
-func (m *MsgAddRlyAddress) String() string { return proto.CompactTextString(m) }
-func (*MsgAddRlyAddress) ProtoMessage()    {}
-func (*MsgAddRlyAddress) Descriptor() ([]byte, []int) {
+func (m *MsgCancelRemoveParachainIBCTokenInfo) Reset()         { *m = MsgCancelRemoveParachainIBCTokenInfo{} }
+func (m *MsgCancelRemoveParachainIBCTokenInfo) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRemoveParachainIBCTokenInfo) ProtoMessage()    {}
+func (*MsgCancelRemoveParachainIBCTokenInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_925cc3e4d71d1dc8, []int{4}
 }
-func (m *MsgAddRlyAddress) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelRemoveParachainIBCTokenInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddRlyAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelRemoveParachainIBCTokenInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddRlyAddress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelRemoveParachainIBCTokenInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -267,47 +312,58 @@ func (m *MsgAddRlyAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgAddRlyAddress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddRlyAddress.Merge(m, src)
+func (m *MsgCancelRemoveParachainIBCTokenInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRemoveParachainIBCTokenInfo.Merge(m, src)
 }
-func (m *MsgAddRlyAddress) XXX_Size() int {
+func (m *MsgCancelRemoveParachainIBCTokenInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddRlyAddress) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddRlyAddress.DiscardUnknown(m)
+func (m *MsgCancelRemoveParachainIBCTokenInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRemoveParachainIBCTokenInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddRlyAddress proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelRemoveParachainIBCTokenInfo proto.InternalMessageInfo
 
-func (m *MsgAddRlyAddress) GetAuthority() string {
+func (m *MsgCancelRemoveParachainIBCTokenInfo) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgAddRlyAddress) GetRlyAddress() string {
+func (m *MsgCancelRemoveParachainIBCTokenInfo) GetNativeDenom() string {
 	if m != nil {
-		return m.RlyAddress
+		return m.NativeDenom
 	}
 	return ""
 }
 
-type MsgAddRlyAddressResponse struct {
+func (m *MsgCancelRemoveParachainIBCTokenInfo) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
 }
 
-func (m *MsgAddRlyAddressResponse) Reset()         { *m = MsgAddRlyAddressResponse{} }
-func (m *MsgAddRlyAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddRlyAddressResponse) ProtoMessage()    {}
-func (*MsgAddRlyAddressResponse) Descriptor() ([]byte, []int) {
+type MsgCancelRemoveParachainIBCTokenInfoResponse struct {
+}
+
+func (m *MsgCancelRemoveParachainIBCTokenInfoResponse) Reset() {
+	*m = MsgCancelRemoveParachainIBCTokenInfoResponse{}
+}
+func (m *MsgCancelRemoveParachainIBCTokenInfoResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCancelRemoveParachainIBCTokenInfoResponse) ProtoMessage() {}
+func (*MsgCancelRemoveParachainIBCTokenInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_925cc3e4d71d1dc8, []int{5}
 }
-func (m *MsgAddRlyAddressResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelRemoveParachainIBCTokenInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddRlyAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelRemoveParachainIBCTokenInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddRlyAddressResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelRemoveParachainIBCTokenInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -317,530 +373,3703 @@ func (m *MsgAddRlyAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgAddRlyAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddRlyAddressResponse.Merge(m, src)
+func (m *MsgCancelRemoveParachainIBCTokenInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRemoveParachainIBCTokenInfoResponse.Merge(m, src)
 }
-func (m *MsgAddRlyAddressResponse) XXX_Size() int {
+func (m *MsgCancelRemoveParachainIBCTokenInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddRlyAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddRlyAddressResponse.DiscardUnknown(m)
+func (m *MsgCancelRemoveParachainIBCTokenInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRemoveParachainIBCTokenInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddRlyAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelRemoveParachainIBCTokenInfoResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAddParachainIBCTokenInfo)(nil), "composable.transfermiddleware.v1beta1.MsgAddParachainIBCTokenInfo")
-	proto.RegisterType((*MsgAddParachainIBCTokenInfoResponse)(nil), "composable.transfermiddleware.v1beta1.MsgAddParachainIBCTokenInfoResponse")
-	proto.RegisterType((*MsgRemoveParachainIBCTokenInfo)(nil), "composable.transfermiddleware.v1beta1.MsgRemoveParachainIBCTokenInfo")
-	proto.RegisterType((*MsgRemoveParachainIBCTokenInfoResponse)(nil), "composable.transfermiddleware.v1beta1.MsgRemoveParachainIBCTokenInfoResponse")
-	proto.RegisterType((*MsgAddRlyAddress)(nil), "composable.transfermiddleware.v1beta1.MsgAddRlyAddress")
-	proto.RegisterType((*MsgAddRlyAddressResponse)(nil), "composable.transfermiddleware.v1beta1.MsgAddRlyAddressResponse")
+// MsgReconcile represents a message to align the native supply minted against
+// escrow of a parachain token info with the vouchers locked in escrow.
+type MsgReconcile struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	NativeDenom string `protobuf:"bytes,2,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
+	// channel_id is the channel of the token info, the primary channel if empty.
+	ChannelID string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
 }
 
-func init() {
-	proto.RegisterFile("composable/transfermiddleware/v1beta1/tx.proto", fileDescriptor_925cc3e4d71d1dc8)
+func (m *MsgReconcile) Reset()         { *m = MsgReconcile{} }
+func (m *MsgReconcile) String() string { return proto.CompactTextString(m) }
+func (*MsgReconcile) ProtoMessage()    {}
+func (*MsgReconcile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_925cc3e4d71d1dc8, []int{6}
 }
-
-var fileDescriptor_925cc3e4d71d1dc8 = []byte{
-	// 536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x25, 0xfc, 0x69, 0xae, 0xfc, 0x29, 0x6e, 0x45, 0x23, 0x57, 0xb2, 0xd1, 0xa1, 0xa2,
-	0x8a, 0xc1, 0x56, 0x0a, 0x52, 0xa5, 0x2c, 0x28, 0x69, 0x19, 0x8c, 0x14, 0x09, 0x59, 0x4c, 0x2c,
-	0xd1, 0xd9, 0x77, 0x75, 0x2c, 0x6c, 0x5f, 0xe4, 0x33, 0xa1, 0x5e, 0xd9, 0x98, 0xe0, 0x0b, 0xf0,
-	0x05, 0x98, 0x90, 0xf8, 0x08, 0x2c, 0x8c, 0x1d, 0x99, 0x2c, 0x94, 0x0c, 0xec, 0x16, 0x1f, 0x00,
-	0xc5, 0xe7, 0xd8, 0x21, 0x01, 0xab, 0xb4, 0x9d, 0xfc, 0xce, 0xbf, 0xf7, 0x7b, 0xef, 0xf7, 0x7b,
-	0x7e, 0x3e, 0xa8, 0xd9, 0xcc, 0x1f, 0x31, 0x8e, 0x2d, 0x8f, 0xea, 0x51, 0x88, 0x03, 0x7e, 0x4c,
-	0x43, 0xdf, 0x25, 0xc4, 0xa3, 0x6f, 0x70, 0x48, 0xf5, 0x71, 0xdb, 0xa2, 0x11, 0x6e, 0xeb, 0xd1,
-	0x89, 0x36, 0x0a, 0x59, 0xc4, 0xa4, 0xdd, 0x32, 0x5f, 0x5b, 0xcd, 0xd7, 0xf2, 0x7c, 0x79, 0xcb,
-	0x61, 0x0e, 0xcb, 0x18, 0xfa, 0x2c, 0x12, 0x64, 0x79, 0xdb, 0x66, 0xdc, 0x67, 0x5c, 0xf7, 0xb9,
-	0xa3, 0x8f, 0xdb, 0xb3, 0x87, 0x00, 0xd0, 0xd7, 0x3a, 0xdc, 0xe9, 0x73, 0xa7, 0x4b, 0xc8, 0x73,
-	0x1c, 0x62, 0x7b, 0x88, 0xdd, 0xc0, 0xe8, 0x1d, 0xbe, 0x60, 0xaf, 0x68, 0x60, 0x04, 0xc7, 0x4c,
-	0xda, 0x87, 0x4d, 0xfc, 0x3a, 0x1a, 0xb2, 0xd0, 0x8d, 0xe2, 0x16, 0xb8, 0x07, 0xf6, 0x9a, 0xbd,
-	0xad, 0x34, 0x51, 0x37, 0x62, 0xec, 0x7b, 0x1d, 0x54, 0x40, 0xc8, 0x2c, 0xd3, 0xa4, 0x2e, 0x84,
-	0xf6, 0x10, 0x07, 0x01, 0xf5, 0x06, 0x2e, 0x69, 0xd5, 0x33, 0x12, 0x9a, 0x24, 0x6a, 0xf3, 0x50,
-	0xbc, 0x35, 0x8e, 0xd2, 0x44, 0xbd, 0x23, 0x2a, 0x94, 0x89, 0xc8, 0x6c, 0xe6, 0x07, 0x83, 0x48,
-	0x6d, 0xd8, 0x74, 0x2d, 0x7b, 0x40, 0x68, 0xc0, 0xfc, 0x56, 0x63, 0xb9, 0x6d, 0x01, 0x21, 0x73,
-	0xcd, 0xb5, 0xec, 0xa3, 0x59, 0x28, 0x75, 0xe0, 0x8d, 0x00, 0x47, 0xee, 0x98, 0xe6, 0xac, 0x2b,
-	0x19, 0x6b, 0x3b, 0x4d, 0xd4, 0x4d, 0xc1, 0x5a, 0x44, 0x91, 0xb9, 0x2e, 0x8e, 0x82, 0xab, 0xc1,
-	0x35, 0xcc, 0x39, 0x8d, 0x66, 0x7a, 0xaf, 0x66, 0xbc, 0xcd, 0x34, 0x51, 0x6f, 0xe7, 0x26, 0x73,
-	0x04, 0x99, 0xd7, 0xb3, 0xd0, 0x20, 0x9d, 0x5b, 0x6f, 0x7f, 0x7e, 0x7e, 0x58, 0x3a, 0x46, 0xbb,
-	0xf0, 0x7e, 0xc5, 0x10, 0x4d, 0xca, 0x47, 0x2c, 0xe0, 0x14, 0x7d, 0x04, 0x50, 0xe9, 0x73, 0xc7,
-	0xa4, 0x3e, 0x1b, 0xd3, 0xcb, 0x9b, 0xf7, 0xc1, 0x92, 0xf3, 0x7a, 0xc5, 0xbc, 0x16, 0x6d, 0xaf,
-	0xd8, 0xd8, 0x83, 0x0f, 0xaa, 0xe5, 0x15, 0x4e, 0xde, 0x03, 0xb8, 0x21, 0x1c, 0x9b, 0x5e, 0xdc,
-	0x25, 0x24, 0xa4, 0x9c, 0x9f, 0x53, 0xfb, 0x7a, 0xe8, 0xc5, 0x03, 0x2c, 0x4a, 0xe4, 0xd2, 0xef,
-	0xa6, 0x89, 0x2a, 0x09, 0xd6, 0x02, 0x88, 0x4c, 0x18, 0x16, 0xcd, 0x56, 0xb4, 0xcb, 0xb0, 0xb5,
-	0x2c, 0x68, 0xae, 0x76, 0xff, 0x57, 0x03, 0x36, 0xfa, 0xdc, 0x91, 0x3e, 0x01, 0xd8, 0xfa, 0xe7,
-	0xa6, 0xf7, 0xb4, 0x33, 0xfd, 0x60, 0x5a, 0xc5, 0x87, 0x96, 0x9f, 0x5d, 0xbc, 0xc6, 0x5c, 0xb4,
-	0xf4, 0x05, 0xc0, 0x9d, 0xaa, 0x4d, 0x79, 0x7a, 0xf6, 0x5e, 0x15, 0x65, 0xe4, 0xfe, 0xa5, 0x94,
-	0x29, 0x54, 0xbf, 0x03, 0xf0, 0xe6, 0x9f, 0x5b, 0x71, 0xf0, 0x5f, 0x33, 0x29, 0x89, 0xf2, 0x93,
-	0x73, 0x12, 0xe7, 0x5a, 0x7a, 0x8f, 0xbf, 0x4d, 0x14, 0x70, 0x3a, 0x51, 0xc0, 0x8f, 0x89, 0x02,
-	0x3e, 0x4c, 0x95, 0xda, 0xe9, 0x54, 0xa9, 0x7d, 0x9f, 0x2a, 0xb5, 0x97, 0xf2, 0xc9, 0xdf, 0xae,
-	0xdc, 0x28, 0x1e, 0x51, 0x6e, 0x5d, 0xcb, 0x2e, 0xc6, 0x47, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xb3, 0x08, 0xd6, 0x79, 0xa0, 0x05, 0x00, 0x00,
+func (m *MsgReconcile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReconcile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReconcile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReconcile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReconcile.Merge(m, src)
+}
+func (m *MsgReconcile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReconcile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReconcile.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgReconcile proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgReconcile) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	AddParachainIBCTokenInfo(ctx context.Context, in *MsgAddParachainIBCTokenInfo, opts ...grpc.CallOption) (*MsgAddParachainIBCTokenInfoResponse, error)
-	RemoveParachainIBCTokenInfo(ctx context.Context, in *MsgRemoveParachainIBCTokenInfo, opts ...grpc.CallOption) (*MsgRemoveParachainIBCTokenInfoResponse, error)
-	AddRlyAddress(ctx context.Context, in *MsgAddRlyAddress, opts ...grpc.CallOption) (*MsgAddRlyAddressResponse, error)
+func (m *MsgReconcile) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgReconcile) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgSetPrimaryChannel represents a message to change the primary channel of
+// a native denom backed by several parachain channels.
+type MsgSetPrimaryChannel struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	NativeDenom string `protobuf:"bytes,2,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
+	ChannelID   string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
 }
 
-func (c *msgClient) AddParachainIBCTokenInfo(ctx context.Context, in *MsgAddParachainIBCTokenInfo, opts ...grpc.CallOption) (*MsgAddParachainIBCTokenInfoResponse, error) {
-	out := new(MsgAddParachainIBCTokenInfoResponse)
-	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Msg/AddParachainIBCTokenInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetPrimaryChannel) Reset()         { *m = MsgSetPrimaryChannel{} }
+func (m *MsgSetPrimaryChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPrimaryChannel) ProtoMessage()    {}
+func (*MsgSetPrimaryChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_925cc3e4d71d1dc8, []int{7}
+}
+func (m *MsgSetPrimaryChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPrimaryChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPrimaryChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetPrimaryChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPrimaryChannel.Merge(m, src)
+}
+func (m *MsgSetPrimaryChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPrimaryChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPrimaryChannel.DiscardUnknown(m)
 }
 
-func (c *msgClient) RemoveParachainIBCTokenInfo(ctx context.Context, in *MsgRemoveParachainIBCTokenInfo, opts ...grpc.CallOption) (*MsgRemoveParachainIBCTokenInfoResponse, error) {
-	out := new(MsgRemoveParachainIBCTokenInfoResponse)
-	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Msg/RemoveParachainIBCTokenInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetPrimaryChannel proto.InternalMessageInfo
+
+func (m *MsgSetPrimaryChannel) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddRlyAddress(ctx context.Context, in *MsgAddRlyAddress, opts ...grpc.CallOption) (*MsgAddRlyAddressResponse, error) {
-	out := new(MsgAddRlyAddressResponse)
-	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Msg/AddRlyAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetPrimaryChannel) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AddParachainIBCTokenInfo(context.Context, *MsgAddParachainIBCTokenInfo) (*MsgAddParachainIBCTokenInfoResponse, error)
-	RemoveParachainIBCTokenInfo(context.Context, *MsgRemoveParachainIBCTokenInfo) (*MsgRemoveParachainIBCTokenInfoResponse, error)
-	AddRlyAddress(context.Context, *MsgAddRlyAddress) (*MsgAddRlyAddressResponse, error)
+func (m *MsgSetPrimaryChannel) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgSetPrimaryChannelResponse struct {
 }
 
-func (*UnimplementedMsgServer) AddParachainIBCTokenInfo(ctx context.Context, req *MsgAddParachainIBCTokenInfo) (*MsgAddParachainIBCTokenInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddParachainIBCTokenInfo not implemented")
+func (m *MsgSetPrimaryChannelResponse) Reset()         { *m = MsgSetPrimaryChannelResponse{} }
+func (m *MsgSetPrimaryChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPrimaryChannelResponse) ProtoMessage()    {}
+func (*MsgSetPrimaryChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_925cc3e4d71d1dc8, []int{8}
 }
-func (*UnimplementedMsgServer) RemoveParachainIBCTokenInfo(ctx context.Context, req *MsgRemoveParachainIBCTokenInfo) (*MsgRemoveParachainIBCTokenInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveParachainIBCTokenInfo not implemented")
+func (m *MsgSetPrimaryChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AddRlyAddress(ctx context.Context, req *MsgAddRlyAddress) (*MsgAddRlyAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddRlyAddress not implemented")
+func (m *MsgSetPrimaryChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPrimaryChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPrimaryChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPrimaryChannelResponse.Merge(m, src)
+}
+func (m *MsgSetPrimaryChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPrimaryChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPrimaryChannelResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetPrimaryChannelResponse proto.InternalMessageInfo
+
+// MsgRegisterBridgeToken represents a message to mint a native denom in
+// exchange for the vouchers of a token received on a channel from any
+// counterparty.
+type MsgRegisterBridgeToken struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	ChannelID string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// remote_denom is the denom of the token on the counterparty chain.
+	RemoteDenom string `protobuf:"bytes,3,opt,name=remote_denom,json=remoteDenom,proto3" json:"remote_denom,omitempty" yaml:"remote_denom"`
+	NativeDenom string `protobuf:"bytes,4,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
+	// supply_cap is the maximum native supply minted on the channel, zero means
+	// no cap.
+	SupplyCap cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=supply_cap,json=supplyCap,proto3,customtype=cosmossdk.io/math.Int" json:"supply_cap" yaml:"supply_cap"`
+	// display, exponent and symbol describe the native denom in the bank denom
+	// metadata, no metadata is registered if display is empty.
+	Display  string `protobuf:"bytes,6,opt,name=display,proto3" json:"display,omitempty" yaml:"display"`
+	Exponent uint32 `protobuf:"varint,7,opt,name=exponent,proto3" json:"exponent,omitempty" yaml:"exponent"`
+	Symbol   string `protobuf:"bytes,8,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
 }
 
-func _Msg_AddParachainIBCTokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddParachainIBCTokenInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddParachainIBCTokenInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/composable.transfermiddleware.v1beta1.Msg/AddParachainIBCTokenInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddParachainIBCTokenInfo(ctx, req.(*MsgAddParachainIBCTokenInfo))
+func (m *MsgRegisterBridgeToken) Reset()         { *m = MsgRegisterBridgeToken{} }
+func (m *MsgRegisterBridgeToken) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBridgeToken) ProtoMessage()    {}
+func (*MsgRegisterBridgeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_925cc3e4d71d1dc8, []int{9}
+}
+func (m *MsgRegisterBridgeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterBridgeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBridgeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRegisterBridgeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBridgeToken.Merge(m, src)
+}
+func (m *MsgRegisterBridgeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBridgeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBridgeToken.DiscardUnknown(m)
 }
 
-func _Msg_RemoveParachainIBCTokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveParachainIBCTokenInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveParachainIBCTokenInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/composable.transfermiddleware.v1beta1.Msg/RemoveParachainIBCTokenInfo",
+var xxx_messageInfo_MsgRegisterBridgeToken proto.InternalMessageInfo
+
+func (m *MsgRegisterBridgeToken) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveParachainIBCTokenInfo(ctx, req.(*MsgRemoveParachainIBCTokenInfo))
+	return ""
+}
+
+func (m *MsgRegisterBridgeToken) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AddRlyAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddRlyAddress)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddRlyAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/composable.transfermiddleware.v1beta1.Msg/AddRlyAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddRlyAddress(ctx, req.(*MsgAddRlyAddress))
+func (m *MsgRegisterBridgeToken) GetRemoteDenom() string {
+	if m != nil {
+		return m.RemoteDenom
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "composable.transfermiddleware.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddParachainIBCTokenInfo",
-			Handler:    _Msg_AddParachainIBCTokenInfo_Handler,
-		},
-		{
-			MethodName: "RemoveParachainIBCTokenInfo",
-			Handler:    _Msg_RemoveParachainIBCTokenInfo_Handler,
-		},
-		{
-			MethodName: "AddRlyAddress",
-			Handler:    _Msg_AddRlyAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "composable/transfermiddleware/v1beta1/tx.proto",
+	return ""
 }
 
-func (m *MsgAddParachainIBCTokenInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterBridgeToken) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddParachainIBCTokenInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterBridgeToken) GetDisplay() string {
+	if m != nil {
+		return m.Display
+	}
+	return ""
 }
 
-func (m *MsgAddParachainIBCTokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetId) > 0 {
-		i -= len(m.AssetId)
-		copy(dAtA[i:], m.AssetId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.NativeDenom) > 0 {
-		i -= len(m.NativeDenom)
-		copy(dAtA[i:], m.NativeDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.IbcDenom) > 0 {
-		i -= len(m.IbcDenom)
-		copy(dAtA[i:], m.IbcDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IbcDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChannelID) > 0 {
-		i -= len(m.ChannelID)
-		copy(dAtA[i:], m.ChannelID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRegisterBridgeToken) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgAddParachainIBCTokenInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterBridgeToken) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddParachainIBCTokenInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRegisterBridgeTokenResponse struct {
+	IbcDenom string `protobuf:"bytes,1,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty" yaml:"ibc_denom"`
 }
 
-func (m *MsgAddParachainIBCTokenInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRegisterBridgeTokenResponse) Reset()         { *m = MsgRegisterBridgeTokenResponse{} }
+func (m *MsgRegisterBridgeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBridgeTokenResponse) ProtoMessage()    {}
+func (*MsgRegisterBridgeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_925cc3e4d71d1dc8, []int{10}
 }
-
-func (m *MsgRemoveParachainIBCTokenInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterBridgeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterBridgeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBridgeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRemoveParachainIBCTokenInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterBridgeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBridgeTokenResponse.Merge(m, src)
 }
-
-func (m *MsgRemoveParachainIBCTokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NativeDenom) > 0 {
-		i -= len(m.NativeDenom)
-		copy(dAtA[i:], m.NativeDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRegisterBridgeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBridgeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBridgeTokenResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveParachainIBCTokenInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterBridgeTokenResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterBridgeTokenResponse) GetIbcDenom() string {
+	if m != nil {
+		return m.IbcDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRemoveParachainIBCTokenInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateBridgeToken represents a message to change the supply cap and the
+// pause of a bridge token on a channel.
+type MsgUpdateBridgeToken struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority   string                `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	NativeDenom string                `protobuf:"bytes,2,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
+	ChannelID   string                `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	SupplyCap   cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=supply_cap,json=supplyCap,proto3,customtype=cosmossdk.io/math.Int" json:"supply_cap" yaml:"supply_cap"`
+	Paused      bool                  `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
 }
 
-func (m *MsgRemoveParachainIBCTokenInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateBridgeToken) Reset()         { *m = MsgUpdateBridgeToken{} }
+func (m *MsgUpdateBridgeToken) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBridgeToken) ProtoMessage()    {}
+func (*MsgUpdateBridgeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_925cc3e4d71d1dc8, []int{11}
 }
-
-func (m *MsgAddRlyAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateBridgeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBridgeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBridgeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAddRlyAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateBridgeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBridgeToken.Merge(m, src)
+}
+func (m *MsgUpdateBridgeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBridgeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBridgeToken.DiscardUnknown(m)
 }
 
-func (m *MsgAddRlyAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RlyAddress) > 0 {
-		i -= len(m.RlyAddress)
-		copy(dAtA[i:], m.RlyAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RlyAddress)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_MsgUpdateBridgeToken proto.InternalMessageInfo
+
+func (m *MsgUpdateBridgeToken) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgUpdateBridgeToken) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddRlyAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateBridgeToken) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddRlyAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateBridgeToken) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
 }
 
-func (m *MsgAddRlyAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgUpdateBridgeTokenResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateBridgeTokenResponse) Reset()         { *m = MsgUpdateBridgeTokenResponse{} }
+func (m *MsgUpdateBridgeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBridgeTokenResponse) ProtoMessage()    {}
+func (*MsgUpdateBridgeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_925cc3e4d71d1dc8, []int{12}
+}
+func (m *MsgUpdateBridgeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBridgeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBridgeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgAddParachainIBCTokenInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateBridgeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBridgeTokenResponse.Merge(m, src)
+}
+func (m *MsgUpdateBridgeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBridgeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBridgeTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBridgeTokenResponse proto.InternalMessageInfo
+
+type MsgReconcileResponse struct {
+	// parity is the escrow parity before the reconciliation.
+	Parity EscrowParity `protobuf:"bytes,1,opt,name=parity,proto3" json:"parity"`
+}
+
+func (m *MsgReconcileResponse) Reset()         { *m = MsgReconcileResponse{} }
+func (m *MsgReconcileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReconcileResponse) ProtoMessage()    {}
+func (*MsgReconcileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_925cc3e4d71d1dc8, []int{13}
+}
+func (m *MsgReconcileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReconcileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReconcileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgReconcileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReconcileResponse.Merge(m, src)
+}
+func (m *MsgReconcileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReconcileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReconcileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReconcileResponse proto.InternalMessageInfo
+
+func (m *MsgReconcileResponse) GetParity() EscrowParity {
+	if m != nil {
+		return m.Parity
 	}
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return EscrowParity{}
+}
+
+// MsgAddRlyAddress represents a message to add new rly address to allow list
+type MsgAddRlyAddress struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	RlyAddress string `protobuf:"bytes,2,opt,name=rly_address,json=rlyAddress,proto3" json:"rly_address,omitempty" yaml:"rly_address"`
+	// operator_name is a human readable name of the relayer operator.
+	OperatorName string `protobuf:"bytes,3,opt,name=operator_name,json=operatorName,proto3" json:"operator_name,omitempty" yaml:"operator_name"`
+	// expiry is the time after which the relayer is no longer allowed, the zero
+	// time means it never expires.
+	Expiry time.Time `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry" yaml:"expiry"`
+	// client_ids restricts the relayer to updating these clients, all clients
+	// are allowed if empty.
+	ClientIDs []string `protobuf:"bytes,5,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty" yaml:"client_ids"`
+	// client_types restricts the relayer to updating clients of these light
+	// client types (e.g. 08-wasm), all types are allowed if empty.
+	ClientTypes []string `protobuf:"bytes,6,rep,name=client_types,json=clientTypes,proto3" json:"client_types,omitempty" yaml:"client_types"`
+}
+
+func (m *MsgAddRlyAddress) Reset()         { *m = MsgAddRlyAddress{} }
+func (m *MsgAddRlyAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgAddRlyAddress) ProtoMessage()    {}
+func (*MsgAddRlyAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_925cc3e4d71d1dc8, []int{14}
+}
+func (m *MsgAddRlyAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddRlyAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddRlyAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.IbcDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgAddRlyAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddRlyAddress.Merge(m, src)
+}
+func (m *MsgAddRlyAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddRlyAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddRlyAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddRlyAddress proto.InternalMessageInfo
+
+func (m *MsgAddRlyAddress) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = len(m.NativeDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgAddRlyAddress) GetRlyAddress() string {
+	if m != nil {
+		return m.RlyAddress
 	}
-	l = len(m.AssetId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgAddRlyAddress) GetOperatorName() string {
+	if m != nil {
+		return m.OperatorName
 	}
-	return n
+	return ""
 }
 
-func (m *MsgAddParachainIBCTokenInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddRlyAddress) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
 	}
-	var l int
-	_ = l
-	return n
+	return time.Time{}
 }
 
-func (m *MsgRemoveParachainIBCTokenInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddRlyAddress) GetClientIDs() []string {
+	if m != nil {
+		return m.ClientIDs
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *MsgAddRlyAddress) GetClientTypes() []string {
+	if m != nil {
+		return m.ClientTypes
 	}
-	l = len(m.NativeDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+type MsgAddRlyAddressResponse struct {
+}
+
+func (m *MsgAddRlyAddressResponse) Reset()         { *m = MsgAddRlyAddressResponse{} }
+func (m *MsgAddRlyAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddRlyAddressResponse) ProtoMessage()    {}
+func (*MsgAddRlyAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_925cc3e4d71d1dc8, []int{15}
+}
+func (m *MsgAddRlyAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddRlyAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddRlyAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgAddRlyAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddRlyAddressResponse.Merge(m, src)
+}
+func (m *MsgAddRlyAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddRlyAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddRlyAddressResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveParachainIBCTokenInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgAddRlyAddressResponse proto.InternalMessageInfo
+
+// MsgRemoveRlyAddress represents a message to remove a rly address from the
+// allow list
+type MsgRemoveRlyAddress struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	RlyAddress string `protobuf:"bytes,2,opt,name=rly_address,json=rlyAddress,proto3" json:"rly_address,omitempty" yaml:"rly_address"`
+}
+
+func (m *MsgRemoveRlyAddress) Reset()         { *m = MsgRemoveRlyAddress{} }
+func (m *MsgRemoveRlyAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRlyAddress) ProtoMessage()    {}
+func (*MsgRemoveRlyAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_925cc3e4d71d1dc8, []int{16}
+}
+func (m *MsgRemoveRlyAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRlyAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRlyAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgRemoveRlyAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRlyAddress.Merge(m, src)
+}
+func (m *MsgRemoveRlyAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRlyAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRlyAddress.DiscardUnknown(m)
 }
 
-func (m *MsgAddRlyAddress) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRemoveRlyAddress proto.InternalMessageInfo
+
+func (m *MsgRemoveRlyAddress) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgRemoveRlyAddress) GetRlyAddress() string {
+	if m != nil {
+		return m.RlyAddress
 	}
-	l = len(m.RlyAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+type MsgRemoveRlyAddressResponse struct {
+}
+
+func (m *MsgRemoveRlyAddressResponse) Reset()         { *m = MsgRemoveRlyAddressResponse{} }
+func (m *MsgRemoveRlyAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRlyAddressResponse) ProtoMessage()    {}
+func (*MsgRemoveRlyAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_925cc3e4d71d1dc8, []int{17}
+}
+func (m *MsgRemoveRlyAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRlyAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRlyAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRlyAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRlyAddressResponse.Merge(m, src)
+}
+func (m *MsgRemoveRlyAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRlyAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRlyAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRlyAddressResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddParachainIBCTokenInfo)(nil), "composable.transfermiddleware.v1beta1.MsgAddParachainIBCTokenInfo")
+	proto.RegisterType((*MsgAddParachainIBCTokenInfoResponse)(nil), "composable.transfermiddleware.v1beta1.MsgAddParachainIBCTokenInfoResponse")
+	proto.RegisterType((*MsgRemoveParachainIBCTokenInfo)(nil), "composable.transfermiddleware.v1beta1.MsgRemoveParachainIBCTokenInfo")
+	proto.RegisterType((*MsgRemoveParachainIBCTokenInfoResponse)(nil), "composable.transfermiddleware.v1beta1.MsgRemoveParachainIBCTokenInfoResponse")
+	proto.RegisterType((*MsgCancelRemoveParachainIBCTokenInfo)(nil), "composable.transfermiddleware.v1beta1.MsgCancelRemoveParachainIBCTokenInfo")
+	proto.RegisterType((*MsgCancelRemoveParachainIBCTokenInfoResponse)(nil), "composable.transfermiddleware.v1beta1.MsgCancelRemoveParachainIBCTokenInfoResponse")
+	proto.RegisterType((*MsgReconcile)(nil), "composable.transfermiddleware.v1beta1.MsgReconcile")
+	proto.RegisterType((*MsgSetPrimaryChannel)(nil), "composable.transfermiddleware.v1beta1.MsgSetPrimaryChannel")
+	proto.RegisterType((*MsgSetPrimaryChannelResponse)(nil), "composable.transfermiddleware.v1beta1.MsgSetPrimaryChannelResponse")
+	proto.RegisterType((*MsgRegisterBridgeToken)(nil), "composable.transfermiddleware.v1beta1.MsgRegisterBridgeToken")
+	proto.RegisterType((*MsgRegisterBridgeTokenResponse)(nil), "composable.transfermiddleware.v1beta1.MsgRegisterBridgeTokenResponse")
+	proto.RegisterType((*MsgUpdateBridgeToken)(nil), "composable.transfermiddleware.v1beta1.MsgUpdateBridgeToken")
+	proto.RegisterType((*MsgUpdateBridgeTokenResponse)(nil), "composable.transfermiddleware.v1beta1.MsgUpdateBridgeTokenResponse")
+	proto.RegisterType((*MsgReconcileResponse)(nil), "composable.transfermiddleware.v1beta1.MsgReconcileResponse")
+	proto.RegisterType((*MsgAddRlyAddress)(nil), "composable.transfermiddleware.v1beta1.MsgAddRlyAddress")
+	proto.RegisterType((*MsgAddRlyAddressResponse)(nil), "composable.transfermiddleware.v1beta1.MsgAddRlyAddressResponse")
+	proto.RegisterType((*MsgRemoveRlyAddress)(nil), "composable.transfermiddleware.v1beta1.MsgRemoveRlyAddress")
+	proto.RegisterType((*MsgRemoveRlyAddressResponse)(nil), "composable.transfermiddleware.v1beta1.MsgRemoveRlyAddressResponse")
+}
+
+func init() {
+	proto.RegisterFile("composable/transfermiddleware/v1beta1/tx.proto", fileDescriptor_925cc3e4d71d1dc8)
+}
+
+var fileDescriptor_925cc3e4d71d1dc8 = []byte{
+	// 1157 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x23, 0x47, 0xb6, 0x46, 0x76, 0x5e, 0x9b, 0xf6, 0x9b, 0xb0, 0x74, 0x2b, 0xba, 0xdb,
+	0xa6, 0x70, 0x8a, 0x80, 0x82, 0xed, 0x02, 0x01, 0x54, 0x04, 0xad, 0x25, 0xfb, 0xa0, 0x16, 0x2a,
+	0x1c, 0xda, 0xbd, 0xf4, 0x22, 0xac, 0xc8, 0x35, 0x4d, 0x84, 0xe4, 0x12, 0x5c, 0xda, 0xb1, 0x0e,
+	0xbd, 0xf4, 0xd6, 0x5b, 0x10, 0xa0, 0xa7, 0x16, 0xfd, 0x01, 0x3d, 0x15, 0xe8, 0x6f, 0x28, 0x90,
+	0x4b, 0x81, 0xf4, 0xd4, 0x8f, 0x03, 0x1b, 0xd8, 0x87, 0xa2, 0x57, 0xa1, 0x3f, 0xa0, 0xe0, 0x87,
+	0x48, 0xc9, 0x54, 0x14, 0xc9, 0x72, 0xe1, 0x22, 0x27, 0x73, 0x77, 0xe6, 0x99, 0x9d, 0x99, 0x67,
+	0x76, 0x76, 0x2c, 0x90, 0x55, 0x6a, 0x39, 0x94, 0xe1, 0x96, 0x49, 0xca, 0x9e, 0x8b, 0x6d, 0x76,
+	0x40, 0x5c, 0xcb, 0xd0, 0x34, 0x93, 0x3c, 0xc2, 0x2e, 0x29, 0x1f, 0xaf, 0xb7, 0x88, 0x87, 0xd7,
+	0xcb, 0xde, 0x89, 0xec, 0xb8, 0xd4, 0xa3, 0xfc, 0xed, 0x54, 0x5f, 0xce, 0xea, 0xcb, 0xb1, 0xbe,
+	0xb8, 0xac, 0x53, 0x9d, 0x86, 0x88, 0x72, 0xf0, 0x15, 0x81, 0xc5, 0x5b, 0x2a, 0x65, 0x16, 0x65,
+	0x65, 0x8b, 0xe9, 0xe5, 0xe3, 0xf5, 0xe0, 0x4f, 0x2c, 0x90, 0x74, 0x4a, 0x75, 0x93, 0x94, 0xc3,
+	0x55, 0xeb, 0xe8, 0xa0, 0xec, 0x19, 0x16, 0x61, 0x1e, 0xb6, 0x9c, 0x58, 0xe1, 0xc3, 0xd1, 0xdc,
+	0x74, 0xb0, 0x8b, 0xd5, 0x43, 0x6c, 0xd8, 0x4d, 0x8f, 0x3e, 0x24, 0x76, 0xd3, 0xb0, 0x0f, 0xe2,
+	0xb3, 0xd1, 0x4f, 0x39, 0x58, 0x69, 0x30, 0x7d, 0x4b, 0xd3, 0x76, 0xbb, 0x4a, 0xf5, 0x6a, 0x6d,
+	0x3f, 0x50, 0xab, 0xdb, 0x07, 0x94, 0xdf, 0x80, 0x02, 0x3e, 0xf2, 0x0e, 0xa9, 0x6b, 0x78, 0x6d,
+	0x81, 0x5b, 0xe5, 0xd6, 0x0a, 0xd5, 0xe5, 0x8e, 0x2f, 0x2d, 0xb4, 0xb1, 0x65, 0x56, 0x50, 0x22,
+	0x42, 0x4a, 0xaa, 0xc6, 0x6f, 0x01, 0xa8, 0x87, 0xd8, 0xb6, 0x89, 0xd9, 0x34, 0x34, 0xe1, 0x5a,
+	0x08, 0x42, 0xa7, 0xbe, 0x54, 0xa8, 0x45, 0xbb, 0xf5, 0xed, 0x8e, 0x2f, 0x2d, 0x46, 0x16, 0x52,
+	0x45, 0xa4, 0x14, 0xe2, 0x45, 0x5d, 0xe3, 0xd7, 0xa1, 0x60, 0xb4, 0xd4, 0xa6, 0x46, 0x6c, 0x6a,
+	0x09, 0xb9, 0xf3, 0xc7, 0x26, 0x22, 0xa4, 0xcc, 0x1a, 0x2d, 0x75, 0x3b, 0xf8, 0xe4, 0x2b, 0x30,
+	0x67, 0x63, 0xcf, 0x38, 0x26, 0x31, 0x6a, 0x3a, 0x44, 0xdd, 0xea, 0xf8, 0xd2, 0x52, 0x84, 0xea,
+	0x95, 0x22, 0xa5, 0x18, 0x2d, 0x23, 0xac, 0x0c, 0xb3, 0x98, 0x31, 0xe2, 0x05, 0xfe, 0x5e, 0x0f,
+	0x71, 0x4b, 0x1d, 0x5f, 0xfa, 0x5f, 0x1c, 0x64, 0x2c, 0x41, 0xca, 0x4c, 0xf8, 0x59, 0xd7, 0xf8,
+	0xbb, 0x30, 0xa3, 0x19, 0xcc, 0x31, 0x71, 0x5b, 0xc8, 0x87, 0xea, 0x7c, 0xc7, 0x97, 0x6e, 0x44,
+	0xea, 0xb1, 0x00, 0x29, 0x5d, 0x15, 0xbe, 0x0c, 0xb3, 0xe4, 0xc4, 0xa1, 0x36, 0xb1, 0x3d, 0x61,
+	0x66, 0x95, 0x5b, 0x9b, 0xef, 0xb5, 0xde, 0x95, 0x20, 0x25, 0x51, 0xe2, 0xef, 0x40, 0x9e, 0xb5,
+	0xad, 0x16, 0x35, 0x85, 0xd9, 0xd0, 0xfa, 0x62, 0xc7, 0x97, 0xe6, 0x23, 0xf5, 0x68, 0x1f, 0x29,
+	0xb1, 0x42, 0xe5, 0xc6, 0x17, 0x7f, 0x7e, 0xff, 0x6e, 0x9a, 0x7b, 0x74, 0x1b, 0xde, 0x1a, 0x42,
+	0xa7, 0x42, 0x98, 0x43, 0x6d, 0x46, 0xd0, 0x73, 0x0e, 0x4a, 0x0d, 0xa6, 0x2b, 0xc4, 0xa2, 0xc7,
+	0xe4, 0xf2, 0x98, 0xbf, 0x77, 0x8e, 0x83, 0x6b, 0x43, 0x98, 0xeb, 0x23, 0xa0, 0xbf, 0x64, 0x72,
+	0x17, 0x28, 0x99, 0x4c, 0x26, 0xd6, 0xe0, 0x9d, 0xe1, 0x11, 0x26, 0xc9, 0xf8, 0x8b, 0x83, 0xb7,
+	0x1b, 0x4c, 0xaf, 0x61, 0x5b, 0x25, 0xe6, 0x65, 0xa7, 0xa4, 0x32, 0x30, 0x25, 0xa3, 0x95, 0xe5,
+	0xbf, 0x90, 0x15, 0x19, 0xee, 0x8e, 0x12, 0x6a, 0x92, 0x9b, 0x9f, 0x39, 0x98, 0x0b, 0xd3, 0xa8,
+	0x52, 0x5b, 0x35, 0x4c, 0xf2, 0x2a, 0xe4, 0xe0, 0x37, 0x0e, 0x96, 0x1b, 0x4c, 0xdf, 0x23, 0xde,
+	0xae, 0x6b, 0x58, 0xd8, 0x6d, 0xc7, 0xa6, 0x5e, 0x85, 0xd8, 0x4a, 0xf0, 0xfa, 0xa0, 0xd0, 0x12,
+	0x3e, 0xff, 0xce, 0xc1, 0xcd, 0x90, 0x4f, 0xdd, 0x60, 0x1e, 0x71, 0xab, 0xae, 0xa1, 0xe9, 0x24,
+	0xa4, 0xfd, 0xaa, 0x5a, 0x7d, 0x05, 0xe6, 0x5c, 0x62, 0x51, 0x8f, 0xf4, 0x75, 0xfb, 0x9e, 0x04,
+	0xf6, 0x4a, 0x91, 0x52, 0x8c, 0x96, 0x93, 0xf7, 0xfc, 0x07, 0x00, 0xec, 0xc8, 0x71, 0xcc, 0x76,
+	0x53, 0xc5, 0x4e, 0xdc, 0xf5, 0x37, 0x9e, 0xfa, 0xd2, 0xd4, 0xef, 0xbe, 0xf4, 0xff, 0xe8, 0x45,
+	0x66, 0xda, 0x43, 0xd9, 0xa0, 0x65, 0x0b, 0x7b, 0x87, 0x72, 0xdd, 0xf6, 0xd2, 0x50, 0x52, 0x20,
+	0x52, 0x0a, 0xd1, 0xa2, 0x86, 0x9d, 0xff, 0xf0, 0xb3, 0xb0, 0x07, 0xa5, 0xc1, 0xac, 0x77, 0x0b,
+	0xa3, 0xff, 0xc5, 0xe5, 0x46, 0x79, 0x71, 0xd1, 0x2f, 0xd7, 0xc2, 0x7b, 0xf4, 0xa9, 0xa3, 0x61,
+	0x8f, 0x4c, 0x5a, 0x49, 0x57, 0x7b, 0x8f, 0xce, 0x55, 0xc3, 0xf4, 0x65, 0x54, 0xc3, 0x1d, 0xc8,
+	0x3b, 0xf8, 0x88, 0x91, 0x68, 0xa4, 0x98, 0xed, 0xa5, 0x2b, 0xda, 0x47, 0x4a, 0xac, 0xf0, 0x82,
+	0x5b, 0x9c, 0x49, 0x6c, 0x72, 0x8b, 0x0d, 0x58, 0xee, 0x6d, 0xca, 0x09, 0x89, 0x0f, 0x82, 0x23,
+	0x93, 0xac, 0x17, 0x37, 0x36, 0xe5, 0x91, 0xe6, 0x52, 0x79, 0x87, 0xa9, 0x2e, 0x7d, 0xb4, 0x1b,
+	0x42, 0xab, 0xd3, 0x41, 0xd8, 0x4a, 0x6c, 0x08, 0x7d, 0x93, 0x83, 0x85, 0x68, 0xa2, 0x50, 0xcc,
+	0xf6, 0x96, 0xa6, 0xb9, 0x84, 0xb1, 0x0b, 0xce, 0x06, 0x45, 0xd7, 0x6c, 0x37, 0x71, 0x64, 0x22,
+	0xe6, 0xf7, 0x66, 0xc7, 0x97, 0xf8, 0xf8, 0x9a, 0xa7, 0x42, 0xa4, 0x80, 0x9b, 0x1e, 0x76, 0x1f,
+	0xe6, 0xa9, 0x43, 0x5c, 0xec, 0x51, 0xb7, 0x69, 0x63, 0x8b, 0xc4, 0x04, 0x0b, 0x1d, 0x5f, 0x5a,
+	0x8e, 0xa0, 0x7d, 0x62, 0xa4, 0xcc, 0x75, 0xd7, 0x9f, 0x60, 0x8b, 0xf0, 0x0d, 0xc8, 0x93, 0x13,
+	0xc7, 0x70, 0xdb, 0x21, 0xab, 0xc5, 0x0d, 0x51, 0x8e, 0xa6, 0x6a, 0xb9, 0x3b, 0x55, 0xcb, 0xfb,
+	0xdd, 0xa9, 0xba, 0xfa, 0x5a, 0x10, 0x7a, 0x4a, 0x53, 0x84, 0x43, 0x8f, 0xff, 0x90, 0x38, 0x25,
+	0x36, 0x12, 0xd6, 0x9a, 0x69, 0x10, 0x3b, 0x98, 0x08, 0x99, 0x70, 0x7d, 0x35, 0x97, 0xd4, 0x5a,
+	0xb8, 0x5b, 0xdf, 0x66, 0x3d, 0xb5, 0x96, 0x28, 0x06, 0xb5, 0x16, 0xc9, 0x35, 0x16, 0x94, 0x7a,
+	0x2c, 0xf1, 0xda, 0x0e, 0x61, 0x42, 0x7e, 0x35, 0xd7, 0x5f, 0xea, 0xbd, 0x52, 0xa4, 0x14, 0xa3,
+	0xe5, 0x7e, 0xb0, 0xca, 0x54, 0x8a, 0x08, 0xc2, 0x79, 0x76, 0x92, 0x2a, 0x79, 0xc2, 0xc1, 0x52,
+	0x32, 0x02, 0x5d, 0x11, 0x7b, 0x19, 0x87, 0xdf, 0x80, 0x95, 0x01, 0x3e, 0x75, 0x7d, 0xde, 0xf8,
+	0xb6, 0x08, 0xb9, 0x06, 0xd3, 0xf9, 0xef, 0x38, 0x10, 0x5e, 0xf8, 0x4f, 0x49, 0x75, 0xc4, 0xb2,
+	0x1e, 0x32, 0x09, 0x8b, 0x1f, 0x4d, 0x6e, 0x23, 0xb9, 0x76, 0x3f, 0x70, 0xb0, 0x32, 0x6c, 0x6e,
+	0xdc, 0x19, 0xfd, 0xac, 0x21, 0x66, 0xc4, 0xc6, 0xa5, 0x98, 0x49, 0xbc, 0xfe, 0x91, 0x83, 0x37,
+	0x5f, 0x3e, 0xf3, 0x7e, 0x3c, 0xfa, 0xa1, 0x2f, 0x35, 0x26, 0xee, 0x5d, 0xa2, 0xb1, 0x24, 0x8e,
+	0xcf, 0xa1, 0x90, 0x8e, 0xa7, 0x9b, 0xe3, 0xe4, 0x28, 0x06, 0x89, 0xef, 0x5f, 0x00, 0x94, 0x1c,
+	0xff, 0x15, 0x07, 0x8b, 0xd9, 0x51, 0x72, 0x0c, 0x93, 0x19, 0xb0, 0x58, 0x9b, 0x00, 0x9c, 0xf8,
+	0xf5, 0x35, 0x07, 0x4b, 0x83, 0xc6, 0xbc, 0xfb, 0xe3, 0x04, 0x9b, 0x81, 0x8b, 0x3b, 0x13, 0xc1,
+	0xfb, 0xb2, 0x96, 0x1d, 0x1c, 0xc6, 0xc8, 0x5a, 0x06, 0x2c, 0xd6, 0x26, 0x00, 0x27, 0x7e, 0x7d,
+	0xc9, 0xc1, 0x7c, 0xff, 0x5b, 0x77, 0x6f, 0xac, 0x46, 0x91, 0x02, 0xc5, 0x0f, 0x2e, 0x08, 0x4c,
+	0x7c, 0x79, 0xc2, 0xc1, 0x42, 0xa6, 0x79, 0x57, 0xc6, 0x6d, 0x02, 0x3d, 0x1e, 0x55, 0x2f, 0x8e,
+	0xed, 0x3a, 0x55, 0x7d, 0xef, 0xe9, 0x69, 0x89, 0x7b, 0x76, 0x5a, 0xe2, 0x9e, 0x9f, 0x96, 0xb8,
+	0xc7, 0x67, 0xa5, 0xa9, 0x67, 0x67, 0xa5, 0xa9, 0x5f, 0xcf, 0x4a, 0x53, 0x9f, 0x89, 0x27, 0x83,
+	0x7e, 0x83, 0x0a, 0x1f, 0xb1, 0x56, 0x3e, 0x7c, 0x6c, 0x37, 0xff, 0x19, 0x00, 0xb4, 0xa1, 0x9c,
+	0xc4, 0x58, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	AddParachainIBCTokenInfo(ctx context.Context, in *MsgAddParachainIBCTokenInfo, opts ...grpc.CallOption) (*MsgAddParachainIBCTokenInfoResponse, error)
+	RemoveParachainIBCTokenInfo(ctx context.Context, in *MsgRemoveParachainIBCTokenInfo, opts ...grpc.CallOption) (*MsgRemoveParachainIBCTokenInfoResponse, error)
+	CancelRemoveParachainIBCTokenInfo(ctx context.Context, in *MsgCancelRemoveParachainIBCTokenInfo, opts ...grpc.CallOption) (*MsgCancelRemoveParachainIBCTokenInfoResponse, error)
+	Reconcile(ctx context.Context, in *MsgReconcile, opts ...grpc.CallOption) (*MsgReconcileResponse, error)
+	SetPrimaryChannel(ctx context.Context, in *MsgSetPrimaryChannel, opts ...grpc.CallOption) (*MsgSetPrimaryChannelResponse, error)
+	RegisterBridgeToken(ctx context.Context, in *MsgRegisterBridgeToken, opts ...grpc.CallOption) (*MsgRegisterBridgeTokenResponse, error)
+	UpdateBridgeToken(ctx context.Context, in *MsgUpdateBridgeToken, opts ...grpc.CallOption) (*MsgUpdateBridgeTokenResponse, error)
+	AddRlyAddress(ctx context.Context, in *MsgAddRlyAddress, opts ...grpc.CallOption) (*MsgAddRlyAddressResponse, error)
+	RemoveRlyAddress(ctx context.Context, in *MsgRemoveRlyAddress, opts ...grpc.CallOption) (*MsgRemoveRlyAddressResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddParachainIBCTokenInfo(ctx context.Context, in *MsgAddParachainIBCTokenInfo, opts ...grpc.CallOption) (*MsgAddParachainIBCTokenInfoResponse, error) {
+	out := new(MsgAddParachainIBCTokenInfoResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Msg/AddParachainIBCTokenInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveParachainIBCTokenInfo(ctx context.Context, in *MsgRemoveParachainIBCTokenInfo, opts ...grpc.CallOption) (*MsgRemoveParachainIBCTokenInfoResponse, error) {
+	out := new(MsgRemoveParachainIBCTokenInfoResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Msg/RemoveParachainIBCTokenInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRemoveParachainIBCTokenInfo(ctx context.Context, in *MsgCancelRemoveParachainIBCTokenInfo, opts ...grpc.CallOption) (*MsgCancelRemoveParachainIBCTokenInfoResponse, error) {
+	out := new(MsgCancelRemoveParachainIBCTokenInfoResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Msg/CancelRemoveParachainIBCTokenInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Reconcile(ctx context.Context, in *MsgReconcile, opts ...grpc.CallOption) (*MsgReconcileResponse, error) {
+	out := new(MsgReconcileResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Msg/Reconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPrimaryChannel(ctx context.Context, in *MsgSetPrimaryChannel, opts ...grpc.CallOption) (*MsgSetPrimaryChannelResponse, error) {
+	out := new(MsgSetPrimaryChannelResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Msg/SetPrimaryChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterBridgeToken(ctx context.Context, in *MsgRegisterBridgeToken, opts ...grpc.CallOption) (*MsgRegisterBridgeTokenResponse, error) {
+	out := new(MsgRegisterBridgeTokenResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Msg/RegisterBridgeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBridgeToken(ctx context.Context, in *MsgUpdateBridgeToken, opts ...grpc.CallOption) (*MsgUpdateBridgeTokenResponse, error) {
+	out := new(MsgUpdateBridgeTokenResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Msg/UpdateBridgeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddRlyAddress(ctx context.Context, in *MsgAddRlyAddress, opts ...grpc.CallOption) (*MsgAddRlyAddressResponse, error) {
+	out := new(MsgAddRlyAddressResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Msg/AddRlyAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRlyAddress(ctx context.Context, in *MsgRemoveRlyAddress, opts ...grpc.CallOption) (*MsgRemoveRlyAddressResponse, error) {
+	out := new(MsgRemoveRlyAddressResponse)
+	err := c.cc.Invoke(ctx, "/composable.transfermiddleware.v1beta1.Msg/RemoveRlyAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	AddParachainIBCTokenInfo(context.Context, *MsgAddParachainIBCTokenInfo) (*MsgAddParachainIBCTokenInfoResponse, error)
+	RemoveParachainIBCTokenInfo(context.Context, *MsgRemoveParachainIBCTokenInfo) (*MsgRemoveParachainIBCTokenInfoResponse, error)
+	CancelRemoveParachainIBCTokenInfo(context.Context, *MsgCancelRemoveParachainIBCTokenInfo) (*MsgCancelRemoveParachainIBCTokenInfoResponse, error)
+	Reconcile(context.Context, *MsgReconcile) (*MsgReconcileResponse, error)
+	SetPrimaryChannel(context.Context, *MsgSetPrimaryChannel) (*MsgSetPrimaryChannelResponse, error)
+	RegisterBridgeToken(context.Context, *MsgRegisterBridgeToken) (*MsgRegisterBridgeTokenResponse, error)
+	UpdateBridgeToken(context.Context, *MsgUpdateBridgeToken) (*MsgUpdateBridgeTokenResponse, error)
+	AddRlyAddress(context.Context, *MsgAddRlyAddress) (*MsgAddRlyAddressResponse, error)
+	RemoveRlyAddress(context.Context, *MsgRemoveRlyAddress) (*MsgRemoveRlyAddressResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddParachainIBCTokenInfo(ctx context.Context, req *MsgAddParachainIBCTokenInfo) (*MsgAddParachainIBCTokenInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddParachainIBCTokenInfo not implemented")
+}
+func (*UnimplementedMsgServer) RemoveParachainIBCTokenInfo(ctx context.Context, req *MsgRemoveParachainIBCTokenInfo) (*MsgRemoveParachainIBCTokenInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveParachainIBCTokenInfo not implemented")
+}
+func (*UnimplementedMsgServer) CancelRemoveParachainIBCTokenInfo(ctx context.Context, req *MsgCancelRemoveParachainIBCTokenInfo) (*MsgCancelRemoveParachainIBCTokenInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveParachainIBCTokenInfo not implemented")
+}
+func (*UnimplementedMsgServer) Reconcile(ctx context.Context, req *MsgReconcile) (*MsgReconcileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+func (*UnimplementedMsgServer) SetPrimaryChannel(ctx context.Context, req *MsgSetPrimaryChannel) (*MsgSetPrimaryChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryChannel not implemented")
+}
+func (*UnimplementedMsgServer) RegisterBridgeToken(ctx context.Context, req *MsgRegisterBridgeToken) (*MsgRegisterBridgeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBridgeToken not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBridgeToken(ctx context.Context, req *MsgUpdateBridgeToken) (*MsgUpdateBridgeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBridgeToken not implemented")
+}
+func (*UnimplementedMsgServer) AddRlyAddress(ctx context.Context, req *MsgAddRlyAddress) (*MsgAddRlyAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRlyAddress not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRlyAddress(ctx context.Context, req *MsgRemoveRlyAddress) (*MsgRemoveRlyAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRlyAddress not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddParachainIBCTokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddParachainIBCTokenInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddParachainIBCTokenInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Msg/AddParachainIBCTokenInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddParachainIBCTokenInfo(ctx, req.(*MsgAddParachainIBCTokenInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveParachainIBCTokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveParachainIBCTokenInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveParachainIBCTokenInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Msg/RemoveParachainIBCTokenInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveParachainIBCTokenInfo(ctx, req.(*MsgRemoveParachainIBCTokenInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRemoveParachainIBCTokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRemoveParachainIBCTokenInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRemoveParachainIBCTokenInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Msg/CancelRemoveParachainIBCTokenInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRemoveParachainIBCTokenInfo(ctx, req.(*MsgCancelRemoveParachainIBCTokenInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReconcile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Msg/Reconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Reconcile(ctx, req.(*MsgReconcile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPrimaryChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPrimaryChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPrimaryChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Msg/SetPrimaryChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPrimaryChannel(ctx, req.(*MsgSetPrimaryChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterBridgeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterBridgeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterBridgeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Msg/RegisterBridgeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterBridgeToken(ctx, req.(*MsgRegisterBridgeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBridgeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBridgeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBridgeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Msg/UpdateBridgeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBridgeToken(ctx, req.(*MsgUpdateBridgeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddRlyAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddRlyAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddRlyAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Msg/AddRlyAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddRlyAddress(ctx, req.(*MsgAddRlyAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRlyAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRlyAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRlyAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/composable.transfermiddleware.v1beta1.Msg/RemoveRlyAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRlyAddress(ctx, req.(*MsgRemoveRlyAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "composable.transfermiddleware.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddParachainIBCTokenInfo",
+			Handler:    _Msg_AddParachainIBCTokenInfo_Handler,
+		},
+		{
+			MethodName: "RemoveParachainIBCTokenInfo",
+			Handler:    _Msg_RemoveParachainIBCTokenInfo_Handler,
+		},
+		{
+			MethodName: "CancelRemoveParachainIBCTokenInfo",
+			Handler:    _Msg_CancelRemoveParachainIBCTokenInfo_Handler,
+		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _Msg_Reconcile_Handler,
+		},
+		{
+			MethodName: "SetPrimaryChannel",
+			Handler:    _Msg_SetPrimaryChannel_Handler,
+		},
+		{
+			MethodName: "RegisterBridgeToken",
+			Handler:    _Msg_RegisterBridgeToken_Handler,
+		},
+		{
+			MethodName: "UpdateBridgeToken",
+			Handler:    _Msg_UpdateBridgeToken_Handler,
+		},
+		{
+			MethodName: "AddRlyAddress",
+			Handler:    _Msg_AddRlyAddress_Handler,
+		},
+		{
+			MethodName: "RemoveRlyAddress",
+			Handler:    _Msg_RemoveRlyAddress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "composable/transfermiddleware/v1beta1/tx.proto",
+}
+
+func (m *MsgAddParachainIBCTokenInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddParachainIBCTokenInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddParachainIBCTokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AssetId) > 0 {
+		i -= len(m.AssetId)
+		copy(dAtA[i:], m.AssetId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddParachainIBCTokenInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddParachainIBCTokenInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddParachainIBCTokenInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveParachainIBCTokenInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveParachainIBCTokenInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveParachainIBCTokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveParachainIBCTokenInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveParachainIBCTokenInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveParachainIBCTokenInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRemoveParachainIBCTokenInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRemoveParachainIBCTokenInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRemoveParachainIBCTokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRemoveParachainIBCTokenInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRemoveParachainIBCTokenInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRemoveParachainIBCTokenInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReconcile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReconcile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReconcile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPrimaryChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPrimaryChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPrimaryChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPrimaryChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPrimaryChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPrimaryChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterBridgeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBridgeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBridgeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.SupplyCap.Size()
+		i -= size
+		if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RemoteDenom) > 0 {
+		i -= len(m.RemoteDenom)
+		copy(dAtA[i:], m.RemoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RemoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterBridgeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBridgeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBridgeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBridgeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBridgeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBridgeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.SupplyCap.Size()
+		i -= size
+		if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBridgeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBridgeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBridgeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReconcileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReconcileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReconcileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Parity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddRlyAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRlyAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRlyAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientTypes) > 0 {
+		for iNdEx := len(m.ClientTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientTypes[iNdEx])
+			copy(dAtA[i:], m.ClientTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ClientTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ClientIDs) > 0 {
+		for iNdEx := len(m.ClientIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientIDs[iNdEx])
+			copy(dAtA[i:], m.ClientIDs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ClientIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.OperatorName) > 0 {
+		i -= len(m.OperatorName)
+		copy(dAtA[i:], m.OperatorName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RlyAddress) > 0 {
+		i -= len(m.RlyAddress)
+		copy(dAtA[i:], m.RlyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RlyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddRlyAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRlyAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRlyAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRlyAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRlyAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRlyAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RlyAddress) > 0 {
+		i -= len(m.RlyAddress)
+		copy(dAtA[i:], m.RlyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RlyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRlyAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRlyAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRlyAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddParachainIBCTokenInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovTx(uint64(m.Exponent))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddParachainIBCTokenInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveParachainIBCTokenInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveParachainIBCTokenInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRemoveParachainIBCTokenInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRemoveParachainIBCTokenInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReconcile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPrimaryChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPrimaryChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterBridgeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RemoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovTx(uint64(m.Exponent))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterBridgeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateBridgeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateBridgeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReconcileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Parity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddRlyAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RlyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.ClientIDs) > 0 {
+		for _, s := range m.ClientIDs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ClientTypes) > 0 {
+		for _, s := range m.ClientTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddRlyAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRlyAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RlyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRlyAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddParachainIBCTokenInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddParachainIBCTokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddParachainIBCTokenInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddParachainIBCTokenInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddParachainIBCTokenInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveParachainIBCTokenInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveParachainIBCTokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveParachainIBCTokenInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveParachainIBCTokenInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveParachainIBCTokenInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRemoveParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRemoveParachainIBCTokenInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRemoveParachainIBCTokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRemoveParachainIBCTokenInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRemoveParachainIBCTokenInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRemoveParachainIBCTokenInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReconcile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReconcile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReconcile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPrimaryChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPrimaryChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPrimaryChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPrimaryChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPrimaryChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPrimaryChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterBridgeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterBridgeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterBridgeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterBridgeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterBridgeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterBridgeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAddRlyAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBridgeToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -863,10 +4092,10 @@ func (m *MsgAddParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddParachainIBCTokenInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBridgeToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddParachainIBCTokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBridgeToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -903,7 +4132,7 @@ func (m *MsgAddParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -931,11 +4160,11 @@ func (m *MsgAddParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -963,11 +4192,11 @@ func (m *MsgAddParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -995,13 +4224,15 @@ func (m *MsgAddParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1011,24 +4242,62 @@ func (m *MsgAddParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBridgeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBridgeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBridgeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1050,7 +4319,7 @@ func (m *MsgAddParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddParachainIBCTokenInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReconcileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1073,12 +4342,45 @@ func (m *MsgAddParachainIBCTokenInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddParachainIBCTokenInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReconcileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddParachainIBCTokenInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReconcileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Parity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1100,7 +4402,7 @@ func (m *MsgAddParachainIBCTokenInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgAddRlyAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1123,10 +4425,10 @@ func (m *MsgRemoveParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveParachainIBCTokenInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddRlyAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveParachainIBCTokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddRlyAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1163,7 +4465,7 @@ func (m *MsgRemoveParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RlyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1191,7 +4493,136 @@ func (m *MsgRemoveParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			m.RlyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientIDs = append(m.ClientIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientTypes = append(m.ClientTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1214,7 +4645,7 @@ func (m *MsgRemoveParachainIBCTokenInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveParachainIBCTokenInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddRlyAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1237,10 +4668,10 @@ func (m *MsgRemoveParachainIBCTokenInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveParachainIBCTokenInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddRlyAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveParachainIBCTokenInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddRlyAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1264,7 +4695,7 @@ func (m *MsgRemoveParachainIBCTokenInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddRlyAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRlyAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1287,10 +4718,10 @@ func (m *MsgAddRlyAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddRlyAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRlyAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddRlyAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRlyAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1378,7 +4809,7 @@ func (m *MsgAddRlyAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddRlyAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRlyAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1401,10 +4832,10 @@ func (m *MsgAddRlyAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddRlyAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRlyAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddRlyAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRlyAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: